@@ -5,12 +5,15 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/MetalBlockchain/metalgo/api"
 	"github.com/MetalBlockchain/metalgo/database/rpcdb"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/formatting"
+	"github.com/MetalBlockchain/metalgo/utils/json"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/profiler"
 	"github.com/MetalBlockchain/metalgo/utils/rpc"
 )
 
@@ -40,6 +43,17 @@ func (c *Client) LockProfile(ctx context.Context, options ...rpc.Option) error {
 	return c.Requester.SendRequest(ctx, "admin.lockProfile", struct{}{}, &api.EmptyReply{}, options...)
 }
 
+// Profile captures a profile of [profileType] on the node and returns it. CPU
+// profiles and execution traces are recorded for [duration].
+func (c *Client) Profile(ctx context.Context, profileType profiler.Type, duration time.Duration, options ...rpc.Option) ([]byte, error) {
+	res := &ProfileReply{}
+	err := c.Requester.SendRequest(ctx, "admin.profile", &ProfileArgs{
+		Type:    profileType.String(),
+		Seconds: json.Uint64(duration / time.Second),
+	}, res, options...)
+	return res.Profile, err
+}
+
 func (c *Client) Alias(ctx context.Context, endpoint, alias string, options ...rpc.Option) error {
 	return c.Requester.SendRequest(ctx, "admin.alias", &AliasArgs{
 		Endpoint: endpoint,
@@ -62,6 +76,16 @@ func (c *Client) GetChainAliases(ctx context.Context, chain string, options ...r
 	return res.Aliases, err
 }
 
+// DumpConsensusEvents writes the recent consensus events of [chain] to a file
+// on the node and returns the path of the file and the number of events.
+func (c *Client) DumpConsensusEvents(ctx context.Context, chain string, options ...rpc.Option) (string, uint64, error) {
+	res := &DumpConsensusEventsReply{}
+	err := c.Requester.SendRequest(ctx, "admin.dumpConsensusEvents", &DumpConsensusEventsArgs{
+		Chain: chain,
+	}, res, options...)
+	return res.Path, uint64(res.NumEvents), err
+}
+
 func (c *Client) Stacktrace(ctx context.Context, options ...rpc.Option) error {
 	return c.Requester.SendRequest(ctx, "admin.stacktrace", struct{}{}, &api.EmptyReply{}, options...)
 }
@@ -123,6 +147,22 @@ func (c *Client) GetConfig(ctx context.Context, options ...rpc.Option) (interfac
 	return res, err
 }
 
+func (c *Client) TrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "admin.trackSubnet", &TrackSubnetArgs{
+		SubnetID: subnetID,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *Client) UntrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "admin.untrackSubnet", &TrackSubnetArgs{
+		SubnetID: subnetID,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *Client) ReloadConfig(ctx context.Context, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "admin.reloadConfig", struct{}{}, &api.EmptyReply{}, options...)
+}
+
 func (c *Client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error) {
 	keyStr, err := formatting.Encode(formatting.HexNC, key)
 	if err != nil {
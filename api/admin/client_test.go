@@ -7,12 +7,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/MetalBlockchain/metalgo/api"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/profiler"
 	"github.com/MetalBlockchain/metalgo/utils/rpc"
 )
 
@@ -65,6 +67,12 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *LoggerLevelReply:
 		response := mc.response.(*LoggerLevelReply)
 		*p = *response
+	case *ProfileReply:
+		response := mc.response.(*ProfileReply)
+		*p = *response
+	case *DumpConsensusEventsReply:
+		response := mc.response.(*DumpConsensusEventsReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -134,6 +142,27 @@ func TestAliasChain(t *testing.T) {
 	}
 }
 
+func TestProfile(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := []byte{1, 2, 3}
+		mockClient := Client{Requester: NewMockClient(&ProfileReply{
+			Profile: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.Profile(context.Background(), profiler.CPU, time.Second)
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := Client{Requester: NewMockClient(&ProfileReply{}, errTest)}
+		_, err := mockClient.Profile(context.Background(), profiler.CPU, time.Second)
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
 func TestGetChainAliases(t *testing.T) {
 	t.Run("successful", func(t *testing.T) {
 		require := require.New(t)
@@ -155,6 +184,28 @@ func TestGetChainAliases(t *testing.T) {
 	})
 }
 
+func TestDumpConsensusEvents(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		mockClient := Client{Requester: NewMockClient(&DumpConsensusEventsReply{
+			Path:      "consensus-events.json",
+			NumEvents: 10,
+		}, nil)}
+
+		path, numEvents, err := mockClient.DumpConsensusEvents(context.Background(), "chain")
+		require.NoError(err)
+		require.Equal("consensus-events.json", path)
+		require.Equal(uint64(10), numEvents)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := Client{Requester: NewMockClient(&DumpConsensusEventsReply{}, errTest)}
+		_, _, err := mockClient.DumpConsensusEvents(context.Background(), "chain")
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
 func TestStacktrace(t *testing.T) {
 	for _, test := range SuccessResponseTests {
 		t.Run(test.name, func(t *testing.T) {
@@ -165,6 +216,36 @@ func TestStacktrace(t *testing.T) {
 	}
 }
 
+func TestTrackSubnet(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := Client{Requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.TrackSubnet(context.Background(), ids.GenerateTestID())
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestUntrackSubnet(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := Client{Requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.UntrackSubnet(context.Background(), ids.GenerateTestID())
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := Client{Requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.ReloadConfig(context.Background())
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestReloadInstalledVMs(t *testing.T) {
 	t.Run("successful", func(t *testing.T) {
 		require := require.New(t)
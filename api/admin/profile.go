@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/profiler"
+)
+
+const (
+	// defaultProfileDuration is the duration of CPU profiles and execution
+	// traces if no duration is specified.
+	defaultProfileDuration = 10 * time.Second
+	// maxProfileDuration is the maximum duration of a profile.
+	maxProfileDuration = 5 * time.Minute
+	// profileWriteTimeout is the time allowed to write a profile
+	// after it has been captured.
+	profileWriteTimeout = 30 * time.Second
+)
+
+var errProfileTooLong = fmt.Errorf("profile duration must be at most %s", maxProfileDuration)
+
+// getProfileDuration returns the duration to capture a profile of type
+// [profileType] for.
+func getProfileDuration(profileType profiler.Type, seconds uint64) (time.Duration, error) {
+	duration := time.Duration(seconds) * time.Second
+	switch {
+	case seconds > uint64(maxProfileDuration/time.Second):
+		return 0, errProfileTooLong
+	case duration == 0 && profileType.IsRecording():
+		return defaultProfileDuration, nil
+	default:
+		return duration, nil
+	}
+}
+
+// NewProfileHandler returns a handler that captures a profile of the node and
+// streams it in the response. The profile is configured with the following
+// query parameters:
+//
+//   - type: the type of the profile. Defaults to cpu.
+//   - seconds: the number of seconds to record the profile for.
+func NewProfileHandler(log logging.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "only GET requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		profileType := profiler.CPU
+		if typeName := query.Get("type"); typeName != "" {
+			var err error
+			profileType, err = profiler.ParseType(typeName)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var seconds uint64
+		if secondsStr := query.Get("seconds"); secondsStr != "" {
+			var err error
+			seconds, err = strconv.ParseUint(secondsStr, 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid seconds: %s", err), http.StatusBadRequest)
+				return
+			}
+		}
+		duration, err := getProfileDuration(profileType, seconds)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.Debug("API called",
+			zap.String("service", "admin"),
+			zap.String("method", "profile"),
+			zap.Stringer("type", profileType),
+			zap.Duration("duration", duration),
+		)
+
+		// Recording a profile may take longer than the write timeout of the
+		// server.
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(duration + profileWriteTimeout))
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.profile"`, profileType))
+
+		writer := &trackedWriter{writer: w}
+		if err := profiler.Capture(r.Context(), writer, profileType, duration); err != nil {
+			log.Debug("failed to capture profile",
+				zap.Stringer("type", profileType),
+				zap.Error(err),
+			)
+			// If part of the profile was already sent, the status code can no
+			// longer be changed.
+			if !writer.written && !errors.Is(err, r.Context().Err()) {
+				w.Header().Del("Content-Disposition")
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+		}
+	})
+}
+
+// trackedWriter records whether anything was written to the response.
+type trackedWriter struct {
+	writer  http.ResponseWriter
+	written bool
+}
+
+func (w *trackedWriter) Write(b []byte) (int, error) {
+	w.written = true
+	return w.writer.Write(b)
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+func TestProfileHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		query          string
+		expectedStatus int
+	}{
+		{
+			name:           "goroutine profile",
+			method:         http.MethodGet,
+			query:          "type=goroutine",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "cpu profile",
+			method:         http.MethodGet,
+			query:          "type=cpu&seconds=1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown type",
+			method:         http.MethodGet,
+			query:          "type=unknown",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid seconds",
+			method:         http.MethodGet,
+			query:          "type=cpu&seconds=-1",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "too many seconds",
+			method:         http.MethodGet,
+			query:          "type=cpu&seconds=301",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodPost,
+			query:          "type=goroutine",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			handler := NewProfileHandler(logging.NoLog{})
+			req := httptest.NewRequest(test.method, "/profile?"+test.query, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(test.expectedStatus, w.Code)
+			if test.expectedStatus == http.StatusOK {
+				require.NotEmpty(w.Body.Bytes())
+			}
+		})
+	}
+}
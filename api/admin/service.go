@@ -4,10 +4,16 @@
 package admin
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
 	"path"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -18,6 +24,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/database"
 	"github.com/MetalBlockchain/metalgo/database/rpcdb"
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/formatting"
@@ -31,11 +38,20 @@ import (
 	rpcdbpb "github.com/MetalBlockchain/metalgo/proto/pb/rpcdb"
 )
 
+type contextKey int
+
+// responseWriterKey is the request context key of the [http.ResponseWriter]
+// that the response is written to.
+const responseWriterKey contextKey = iota
+
 const (
 	maxAliasLength = 512
 
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
+
+	// Prefix of the files that consensus events are written to
+	consensusEventsFilePrefix = "consensus-events"
 )
 
 var (
@@ -43,16 +59,24 @@ var (
 	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
 )
 
+// ConfigReloader re-reads the node's config and applies the settings that can
+// be updated at runtime.
+type ConfigReloader interface {
+	ReloadConfig() error
+}
+
 type Config struct {
-	Log          logging.Logger
-	ProfileDir   string
-	LogFactory   logging.Factory
-	NodeConfig   interface{}
-	DB           database.Database
-	ChainManager chains.Manager
-	HTTPServer   server.PathAdderWithReadLock
-	VMRegistry   registry.VMRegistry
-	VMManager    vms.Manager
+	Log            logging.Logger
+	ProfileDir     string
+	LogFactory     logging.Factory
+	NodeConfig     interface{}
+	DB             database.Database
+	ChainManager   chains.Manager
+	HTTPServer     server.PathAdderWithReadLock
+	VMRegistry     registry.VMRegistry
+	VMManager      vms.Manager
+	SubnetTracker  chains.SubnetTracker
+	ConfigReloader ConfigReloader
 }
 
 // Admin is the API service for node admin management
@@ -69,7 +93,13 @@ func NewService(config Config) (http.Handler, error) {
 	codec := json.NewCodec()
 	server.RegisterCodec(codec, "application/json")
 	server.RegisterCodec(codec, "application/json;charset=UTF-8")
-	return server, server.RegisterService(
+	// The response writer is made available to the service methods so that
+	// long running calls can extend the write deadline of their response.
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), responseWriterKey, w)
+		server.ServeHTTP(w, r.WithContext(ctx))
+	})
+	return handler, server.RegisterService(
 		&Admin{
 			Config:   config,
 			profiler: profiler.New(config.ProfileDir),
@@ -214,6 +244,69 @@ func (a *Admin) GetChainAliases(_ *http.Request, args *GetChainAliasesArgs, repl
 	return err
 }
 
+// DumpConsensusEventsArgs are the arguments for calling DumpConsensusEvents
+type DumpConsensusEventsArgs struct {
+	// ID or alias of the chain
+	Chain string `json:"chain"`
+}
+
+// DumpConsensusEventsReply is the result from calling DumpConsensusEvents
+type DumpConsensusEventsReply struct {
+	// Path of the file the events were written to
+	Path string `json:"path"`
+	// Number of events that were written
+	NumEvents json.Uint64 `json:"numEvents"`
+}
+
+// DumpConsensusEvents writes the recent consensus events of a chain to a file
+// in the profile directory
+func (a *Admin) DumpConsensusEvents(_ *http.Request, args *DumpConsensusEventsArgs, reply *DumpConsensusEventsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "dumpConsensusEvents"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	dump, err := a.ChainManager.ConsensusEvents(chainID)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if err := os.MkdirAll(a.ProfileDir, perms.ReadWriteExecute); err != nil {
+		return err
+	}
+
+	fileName := filepath.Join(a.ProfileDir, fmt.Sprintf(
+		"%s-%s-%d.json",
+		consensusEventsFilePrefix,
+		chainID,
+		dump.DumpedAt.UnixNano(),
+	))
+	file, err := perms.Create(fileName, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	if err := recorder.WriteDump(file, dump); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	reply.Path = fileName
+	reply.NumEvents = json.Uint64(len(dump.Events))
+	return nil
+}
+
 // Stacktrace returns the current global stacktrace
 func (a *Admin) Stacktrace(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
 	a.Log.Debug("API called",
@@ -382,6 +475,101 @@ func (a *Admin) getLogLevels(loggerNames []string) (map[string]LogAndDisplayLeve
 	return loggerLevels, nil
 }
 
+// TrackSubnetArgs are the arguments for calling TrackSubnet and UntrackSubnet
+type TrackSubnetArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// TrackSubnet starts tracking the subnet. The chains of the subnet are created
+// and bootstrapped without restarting the node.
+func (a *Admin) TrackSubnet(_ *http.Request, args *TrackSubnetArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "trackSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.SubnetTracker.TrackSubnet(args.SubnetID)
+}
+
+// UntrackSubnet stops tracking the subnet. The chains of the subnet are shut
+// down without restarting the node.
+func (a *Admin) UntrackSubnet(_ *http.Request, args *TrackSubnetArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "untrackSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.SubnetTracker.UntrackSubnet(args.SubnetID)
+}
+
+// ProfileArgs are the arguments for calling Profile
+type ProfileArgs struct {
+	Type    string      `json:"type"`
+	Seconds json.Uint64 `json:"seconds"`
+}
+
+// ProfileReply is the response from calling Profile
+type ProfileReply struct {
+	Profile []byte `json:"profile"`
+}
+
+// Profile captures a profile of the node and returns it in the response.
+//
+// The lock isn't held so that recording a profile doesn't block the other
+// admin calls.
+func (a *Admin) Profile(r *http.Request, args *ProfileArgs, reply *ProfileReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "profile"),
+		zap.String("type", args.Type),
+		zap.Uint64("seconds", uint64(args.Seconds)),
+	)
+
+	profileType, err := profiler.ParseType(args.Type)
+	if err != nil {
+		return err
+	}
+	duration, err := getProfileDuration(profileType, uint64(args.Seconds))
+	if err != nil {
+		return err
+	}
+
+	// Recording a profile may take longer than the write timeout of the
+	// server.
+	if w, ok := r.Context().Value(responseWriterKey).(http.ResponseWriter); ok {
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(duration + profileWriteTimeout))
+	}
+
+	var profile bytes.Buffer
+	if err := profiler.Capture(r.Context(), &profile, profileType, duration); err != nil {
+		return err
+	}
+	reply.Profile = profile.Bytes()
+	return nil
+}
+
+// ReloadConfig re-reads the node's config file and applies the settings that
+// can be updated without restarting the node.
+func (a *Admin) ReloadConfig(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "reloadConfig"),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.ConfigReloader.ReloadConfig()
+}
+
 type DBGetArgs struct {
 	Key string `json:"key"`
 }
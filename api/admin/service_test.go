@@ -4,16 +4,23 @@
 package admin
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/MetalBlockchain/metalgo/chains"
 	"github.com/MetalBlockchain/metalgo/database/memdb"
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/utils/formatting"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/profiler"
 	"github.com/MetalBlockchain/metalgo/vms/registry/registrymock"
 	"github.com/MetalBlockchain/metalgo/vms/vmsmock"
 
@@ -165,3 +172,48 @@ func TestServiceDBGet(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDumpConsensusEvents(t *testing.T) {
+	require := require.New(t)
+
+	admin := &Admin{Config: Config{
+		Log:          logging.NoLog{},
+		ProfileDir:   t.TempDir(),
+		ChainManager: chains.TestManager,
+	}}
+
+	chainID := ids.GenerateTestID()
+	reply := DumpConsensusEventsReply{}
+	require.NoError(admin.DumpConsensusEvents(nil, &DumpConsensusEventsArgs{
+		Chain: chainID.String(),
+	}, &reply))
+	require.Zero(reply.NumEvents)
+
+	file, err := os.Open(reply.Path)
+	require.NoError(err)
+	defer file.Close()
+
+	dump, err := recorder.ReadDump(file)
+	require.NoError(err)
+	require.Equal(recorder.Version, dump.Version)
+}
+
+func TestServiceProfileExtendsWriteDeadline(t *testing.T) {
+	require := require.New(t)
+
+	handler, err := NewService(Config{
+		Log: logging.NoLog{},
+	})
+	require.NoError(err)
+
+	// The profile takes longer to record than the write timeout of the server.
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	client := NewClient(server.URL)
+	profile, err := client.Profile(context.Background(), profiler.CPU, time.Second)
+	require.NoError(err)
+	require.NotEmpty(profile)
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package audit records the privileged API calls made to the node in an
+// append-only JSON-lines log.
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/units"
+)
+
+const (
+	fileName = "audit"
+
+	forwardedForHeader = "X-Forwarded-For"
+
+	resultSuccess = "success"
+	resultError   = "error"
+
+	// maxRecordedResponseSize is the number of bytes of a response that are
+	// kept to find the error it reports.
+	maxRecordedResponseSize = 64 * units.KiB
+)
+
+// Record describes a single API call.
+type Record struct {
+	Time time.Time `json:"time"`
+	// Address of the caller, as reported by the connection.
+	Caller string `json:"caller"`
+	// Addresses reported by proxies between the caller and the node.
+	ForwardedFor string `json:"forwardedFor,omitempty"`
+	Endpoint     string `json:"endpoint"`
+	// Method is the JSON-RPC method, or the HTTP method for requests that
+	// aren't JSON-RPC calls.
+	Method string `json:"method"`
+	// Hex encoded SHA-256 digest of the JSON encoded arguments, or of the query
+	// string followed by the body for requests that aren't JSON-RPC calls. The
+	// arguments aren't recorded, as they may contain secrets.
+	ArgumentsDigest string `json:"argumentsDigest"`
+	Result          string `json:"result"`
+	Error           string `json:"error,omitempty"`
+	StatusCode      int    `json:"statusCode"`
+	// Number of bytes written in the response body.
+	ResponseSize int `json:"responseSize"`
+	// Time spent handling the call, in nanoseconds.
+	Latency time.Duration `json:"latency"`
+}
+
+// Log writes the records of the calls to the methods of the configured
+// classes.
+type Log struct {
+	matcher matcher
+	writer  io.WriteCloser
+	now     func() time.Time
+}
+
+// New returns a log that writes to audit.log in the directory of
+// [writerConfig], rotated according to [writerConfig].
+func New(config Config, writerConfig logging.RotatingWriterConfig) (*Log, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+	return newLog(config, logging.NewRotatingWriter(writerConfig, fileName)), nil
+}
+
+func newLog(config Config, writer io.WriteCloser) *Log {
+	return &Log{
+		matcher: newMatcher(config.Classes),
+		writer:  writer,
+		now:     time.Now,
+	}
+}
+
+// Wrap returns a handler that records the calls made to [handler].
+func (l *Log) Wrap(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var (
+			request struct {
+				Method string          `json:"method"`
+				Params json.RawMessage `json:"params"`
+			}
+			method string
+			digest [sha256.Size]byte
+		)
+		if err := json.Unmarshal(body, &request); err == nil && request.Method != "" {
+			if !l.matcher.matches(request.Method) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			method = request.Method
+			digest = sha256.Sum256(request.Params)
+		} else {
+			// Requests that aren't JSON-RPC calls, such as the profile route
+			// of the admin API, are recorded by path.
+			if !l.matcher.matchesPath(r.URL.Path) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			method = r.Method
+			digest = sha256.Sum256(append([]byte(r.URL.RawQuery), body...))
+		}
+
+		start := l.now()
+		recorder := &responseRecorder{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+		handler.ServeHTTP(recorder, r)
+
+		record := Record{
+			Time:            start.UTC(),
+			Caller:          r.RemoteAddr,
+			ForwardedFor:    r.Header.Get(forwardedForHeader),
+			Endpoint:        r.URL.Path,
+			Method:          method,
+			ArgumentsDigest: hex.EncodeToString(digest[:]),
+			Result:          resultSuccess,
+			StatusCode:      recorder.statusCode,
+			ResponseSize:    recorder.size,
+			Latency:         l.now().Sub(start),
+		}
+		if errMsg, failed := responseError(recorder.statusCode, recorder.body.Bytes()); failed {
+			record.Result = resultError
+			record.Error = errMsg
+		}
+		l.write(&record)
+	})
+}
+
+// write doesn't report failures to the caller, as the call being recorded has
+// already been handled.
+func (l *Log) write(record *Record) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	_, _ = l.writer.Write(append(b, '\n'))
+}
+
+func (l *Log) Close() error {
+	return l.writer.Close()
+}
+
+// responseError returns the error reported by the response, if any.
+func responseError(statusCode int, body []byte) (string, bool) {
+	if statusCode != http.StatusOK {
+		return string(bytes.TrimSpace(body)), true
+	}
+
+	var response struct {
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil || response.Error == nil {
+		return "", false
+	}
+	return response.Error.Message, true
+}
+
+// responseRecorder keeps a copy of the start of the response written to the
+// caller, up to [maxRecordedResponseSize] bytes.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	size       int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if remaining := maxRecordedResponseSize - r.body.Len(); remaining > 0 {
+		_, _ = r.body.Write(b[:min(len(b), remaining)])
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.size += n
+	return n, err
+}
+
+// Unwrap allows [http.ResponseController] to reach the underlying writer, for
+// example to extend the write deadline of a long running call.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const errorResponse = `{"jsonrpc":"2.0","error":{"code":-32000,"message":"failed"},"id":1}`
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		classes  []string
+		method   string
+		expected bool
+	}{
+		{
+			classes:  []string{AdminClass},
+			method:   "admin.aliasChain",
+			expected: true,
+		},
+		{
+			classes:  []string{AdminClass},
+			method:   "platform.issueTx",
+			expected: false,
+		},
+		{
+			classes:  []string{IssueClass},
+			method:   "platform.issueTx",
+			expected: true,
+		},
+		{
+			classes:  []string{IssueClass},
+			method:   "wallet.send",
+			expected: true,
+		},
+		{
+			classes:  []string{IssueClass},
+			method:   "platform.scheduleTx",
+			expected: true,
+		},
+		{
+			classes:  []string{IssueClass},
+			method:   "info.getNodeID",
+			expected: false,
+		},
+		{
+			classes:  []string{AllClass},
+			method:   "info.getNodeID",
+			expected: true,
+		},
+		{
+			classes:  nil,
+			method:   "admin.aliasChain",
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.method, func(t *testing.T) {
+			require.Equal(t, test.expected, newMatcher(test.classes).matches(test.method))
+		})
+	}
+}
+
+func TestMatcherPath(t *testing.T) {
+	tests := []struct {
+		classes  []string
+		path     string
+		expected bool
+	}{
+		{
+			classes:  []string{AdminClass},
+			path:     "/ext/admin",
+			expected: true,
+		},
+		{
+			classes:  []string{AdminClass},
+			path:     "/ext/admin/profile",
+			expected: true,
+		},
+		{
+			classes:  []string{AdminClass},
+			path:     "/ext/administrator",
+			expected: false,
+		},
+		{
+			classes:  []string{IssueClass},
+			path:     "/ext/admin/profile",
+			expected: false,
+		},
+		{
+			classes:  []string{AllClass},
+			path:     "/ext/metrics",
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			require.Equal(t, test.expected, newMatcher(test.classes).matchesPath(test.path))
+		})
+	}
+}
+
+func TestConfigVerify(t *testing.T) {
+	require := require.New(t)
+
+	config := Config{
+		Classes: []string{AdminClass, IssueClass, AllClass},
+	}
+	require.NoError(config.Verify())
+
+	config.Classes = append(config.Classes, "unknown")
+	err := config.Verify()
+	require.ErrorIs(err, errUnknownClass)
+}
+
+func TestWrap(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		output bytes.Buffer
+		now    = time.Unix(1_700_000_000, 0)
+		log    = newLog(Config{Classes: []string{AdminClass}}, nopCloser{Writer: &output})
+	)
+	log.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	handler := log.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		if strings.Contains(string(body), "fail") {
+			_, _ = w.Write([]byte(errorResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","result":{},"id":1}`))
+	}))
+
+	const params = `{"chain":"fail"}`
+	requests := []string{
+		`{"jsonrpc":"2.0","method":"admin.aliasChain","params":{"chain":"X"},"id":1}`,
+		`{"jsonrpc":"2.0","method":"admin.aliasChain","params":` + params + `,"id":1}`,
+		`{"jsonrpc":"2.0","method":"info.getNodeID","params":{},"id":1}`,
+	}
+	for _, body := range requests {
+		request := httptest.NewRequest(http.MethodPost, "/ext/admin", strings.NewReader(body))
+		request.Header.Set(forwardedForHeader, "1.2.3.4")
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(lines, 2)
+
+	var records [2]Record
+	for i, line := range lines {
+		require.NoError(json.Unmarshal([]byte(line), &records[i]))
+	}
+	require.Equal(resultSuccess, records[0].Result)
+	require.Empty(records[0].Error)
+
+	digest := sha256.Sum256([]byte(params))
+	require.Equal(Record{
+		Time:            time.Unix(1_700_000_003, 0).UTC(),
+		Caller:          "192.0.2.1:1234",
+		ForwardedFor:    "1.2.3.4",
+		Endpoint:        "/ext/admin",
+		Method:          "admin.aliasChain",
+		ArgumentsDigest: hex.EncodeToString(digest[:]),
+		Result:          resultError,
+		Error:           "failed",
+		StatusCode:      http.StatusOK,
+		ResponseSize:    len(errorResponse),
+		Latency:         time.Second,
+	}, records[1])
+}
+
+func TestWrapPath(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		output bytes.Buffer
+		now    = time.Unix(1_700_000_000, 0)
+		log    = newLog(Config{Classes: []string{AdminClass}}, nopCloser{Writer: &output})
+		// The response is larger than the recorded part of the response.
+		response = make([]byte, 2*maxRecordedResponseSize)
+	)
+	log.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	handler := log.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") == "unknown" {
+			http.Error(w, "unknown profile type", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write(response)
+	}))
+
+	requests := []string{
+		"/ext/admin/profile?type=heap",
+		"/ext/admin/profile?type=unknown",
+		"/ext/administrator",
+		"/ext/info",
+	}
+	for _, path := range requests {
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(lines, 2)
+
+	var records [2]Record
+	for i, line := range lines {
+		require.NoError(json.Unmarshal([]byte(line), &records[i]))
+	}
+
+	digest := sha256.Sum256([]byte("type=heap"))
+	require.Equal(Record{
+		Time:            time.Unix(1_700_000_001, 0).UTC(),
+		Caller:          "192.0.2.1:1234",
+		Endpoint:        "/ext/admin/profile",
+		Method:          http.MethodGet,
+		ArgumentsDigest: hex.EncodeToString(digest[:]),
+		Result:          resultSuccess,
+		StatusCode:      http.StatusOK,
+		ResponseSize:    len(response),
+		Latency:         time.Second,
+	}, records[0])
+
+	require.Equal(resultError, records[1].Result)
+	require.Equal("unknown profile type", records[1].Error)
+	require.Equal(http.StatusBadRequest, records[1].StatusCode)
+}
+
+func TestResponseErrorStatusCode(t *testing.T) {
+	require := require.New(t)
+
+	errMsg, failed := responseError(http.StatusServiceUnavailable, []byte("chain is not done bootstrapping\n"))
+	require.True(failed)
+	require.Equal("chain is not done bootstrapping", errMsg)
+}
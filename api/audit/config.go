@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// AdminClass contains every method of the admin API, including the
+	// routes of the admin API that aren't JSON-RPC methods.
+	AdminClass = "admin"
+	// IssueClass contains every method that issues a transaction, which are
+	// the methods named issueTx, the methods managing scheduled transactions
+	// and every method of the wallet API.
+	IssueClass = "issue"
+	// AllClass contains every method.
+	AllClass = "all"
+
+	adminNamespace  = "admin"
+	adminPath       = "/ext/admin"
+	walletNamespace = "wallet"
+	issuePrefix     = "issue"
+
+	scheduleTxMethod        = "scheduleTx"
+	cancelScheduledTxMethod = "cancelScheduledTx"
+)
+
+var errUnknownClass = errors.New("unknown method class")
+
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Classes of the methods whose calls are recorded.
+	Classes []string `json:"classes"`
+}
+
+// Verify returns an error if any of the configured classes is unknown.
+func (c *Config) Verify() error {
+	for _, class := range c.Classes {
+		switch class {
+		case AdminClass, IssueClass, AllClass:
+		default:
+			return fmt.Errorf("%w: %q", errUnknownClass, class)
+		}
+	}
+	return nil
+}
+
+// matcher reports whether calls to a JSON-RPC method, or to a route that isn't
+// JSON-RPC, are recorded.
+type matcher struct {
+	admin bool
+	issue bool
+	all   bool
+}
+
+func newMatcher(classes []string) matcher {
+	var m matcher
+	for _, class := range classes {
+		switch class {
+		case AdminClass:
+			m.admin = true
+		case IssueClass:
+			m.issue = true
+		case AllClass:
+			m.all = true
+		}
+	}
+	return m
+}
+
+// matches expects [method] to be formatted as "namespace.method".
+func (m matcher) matches(method string) bool {
+	if m.all {
+		return true
+	}
+	namespace, name, _ := strings.Cut(method, ".")
+	switch {
+	case m.admin && namespace == adminNamespace:
+		return true
+	case m.issue && (namespace == walletNamespace || strings.HasPrefix(name, issuePrefix)):
+		return true
+	case m.issue && (name == scheduleTxMethod || name == cancelScheduledTxMethod):
+		return true
+	default:
+		return false
+	}
+}
+
+// matchesPath reports whether requests to [path] that aren't JSON-RPC calls are
+// recorded.
+func (m matcher) matchesPath(path string) bool {
+	if m.all {
+		return true
+	}
+	return m.admin && (path == adminPath || strings.HasPrefix(path, adminPath+"/"))
+}
@@ -41,6 +41,13 @@ func (c *Client) Liveness(ctx context.Context, tags []string, options ...rpc.Opt
 	return res, err
 }
 
+// History returns the recent times checks started passing or failing
+func (c *Client) History(ctx context.Context, tags []string, options ...rpc.Option) ([]Transition, error) {
+	res := &HistoryReply{}
+	err := c.Requester.SendRequest(ctx, "health.history", &APIArgs{Tags: tags}, res, options...)
+	return res.Transitions, err
+}
+
 // AwaitReady polls the node every [freq] until the node reports ready.
 // Only returns an error if [ctx] returns an error.
 func AwaitReady(ctx context.Context, c *Client, freq time.Duration, tags []string, options ...rpc.Option) (bool, error) {
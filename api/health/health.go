@@ -5,6 +5,7 @@ package health
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -49,6 +50,10 @@ type Registerer interface {
 	RegisterReadinessCheck(name string, checker Checker, tags ...string) error
 	RegisterHealthCheck(name string, checker Checker, tags ...string) error
 	RegisterLivenessCheck(name string, checker Checker, tags ...string) error
+
+	DeregisterReadinessCheck(name string) error
+	DeregisterHealthCheck(name string) error
+	DeregisterLivenessCheck(name string) error
 }
 
 // Reporter returns the current health status.
@@ -56,6 +61,9 @@ type Reporter interface {
 	Readiness(tags ...string) (map[string]Result, bool)
 	Health(tags ...string) (map[string]Result, bool)
 	Liveness(tags ...string) (map[string]Result, bool)
+	// History returns the recent transitions of the readiness, health and
+	// liveness checks with any of [tags], from oldest to newest.
+	History(tags ...string) []Transition
 }
 
 type health struct {
@@ -65,7 +73,9 @@ type health struct {
 	liveness  *worker
 }
 
-func New(log logging.Logger, registerer prometheus.Registerer) (Health, error) {
+// New returns a health service that notifies [notifiers] whenever a check
+// starts passing or failing.
+func New(log logging.Logger, registerer prometheus.Registerer, notifiers ...Notifier) (Health, error) {
 	failingChecks := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "checks_failing",
@@ -75,9 +85,9 @@ func New(log logging.Logger, registerer prometheus.Registerer) (Health, error) {
 	)
 	return &health{
 		log:       log,
-		readiness: newWorker(log, "readiness", failingChecks),
-		health:    newWorker(log, "health", failingChecks),
-		liveness:  newWorker(log, "liveness", failingChecks),
+		readiness: newWorker(log, "readiness", failingChecks, notifiers),
+		health:    newWorker(log, "health", failingChecks, notifiers),
+		liveness:  newWorker(log, "liveness", failingChecks, notifiers),
 	}, registerer.Register(failingChecks)
 }
 
@@ -93,6 +103,18 @@ func (h *health) RegisterLivenessCheck(name string, checker Checker, tags ...str
 	return h.liveness.RegisterCheck(name, checker, tags...)
 }
 
+func (h *health) DeregisterReadinessCheck(name string) error {
+	return h.readiness.DeregisterCheck(name)
+}
+
+func (h *health) DeregisterHealthCheck(name string) error {
+	return h.health.DeregisterCheck(name)
+}
+
+func (h *health) DeregisterLivenessCheck(name string) error {
+	return h.liveness.DeregisterCheck(name)
+}
+
 func (h *health) Readiness(tags ...string) (map[string]Result, bool) {
 	results, healthy := h.readiness.Results(tags...)
 	if !healthy {
@@ -126,6 +148,18 @@ func (h *health) Liveness(tags ...string) (map[string]Result, bool) {
 	return results, healthy
 }
 
+func (h *health) History(tags ...string) []Transition {
+	history := slices.Concat(
+		h.readiness.History(tags...),
+		h.health.History(tags...),
+		h.liveness.History(tags...),
+	)
+	slices.SortStableFunc(history, func(a, b Transition) int {
+		return a.Time.Compare(b.Time)
+	})
+	return history
+}
+
 func (h *health) Start(ctx context.Context, freq time.Duration) {
 	h.readiness.Start(ctx, freq)
 	h.health.Start(ctx, freq)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/MetalBlockchain/metalgo/utils"
@@ -70,6 +71,53 @@ func TestDuplicatedRegistrations(t *testing.T) {
 	require.ErrorIs(err, errDuplicateCheck)
 }
 
+func TestDeregistration(t *testing.T) {
+	require := require.New(t)
+
+	passing := CheckerFunc(func(context.Context) (interface{}, error) {
+		return "", nil
+	})
+	failing := CheckerFunc(func(context.Context) (interface{}, error) {
+		return errUnhealthy.Error(), errUnhealthy
+	})
+
+	registry := prometheus.NewRegistry()
+	h, err := New(logging.NoLog{}, registry)
+	require.NoError(err)
+
+	require.NoError(h.RegisterHealthCheck("passing", passing, "tag"))
+	require.NoError(h.RegisterHealthCheck("failing", failing, "tag"))
+
+	h.Start(context.Background(), checkFreq)
+	defer h.Stop()
+
+	awaitHealthy(t, h, false)
+
+	require.NoError(h.DeregisterHealthCheck("failing"))
+	err = h.DeregisterHealthCheck("failing")
+	require.ErrorIs(err, errUnknownCheck)
+
+	awaitHealthy(t, h, true)
+
+	results, healthy := h.Health("tag")
+	require.True(healthy)
+	require.Len(results, 1)
+	require.Contains(results, "passing")
+
+	failingChecks := h.(*health).health.failingChecks
+	for _, tag := range []string{AllTag, "tag"} {
+		gauge := failingChecks.With(prometheus.Labels{
+			CheckLabel: "health",
+			TagLabel:   tag,
+		})
+		require.Zero(testutil.ToFloat64(gauge))
+	}
+
+	// A deregistered check can be registered again.
+	require.NoError(h.RegisterHealthCheck("failing", failing))
+	awaitHealthy(t, h, false)
+}
+
 func TestDefaultFailing(t *testing.T) {
 	require := require.New(t)
 
@@ -390,3 +438,95 @@ func TestTags(t *testing.T) {
 		require.False(health)
 	}
 }
+
+func TestWarningChecks(t *testing.T) {
+	require := require.New(t)
+
+	failing := CheckerFunc(func(context.Context) (interface{}, error) {
+		return errUnhealthy.Error(), errUnhealthy
+	})
+
+	h, err := New(logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+
+	require.NoError(h.RegisterReadinessCheck("check", WithSeverity(failing, Warning)))
+	require.NoError(h.RegisterHealthCheck("check", WithSeverity(failing, Warning)))
+
+	// Checks that haven't been run yet report their severity
+	healthResult, _ := h.Health()
+	require.Equal(Warning, healthResult["check"].Severity)
+
+	h.Start(context.Background(), checkFreq)
+	defer h.Stop()
+
+	require.Eventually(func() bool {
+		healthResult, _ := h.Health()
+		return healthResult["check"].ContiguousFailures > 0
+	}, awaitTimeout, awaitFreq)
+
+	readinessResult, readiness := h.Readiness()
+	require.Equal(Warning, readinessResult["check"].Severity)
+	require.NotNil(readinessResult["check"].Error)
+	require.True(readiness)
+
+	healthResult, health := h.Health()
+	require.Equal(Warning, healthResult["check"].Severity)
+	require.NotNil(healthResult["check"].Error)
+	require.True(health)
+
+	require.NoError(h.RegisterHealthCheck("critical", failing))
+	healthResult, health = h.Health()
+	require.Equal(Critical, healthResult["critical"].Severity)
+	require.False(health)
+}
+
+type testNotifier chan Transition
+
+func (n testNotifier) Notify(transition Transition) {
+	n <- transition
+}
+
+func TestHistory(t *testing.T) {
+	require := require.New(t)
+
+	var shouldCheckErr utils.Atomic[bool]
+	check := CheckerFunc(func(context.Context) (interface{}, error) {
+		if shouldCheckErr.Get() {
+			return nil, errUnhealthy
+		}
+		return nil, nil
+	})
+
+	notifier := make(testNotifier, 1)
+	h, err := New(logging.NoLog{}, prometheus.NewRegistry(), notifier)
+	require.NoError(err)
+
+	require.NoError(h.RegisterHealthCheck("check", check, "tag"))
+
+	h.Start(context.Background(), checkFreq)
+	defer h.Stop()
+
+	// Passing for the first time isn't a transition
+	awaitHealthy(t, h, true)
+	require.Empty(h.History())
+
+	shouldCheckErr.Set(true)
+	failed := <-notifier
+	require.Equal("health", failed.Namespace)
+	require.Equal("check", failed.Check)
+	require.Equal(Critical, failed.Severity)
+	require.Equal([]string{"tag"}, failed.Tags)
+	require.False(failed.Healthy)
+	require.Equal(errUnhealthy.Error(), *failed.Error)
+
+	shouldCheckErr.Set(false)
+	passed := <-notifier
+	require.True(passed.Healthy)
+	require.Nil(passed.Error)
+	require.True(passed.Time.After(failed.Time))
+
+	require.Equal([]Transition{failed, passed}, h.History("tag")[:2])
+	require.Empty(h.History("otherTag"))
+	require.NoError(h.DeregisterHealthCheck("check"))
+	require.Empty(h.History())
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package health
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+const (
+	webhookQueueSize = 256
+	webhookTimeout   = 10 * time.Second
+)
+
+var (
+	errUnexpectedStatusCode = errors.New("unexpected status code")
+
+	_ Notifier = (*Webhook)(nil)
+)
+
+// Transition is a change in whether a check is passing.
+type Transition struct {
+	// Namespace of the check: readiness, health or liveness.
+	Namespace string    `json:"namespace"`
+	Check     string    `json:"check"`
+	Severity  Severity  `json:"severity"`
+	Tags      []string  `json:"tags,omitempty"`
+	Time      time.Time `json:"time"`
+	// Healthy is true if the check started passing and false if it started
+	// failing.
+	Healthy bool `json:"healthy"`
+	// Error returned by the check if it started failing.
+	Error *string `json:"error,omitempty"`
+}
+
+// Notifier is notified of every transition of the health checks.
+//
+// Notify is called while the results of the checks are locked, so it must not
+// block.
+type Notifier interface {
+	Notify(transition Transition)
+}
+
+// Webhook is a notifier that POSTs every transition, JSON encoded, to a URL.
+// Transitions are delivered in order. If the URL can't keep up, transitions
+// are dropped.
+type Webhook struct {
+	log         logging.Logger
+	url         string
+	client      *http.Client
+	transitions chan Transition
+
+	closeOnce sync.Once
+	closer    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewWebhook returns a notifier that POSTs transitions to [url] until it is
+// closed.
+func NewWebhook(log logging.Logger, url string) *Webhook {
+	w := &Webhook{
+		log: log,
+		url: url,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+		transitions: make(chan Transition, webhookQueueSize),
+		closer:      make(chan struct{}),
+	}
+	w.wg.Add(1)
+	go w.dispatch()
+	return w
+}
+
+func (w *Webhook) Notify(transition Transition) {
+	select {
+	case w.transitions <- transition:
+	default:
+		w.log.Warn("dropping health check transition",
+			zap.String("reason", "webhook queue is full"),
+			zap.String("namespace", transition.Namespace),
+			zap.String("check", transition.Check),
+		)
+	}
+}
+
+// Close stops delivering transitions. Transitions that haven't been delivered
+// yet are dropped.
+func (w *Webhook) Close() {
+	w.closeOnce.Do(func() {
+		close(w.closer)
+		w.wg.Wait()
+	})
+}
+
+func (w *Webhook) dispatch() {
+	defer w.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-w.closer
+		cancel()
+	}()
+
+	for {
+		select {
+		case transition := <-w.transitions:
+			if err := w.post(ctx, transition); err != nil {
+				w.log.Warn("failed to notify webhook of health check transition",
+					zap.String("namespace", transition.Namespace),
+					zap.String("check", transition.Check),
+					zap.Error(err),
+				)
+			}
+		case <-w.closer:
+			return
+		}
+	}
+}
+
+func (w *Webhook) post(ctx context.Context, transition Transition) error {
+	body, err := json.Marshal(transition)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %d", errUnexpectedStatusCode, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+func TestWebhook(t *testing.T) {
+	require := require.New(t)
+
+	received := make(chan Transition)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(http.MethodPost, r.Method)
+		require.Equal("application/json", r.Header.Get("Content-Type"))
+
+		var transition Transition
+		require.NoError(json.NewDecoder(r.Body).Decode(&transition))
+		received <- transition
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(logging.NoLog{}, server.URL)
+	defer webhook.Close()
+
+	errString := errUnhealthy.Error()
+	expected := []Transition{
+		{
+			Namespace: "health",
+			Check:     "network",
+			Severity:  Critical,
+			Time:      time.Unix(1_700_000_000, 0).UTC(),
+			Error:     &errString,
+		},
+		{
+			Namespace: "health",
+			Check:     "network",
+			Severity:  Critical,
+			Time:      time.Unix(1_700_000_001, 0).UTC(),
+			Healthy:   true,
+		},
+	}
+	for _, transition := range expected {
+		webhook.Notify(transition)
+	}
+	for _, transition := range expected {
+		require.Equal(transition, <-received)
+	}
+}
+
+func TestWebhookUnexpectedStatusCode(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(logging.NoLog{}, server.URL)
+	defer webhook.Close()
+
+	err := webhook.post(context.Background(), Transition{})
+	require.ErrorIs(err, errUnexpectedStatusCode)
+}
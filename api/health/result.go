@@ -12,7 +12,8 @@ var notYetRunResult Result
 func init() {
 	err := "not yet run"
 	notYetRunResult = Result{
-		Error: &err,
+		Severity: Critical,
+		Error:    &err,
 	}
 }
 
@@ -20,6 +21,10 @@ type Result struct {
 	// Details of the HealthCheck.
 	Details interface{} `json:"message,omitempty"`
 
+	// Severity of the HealthCheck. A failing HealthCheck only makes the node
+	// unhealthy if it is critical.
+	Severity Severity `json:"severity,omitempty"`
+
 	// Error is the string representation of the error returned by the failing
 	// HealthCheck. The value is nil if the check passed.
 	Error *string `json:"error,omitempty"`
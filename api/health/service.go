@@ -27,6 +27,22 @@ type APIArgs struct {
 	Tags []string `json:"tags"`
 }
 
+// HistoryReply is the response for History.
+type HistoryReply struct {
+	Transitions []Transition `json:"transitions"`
+}
+
+// History returns the recent times checks started passing or failing
+func (s *Service) History(_ *http.Request, args *APIArgs, reply *HistoryReply) error {
+	s.log.Debug("API called",
+		zap.String("service", "health"),
+		zap.String("method", "history"),
+		zap.Strings("tags", args.Tags),
+	)
+	reply.Transitions = s.health.History(args.Tags...)
+	return nil
+}
+
 // Readiness returns if the node has finished initialization
 func (s *Service) Readiness(_ *http.Request, args *APIArgs, reply *APIReply) error {
 	s.log.Debug("API called",
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package health
+
+import "context"
+
+const (
+	// Critical checks make the node unhealthy when they fail. Checks are
+	// critical unless they are registered with a different severity.
+	Critical Severity = "critical"
+	// Warning checks are reported when they fail but don't make the node
+	// unhealthy.
+	Warning Severity = "warning"
+)
+
+var _ Checker = (*severityChecker)(nil)
+
+// Severity describes the impact of a failing check on the health of the node.
+type Severity string
+
+type severityChecker struct {
+	checker  Checker
+	severity Severity
+}
+
+// WithSeverity returns a checker that reports the results of [checker] with
+// [severity].
+func WithSeverity(checker Checker, severity Severity) Checker {
+	return &severityChecker{
+		checker:  checker,
+		severity: severity,
+	}
+}
+
+func (c *severityChecker) HealthCheck(ctx context.Context) (interface{}, error) {
+	return c.checker.HealthCheck(ctx)
+}
+
+func severityOf(checker Checker) Severity {
+	if c, ok := checker.(*severityChecker); ok {
+		return c.severity
+	}
+	return Critical
+}
@@ -20,22 +20,28 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/set"
 )
 
+// maxHistorySize is the number of transitions kept per check.
+const maxHistorySize = 64
+
 var (
 	allTags = []string{AllTag}
 
 	errRestrictedTag  = errors.New("restricted tag")
 	errDuplicateCheck = errors.New("duplicate check")
+	errUnknownCheck   = errors.New("unknown check")
 )
 
 type worker struct {
 	log           logging.Logger
 	name          string
 	failingChecks *prometheus.GaugeVec
+	notifiers     []Notifier
 	checksLock    sync.RWMutex
 	checks        map[string]*taggedChecker
 
 	resultsLock                 sync.RWMutex
 	results                     map[string]Result
+	history                     map[string][]Transition // check name -> transitions
 	numFailingApplicationChecks int
 	tags                        map[string]set.Set[string] // tag -> set of check names
 
@@ -48,6 +54,7 @@ type worker struct {
 type taggedChecker struct {
 	checker            Checker
 	isApplicationCheck bool
+	severity           Severity
 	tags               []string
 }
 
@@ -55,6 +62,7 @@ func newWorker(
 	log logging.Logger,
 	name string,
 	failingChecks *prometheus.GaugeVec,
+	notifiers []Notifier,
 ) *worker {
 	// Initialize the number of failing checks to 0 for all checks
 	for _, tag := range []string{AllTag, ApplicationTag} {
@@ -67,8 +75,10 @@ func newWorker(
 		log:           log,
 		name:          name,
 		failingChecks: failingChecks,
+		notifiers:     notifiers,
 		checks:        make(map[string]*taggedChecker),
 		results:       make(map[string]Result),
+		history:       make(map[string][]Transition),
 		closer:        make(chan struct{}),
 		tags:          make(map[string]set.Set[string]),
 	}
@@ -106,10 +116,13 @@ func (w *worker) RegisterCheck(name string, check Checker, tags ...string) error
 	tc := &taggedChecker{
 		checker:            check,
 		isApplicationCheck: applicationChecks.Contains(name),
+		severity:           severityOf(check),
 		tags:               tags,
 	}
 	w.checks[name] = tc
-	w.results[name] = notYetRunResult
+	result := notYetRunResult
+	result.Severity = tc.severity
+	w.results[name] = result
 
 	// Whenever a new check is added - it is failing
 	w.log.Info("registered new check and initialized its state to failing",
@@ -126,7 +139,7 @@ func (w *worker) RegisterCheck(name string, check Checker, tags ...string) error
 
 func (w *worker) RegisterMonotonicCheck(name string, checker Checker, tags ...string) error {
 	var result utils.Atomic[any]
-	return w.RegisterCheck(name, CheckerFunc(func(ctx context.Context) (any, error) {
+	monotonicChecker := CheckerFunc(func(ctx context.Context) (any, error) {
 		details := result.Get()
 		if details != nil {
 			return details, nil
@@ -137,13 +150,97 @@ func (w *worker) RegisterMonotonicCheck(name string, checker Checker, tags ...st
 			result.Set(details)
 		}
 		return details, err
-	}), tags...)
+	})
+	return w.RegisterCheck(name, WithSeverity(monotonicChecker, severityOf(checker)), tags...)
+}
+
+func (w *worker) DeregisterCheck(name string) error {
+	w.checksLock.Lock()
+	defer w.checksLock.Unlock()
+
+	tc, ok := w.checks[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", errUnknownCheck, name)
+	}
+
+	w.resultsLock.Lock()
+	defer w.resultsLock.Unlock()
+
+	// If the check is currently failing, it must no longer be included in the
+	// failing check metrics.
+	if w.results[name].Error != nil {
+		w.updateMetrics(tc, true /*=healthy*/, false /*=register*/)
+	}
+
+	allNames := w.tags[AllTag]
+	allNames.Remove(name)
+	for _, tag := range tc.tags {
+		names := w.tags[tag]
+		names.Remove(name)
+		if names.Len() > 0 {
+			continue
+		}
+
+		// If this was the last check with this tag, the currently failing
+		// application-wide checks are no longer reported under this tag.
+		delete(w.tags, tag)
+		if tag != ApplicationTag {
+			w.failingChecks.With(prometheus.Labels{
+				CheckLabel: w.name,
+				TagLabel:   tag,
+			}).Sub(float64(w.numFailingApplicationChecks))
+		}
+	}
+	delete(w.checks, name)
+	delete(w.results, name)
+	delete(w.history, name)
+
+	w.log.Info("deregistered check",
+		zap.String("name", w.name),
+		zap.String("check", name),
+		zap.Strings("tags", tc.tags),
+	)
+	return nil
 }
 
 func (w *worker) Results(tags ...string) (map[string]Result, bool) {
 	w.resultsLock.RLock()
 	defer w.resultsLock.RUnlock()
 
+	names := w.names(tags...)
+	results := make(map[string]Result, names.Len())
+	healthy := true
+	for name := range names {
+		if result, ok := w.results[name]; ok {
+			results[name] = result
+			// Failing warning checks don't make the node unhealthy.
+			healthy = healthy && (result.Error == nil || result.Severity == Warning)
+		}
+	}
+	return results, healthy
+}
+
+// History returns the transitions of the checks with any of [tags], from
+// oldest to newest.
+func (w *worker) History(tags ...string) []Transition {
+	w.resultsLock.RLock()
+	defer w.resultsLock.RUnlock()
+
+	var history []Transition
+	for name := range w.names(tags...) {
+		history = append(history, w.history[name]...)
+	}
+	slices.SortStableFunc(history, func(a, b Transition) int {
+		return a.Time.Compare(b.Time)
+	})
+	return history
+}
+
+// names returns the names of the checks with any of [tags]. If no tags are
+// provided, every check is returned.
+//
+// Assumes [w.resultsLock] is held.
+func (w *worker) names(tags ...string) set.Set[string] {
 	// if no tags are specified, return all checks
 	if len(tags) == 0 {
 		tags = allTags
@@ -157,16 +254,7 @@ func (w *worker) Results(tags ...string) (map[string]Result, bool) {
 			names.Union(set)
 		}
 	}
-
-	results := make(map[string]Result, names.Len())
-	healthy := true
-	for name := range names {
-		if result, ok := w.results[name]; ok {
-			results[name] = result
-			healthy = healthy && result.Error == nil
-		}
-	}
-	return results, healthy
+	return names
 }
 
 func (w *worker) Start(ctx context.Context, freq time.Duration) {
@@ -230,13 +318,18 @@ func (w *worker) runCheck(ctx context.Context, wg *sync.WaitGroup, name string,
 
 	result := Result{
 		Details:   details,
+		Severity:  check.severity,
 		Timestamp: end,
 		Duration:  end.Sub(start),
 	}
 
 	w.resultsLock.Lock()
 	defer w.resultsLock.Unlock()
-	prevResult := w.results[name]
+	prevResult, ok := w.results[name]
+	if !ok {
+		// The check was deregistered while it was running.
+		return
+	}
 	if err != nil {
 		errString := err.Error()
 		result.Error = &errString
@@ -257,6 +350,12 @@ func (w *worker) runCheck(ctx context.Context, wg *sync.WaitGroup, name string,
 			)
 			w.updateMetrics(check, false /*=healthy*/, false /*=register*/)
 		}
+
+		// A check that hasn't been run yet is reported as failing, so its first
+		// failure is also a transition.
+		if prevResult.Error == nil || prevResult.Timestamp.IsZero() {
+			w.recordTransition(name, check, result)
+		}
 	} else if prevResult.Error != nil {
 		w.log.Info("check started passing",
 			zap.String("name", w.name),
@@ -264,10 +363,41 @@ func (w *worker) runCheck(ctx context.Context, wg *sync.WaitGroup, name string,
 			zap.Strings("tags", check.tags),
 		)
 		w.updateMetrics(check, true /*=healthy*/, false /*=register*/)
+
+		// Passing for the first time isn't a transition worth reporting.
+		if !prevResult.Timestamp.IsZero() {
+			w.recordTransition(name, check, result)
+		}
 	}
 	w.results[name] = result
 }
 
+// recordTransition adds the transition of check [name] to [result] to its
+// history and notifies the notifiers.
+//
+// Assumes [w.resultsLock] is held.
+func (w *worker) recordTransition(name string, check *taggedChecker, result Result) {
+	transition := Transition{
+		Namespace: w.name,
+		Check:     name,
+		Severity:  check.severity,
+		Tags:      check.tags,
+		Time:      result.Timestamp,
+		Healthy:   result.Error == nil,
+		Error:     result.Error,
+	}
+
+	history := append(w.history[name], transition)
+	if len(history) > maxHistorySize {
+		history = history[1:]
+	}
+	w.history[name] = history
+
+	for _, notifier := range w.notifiers {
+		notifier.Notify(transition)
+	}
+}
+
 // updateMetrics updates the metrics for the given check. If [healthy] is true,
 // then the check is considered healthy and the metrics are decremented.
 // Otherwise, the check is considered unhealthy and the metrics are incremented.
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils/rpc"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
@@ -84,6 +85,14 @@ func (c *Client) Upgrades(ctx context.Context, options ...rpc.Option) (*upgrade.
 	return res, err
 }
 
+func (c *Client) GetValidatorPerformance(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]performance.Validator, error) {
+	res := &GetValidatorPerformanceReply{}
+	err := c.Requester.SendRequest(ctx, "info.getValidatorPerformance", &GetValidatorPerformanceArgs{
+		NodeIDs: nodeIDs,
+	}, res, options...)
+	return res.Validators, err
+}
+
 func (c *Client) Uptime(ctx context.Context, options ...rpc.Option) (*UptimeResponse, error) {
 	res := &UptimeResponse{}
 	err := c.Requester.SendRequest(ctx, "info.uptime", struct{}{}, res, options...)
@@ -17,6 +17,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/network"
 	"github.com/MetalBlockchain/metalgo/network/peer"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils"
@@ -75,6 +76,7 @@ type Info struct {
 	chainManager chains.Manager
 	vmManager    vms.Manager
 	benchlist    benchlist.Manager
+	performance  performance.Tracker
 }
 
 type Parameters struct {
@@ -98,6 +100,7 @@ func NewService(
 	myIP *utils.Atomic[netip.AddrPort],
 	network network.Network,
 	benchlist benchlist.Manager,
+	performance performance.Tracker,
 ) (http.Handler, error) {
 	server := rpc.NewServer()
 	codec := json.NewCodec()
@@ -113,6 +116,7 @@ func NewService(
 			myIP:         myIP,
 			networking:   network,
 			benchlist:    benchlist,
+			performance:  performance,
 		},
 		"info",
 	)
@@ -360,6 +364,45 @@ func (i *Info) Uptime(_ *http.Request, _ *struct{}, reply *UptimeResponse) error
 	return nil
 }
 
+// GetValidatorPerformanceArgs are the arguments for calling
+// GetValidatorPerformance
+type GetValidatorPerformanceArgs struct {
+	// NodeIDs of the validators to report. If empty, every validator this node
+	// has recorded the performance of is reported.
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// GetValidatorPerformanceReply are the results from calling
+// GetValidatorPerformance
+type GetValidatorPerformanceReply struct {
+	Validators []performance.Validator `json:"validators"`
+}
+
+// GetValidatorPerformance returns how the validators have responded to the
+// requests this node sent them, when they were benched and their daily uptime
+// samples.
+func (i *Info) GetValidatorPerformance(_ *http.Request, args *GetValidatorPerformanceArgs, reply *GetValidatorPerformanceReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getValidatorPerformance"),
+	)
+
+	nodeIDs := args.NodeIDs
+	if len(nodeIDs) == 0 {
+		nodeIDs = i.performance.NodeIDs()
+	}
+
+	reply.Validators = make([]performance.Validator, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		validator, ok := i.performance.Performance(nodeID)
+		if !ok {
+			continue
+		}
+		reply.Validators = append(reply.Validators, validator)
+	}
+	return nil
+}
+
 type ACP struct {
 	SupportWeight json.Uint64         `json:"supportWeight"`
 	Supporters    set.Set[ids.NodeID] `json:"supporters"`
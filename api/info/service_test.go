@@ -6,11 +6,19 @@ package info
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/MetalBlockchain/metalgo/database/memdb"
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
+	"github.com/MetalBlockchain/metalgo/snow/uptime"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/json"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/vms/vmsmock"
 )
@@ -92,3 +100,46 @@ func TestGetVMsGetAliasesFails(t *testing.T) {
 	err := resources.info.GetVMs(nil, nil, &reply)
 	require.ErrorIs(t, err, errTest)
 }
+
+func TestGetValidatorPerformance(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		vdrs    = validators.NewManager()
+		tracker = performance.NewTracker(logging.NoLog{}, vdrs, uptime.NoOpCalculator, memdb.New())
+		chainID = ids.GenerateTestID()
+		nodeID0 = ids.BuildTestNodeID([]byte{0})
+		nodeID1 = ids.BuildTestNodeID([]byte{1})
+	)
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID0, nil, ids.Empty, 1))
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID1, nil, ids.Empty, 1))
+	tracker.RegisterResponse(chainID, nodeID0, message.ChitsOp, time.Millisecond)
+	tracker.RegisterTimeout(chainID, nodeID1, message.ChitsOp)
+
+	info := &Info{
+		log:         logging.NoLog{},
+		performance: tracker,
+	}
+
+	reply := GetValidatorPerformanceReply{}
+	require.NoError(info.GetValidatorPerformance(nil, &GetValidatorPerformanceArgs{}, &reply))
+	require.Len(reply.Validators, 2)
+	require.Equal(nodeID0, reply.Validators[0].NodeID)
+	require.Equal(json.Float64(1), reply.Validators[0].Chains[0].PollResponseRate)
+	require.Equal(nodeID1, reply.Validators[1].NodeID)
+	require.Zero(reply.Validators[1].Chains[0].PollResponseRate)
+
+	reply = GetValidatorPerformanceReply{}
+	require.NoError(info.GetValidatorPerformance(
+		nil,
+		&GetValidatorPerformanceArgs{
+			NodeIDs: []ids.NodeID{
+				nodeID1,
+				ids.GenerateTestNodeID(),
+			},
+		},
+		&reply,
+	))
+	require.Len(reply.Validators, 1)
+	require.Equal(nodeID1, reply.Validators[0].NodeID)
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/MetalBlockchain/metalgo/utils/set"
 )
@@ -18,21 +19,12 @@ var _ http.Handler = (*allowedHostsHandler)(nil)
 func filterInvalidHosts(
 	handler http.Handler,
 	allowed []string,
-) http.Handler {
-	s := set.Set[string]{}
-
-	for _, host := range allowed {
-		if host == wildcard {
-			// wildcards match all hostnames, so just return the base handler
-			return handler
-		}
-		s.Add(strings.ToLower(host))
-	}
-
-	return &allowedHostsHandler{
+) *allowedHostsHandler {
+	a := &allowedHostsHandler{
 		handler: handler,
-		hosts:   s,
 	}
+	a.setAllowedHosts(allowed)
+	return a
 }
 
 // allowedHostsHandler is an implementation of http.Handler that validates the
@@ -42,7 +34,41 @@ func filterInvalidHosts(
 // not.
 type allowedHostsHandler struct {
 	handler http.Handler
-	hosts   set.Set[string]
+
+	lock sync.RWMutex
+	// allowAll is true if the wildcard is allowed, in which case all
+	// hostnames are accepted.
+	allowAll bool
+	hosts    set.Set[string]
+}
+
+// setAllowedHosts replaces the hostnames that requests are accepted from.
+func (a *allowedHostsHandler) setAllowedHosts(allowed []string) {
+	var (
+		allowAll bool
+		hosts    = set.Set[string]{}
+	)
+	for _, host := range allowed {
+		if host == wildcard {
+			// wildcards match all hostnames
+			allowAll = true
+			break
+		}
+		hosts.Add(strings.ToLower(host))
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.allowAll = allowAll
+	a.hosts = hosts
+}
+
+func (a *allowedHostsHandler) isAllowed(host string) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.allowAll || a.hosts.Contains(strings.ToLower(host))
 }
 
 func (a *allowedHostsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -67,7 +93,7 @@ func (a *allowedHostsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	// a specific hostname - we need to check the whitelist to see if we should
 	// accept this r
-	if a.hosts.Contains(strings.ToLower(host)) {
+	if a.isAllowed(host) {
 		a.handler.ServeHTTP(w, r)
 		return
 	}
@@ -102,6 +102,14 @@ func (r *router) AddHeaderRoute(route string, handler http.Handler) bool {
 	return true
 }
 
+// RemoveHeaderRoute removes the handler of [route], if one exists.
+func (r *router) RemoveHeaderRoute(route string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.headerRoutes, route)
+}
+
 func (r *router) AddRouter(base, endpoint string, handler http.Handler) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -150,6 +158,36 @@ func (r *router) forceAddRouter(base, endpoint string, handler http.Handler) err
 	return err
 }
 
+// RemoveRouter removes all the endpoints registered under [base], including the
+// endpoints registered under the aliases of [base]. The aliases themselves
+// remain reserved so that they are applied again if [base] is re-added.
+func (r *router) RemoveRouter(base string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.routeLock.Lock()
+	defer r.routeLock.Unlock()
+
+	r.removeRouter(base)
+
+	// The underlying router doesn't support removing routes, so it is rebuilt
+	// from the remaining routes.
+	router := mux.NewRouter()
+	for base, endpoints := range r.routes {
+		for endpoint, handler := range endpoints {
+			url := base + endpoint
+			router.Handle(url, handler).Name(url)
+		}
+	}
+	r.router = router
+}
+
+func (r *router) removeRouter(base string) {
+	delete(r.routes, base)
+	for _, alias := range r.aliases[base] {
+		r.removeRouter(alias)
+	}
+}
+
 func (r *router) AddAlias(base string, aliases ...string) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
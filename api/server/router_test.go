@@ -48,6 +48,38 @@ func TestAliasing(t *testing.T) {
 	require.Equal(handler1, handler)
 }
 
+func TestRemoveRouter(t *testing.T) {
+	require := require.New(t)
+
+	r := newRouter()
+	require.NoError(r.AddAlias("/1", "/2"))
+
+	handler1 := &testHandler{}
+	require.NoError(r.AddRouter("/1", "/a", handler1))
+	handler3 := &testHandler{}
+	require.NoError(r.AddRouter("/3", "/b", handler3))
+
+	r.RemoveRouter("/1")
+
+	_, err := r.GetHandler("/1", "/a")
+	require.ErrorIs(err, errUnknownBaseURL)
+	_, err = r.GetHandler("/2", "/a")
+	require.ErrorIs(err, errUnknownBaseURL)
+	require.Nil(r.router.Get("/1/a"))
+	require.Nil(r.router.Get("/2/a"))
+
+	handler, err := r.GetHandler("/3", "/b")
+	require.NoError(err)
+	require.Equal(handler3, handler)
+	require.NotNil(r.router.Get("/3/b"))
+
+	// The endpoints can be registered again, including under the alias.
+	require.NoError(r.AddRouter("/1", "/a", handler1))
+	handler, err = r.GetHandler("/2", "/a")
+	require.NoError(err)
+	require.Equal(handler1, handler)
+}
+
 func TestBlock(t *testing.T) {
 	require := require.New(t)
 	r := newRouter()
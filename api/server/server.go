@@ -20,6 +20,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/MetalBlockchain/metalgo/api"
+	"github.com/MetalBlockchain/metalgo/api/audit"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow"
 	"github.com/MetalBlockchain/metalgo/snow/engine/common"
@@ -66,6 +67,11 @@ type Server interface {
 	// That is, add <route, handler> pairs to server so that API calls can be
 	// made to the VM.
 	RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM)
+	// DeregisterChain removes the API endpoints associated with this chain.
+	DeregisterChain(ctx *snow.ConsensusContext)
+	// SetAllowedHosts replaces the hostnames that API requests are accepted
+	// from.
+	SetAllowedHosts(allowedHosts []string)
 	// Shutdown this server
 	Shutdown() error
 }
@@ -88,9 +94,15 @@ type server struct {
 
 	metrics *metrics
 
+	// Records privileged calls, if non-nil
+	auditLog *audit.Log
+
 	// Maps endpoints to handlers
 	router *router
 
+	// Validates the host header of incoming requests
+	allowedHosts *allowedHostsHandler
+
 	srv *http.Server
 
 	// Listener used to serve traffic
@@ -109,6 +121,7 @@ func New(
 	registerer prometheus.Registerer,
 	httpConfig HTTPConfig,
 	allowedHosts []string,
+	auditLog *audit.Log,
 ) (Server, error) {
 	m, err := newMetrics(registerer)
 	if err != nil {
@@ -116,7 +129,8 @@ func New(
 	}
 
 	router := newRouter()
-	handler := wrapHandler(router, nodeID, allowedOrigins, allowedHosts)
+	allowedHostsHandler := filterInvalidHosts(router, allowedHosts)
+	handler := wrapHandler(allowedHostsHandler, nodeID, allowedOrigins)
 
 	httpServer := &http.Server{
 		Handler: h2c.NewHandler(
@@ -140,7 +154,9 @@ func New(
 		tracingEnabled:  tracingEnabled,
 		tracer:          tracer,
 		metrics:         m,
+		auditLog:        auditLog,
 		router:          router,
+		allowedHosts:    allowedHostsHandler,
 		srv:             httpServer,
 		listener:        listener,
 	}, nil
@@ -211,6 +227,23 @@ func (s *server) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 	}
 }
 
+func (s *server) DeregisterChain(ctx *snow.ConsensusContext) {
+	defaultEndpoint := path.Join(constants.ChainAliasPrefix, ctx.ChainID.String())
+	url := fmt.Sprintf("%s/%s", baseURL, defaultEndpoint)
+	s.log.Info("removing routes",
+		zap.String("url", url),
+	)
+	s.router.RemoveRouter(url)
+	s.router.RemoveHeaderRoute(ctx.ChainID.String())
+}
+
+func (s *server) SetAllowedHosts(allowedHosts []string) {
+	s.allowedHosts.setAllowedHosts(allowedHosts)
+	s.log.Info("updated allowed hosts",
+		zap.Strings("allowedHosts", allowedHosts),
+	)
+}
+
 func (s *server) addChainRoute(chainName string, handler http.Handler, ctx *snow.ConsensusContext, base, endpoint string) error {
 	url := fmt.Sprintf("%s/%s", baseURL, base)
 	s.log.Info("adding route",
@@ -227,6 +260,9 @@ func (s *server) wrapMiddleware(chainName string, handler http.Handler, ctx *sno
 	}
 	// Apply middleware to reject calls to the handler before the chain finishes bootstrapping
 	handler = rejectMiddleware(handler, ctx)
+	if s.auditLog != nil {
+		handler = s.auditLog.Wrap(handler)
+	}
 	return s.metrics.wrapHandler(chainName, handler)
 }
 
@@ -250,6 +286,9 @@ func (s *server) addRoute(handler http.Handler, base, endpoint string) error {
 	if s.tracingEnabled {
 		handler = api.TraceHandler(handler, url, s.tracer)
 	}
+	if s.auditLog != nil {
+		handler = s.auditLog.Wrap(handler)
+	}
 
 	handler = s.metrics.wrapHandler(base, handler)
 	return s.router.AddRouter(url, endpoint, handler)
@@ -321,13 +360,11 @@ func wrapHandler(
 	handler http.Handler,
 	nodeID ids.NodeID,
 	allowedOrigins []string,
-	allowedHosts []string,
 ) http.Handler {
-	h := filterInvalidHosts(handler, allowedHosts)
-	h = cors.New(cors.Options{
+	h := cors.New(cors.Options{
 		AllowedOrigins:   allowedOrigins,
 		AllowCredentials: true,
-	}).Handler(h)
+	}).Handler(handler)
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// Attach this node's ID as a header
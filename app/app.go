@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/MetalBlockchain/metalgo/node"
+	"github.com/MetalBlockchain/metalgo/trace/otlp"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/perms"
@@ -42,9 +43,13 @@ type App interface {
 	// ExitCode should only be called after [Start] returns. It
 	// should block until the application finishes
 	ExitCode() int
+
+	// ReloadConfig re-reads the config of the application and applies the
+	// settings that can be updated while it is running.
+	ReloadConfig() error
 }
 
-func New(config nodeconfig.Config) (App, error) {
+func New(config nodeconfig.Config, loadConfig node.ConfigLoader) (App, error) {
 	// Set the data directory permissions to be read write.
 	if err := perms.ChmodR(config.DatabaseConfig.Path, true, perms.ReadWriteExecute); err != nil {
 		return nil, fmt.Errorf("failed to restrict the permissions of the database directory with: %w", err)
@@ -53,10 +58,26 @@ func New(config nodeconfig.Config) (App, error) {
 		return nil, fmt.Errorf("failed to restrict the permissions of the log directory with: %w", err)
 	}
 
+	var logExporter *otlp.LogExporter
+	if config.OTLPConfig.LogsEnabled {
+		var err error
+		logExporter, err = otlp.NewLogExporter(config.OTLPConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize log exporter: %w", err)
+		}
+		config.LoggingConfig.Exporter = logExporter
+	}
+
 	logFactory := logging.NewFactory(config.LoggingConfig)
+	closeLogs := func() {
+		logFactory.Close()
+		if logExporter != nil {
+			_ = logExporter.Close()
+		}
+	}
 	log, err := logFactory.Make("main")
 	if err != nil {
-		logFactory.Close()
+		closeLogs()
 		return nil, fmt.Errorf("failed to initialize log: %w", err)
 	}
 
@@ -66,22 +87,23 @@ func New(config nodeconfig.Config) (App, error) {
 		log.Fatal("failed to set fd-limit",
 			zap.Error(err),
 		)
-		logFactory.Close()
+		closeLogs()
 		return nil, err
 	}
 
-	n, err := node.New(&config, logFactory, log)
+	n, err := node.New(&config, logFactory, log, loadConfig)
 	if err != nil {
 		log.Fatal("failed to initialize node", zap.Error(err))
 		log.Stop()
-		logFactory.Close()
+		closeLogs()
 		return nil, fmt.Errorf("failed to initialize node: %w", err)
 	}
 
 	return &app{
-		node:       n,
-		log:        log,
-		logFactory: logFactory,
+		node:        n,
+		log:         log,
+		logFactory:  logFactory,
+		logExporter: logExporter,
 	}, nil
 }
 
@@ -96,6 +118,9 @@ func Run(app App) int {
 	stackTraceSignal := make(chan os.Signal, 1)
 	signal.Notify(stackTraceSignal, syscall.SIGABRT)
 
+	reloadSignal := make(chan os.Signal, 1)
+	signal.Notify(reloadSignal, syscall.SIGHUP)
+
 	// start up a new go routine to handle attempts to kill the application
 	go func() {
 		for range terminationSignals {
@@ -112,6 +137,14 @@ func Run(app App) int {
 		}
 	}()
 
+	// start a goroutine to listen on SIGHUP signals, to reload the config.
+	// Failures are logged by the application.
+	go func() {
+		for range reloadSignal {
+			_ = app.ReloadConfig()
+		}
+	}()
+
 	// wait for the app to exit and get the exit code response
 	exitCode := app.ExitCode()
 
@@ -123,6 +156,10 @@ func Run(app App) int {
 	signal.Stop(stackTraceSignal)
 	close(stackTraceSignal)
 
+	// shut down the config reload go routine
+	signal.Stop(reloadSignal)
+	close(reloadSignal)
+
 	// return the exit code that the application reported
 	return exitCode
 }
@@ -132,7 +169,9 @@ type app struct {
 	node       *node.Node
 	log        logging.Logger
 	logFactory logging.Factory
-	exitWG     sync.WaitGroup
+	// Pushes the log records over OTLP, if enabled
+	logExporter *otlp.LogExporter
+	exitWG      sync.WaitGroup
 }
 
 // Start the business logic of the node (as opposed to config reading, etc).
@@ -147,6 +186,9 @@ func (a *app) Start() {
 			}
 			a.log.Stop()
 			a.logFactory.Close()
+			if a.logExporter != nil {
+				_ = a.logExporter.Close()
+			}
 			a.exitWG.Done()
 		}()
 		defer func() {
@@ -175,3 +217,16 @@ func (a *app) ExitCode() int {
 	a.exitWG.Wait()
 	return a.node.ExitCode()
 }
+
+// ReloadConfig re-reads the config of the node and applies the settings that
+// can be updated while the node is running.
+func (a *app) ReloadConfig() error {
+	a.log.Info("reloading config")
+	if err := a.node.ReloadConfig(); err != nil {
+		a.log.Error("failed to reload config",
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
@@ -4,12 +4,16 @@
 package chains
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"errors"
 	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,6 +44,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/networking/router"
 	"github.com/MetalBlockchain/metalgo/snow/networking/sender"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/staking"
 	"github.com/MetalBlockchain/metalgo/subnets"
@@ -61,6 +66,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/proposervm"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 	"github.com/MetalBlockchain/metalgo/vms/tracedvm"
+	"github.com/MetalBlockchain/metalgo/x/blockdb"
 
 	p2ppb "github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	smcon "github.com/MetalBlockchain/metalgo/snow/consensus/snowman"
@@ -69,6 +75,7 @@ import (
 	avagetter "github.com/MetalBlockchain/metalgo/snow/engine/avalanche/getter"
 	smeng "github.com/MetalBlockchain/metalgo/snow/engine/snowman"
 	smbootstrap "github.com/MetalBlockchain/metalgo/snow/engine/snowman/bootstrap"
+	smarchive "github.com/MetalBlockchain/metalgo/snow/engine/snowman/bootstrap/archive"
 	snowgetter "github.com/MetalBlockchain/metalgo/snow/engine/snowman/getter"
 	timetracker "github.com/MetalBlockchain/metalgo/snow/networking/tracker"
 )
@@ -79,6 +86,10 @@ const (
 	defaultChannelSize = 1
 	initialQueueSize   = 3
 
+	// stopChainTimeout is the maximum amount of time to wait for the chains of
+	// an untracked subnet to shut down.
+	stopChainTimeout = time.Minute
+
 	avalancheNamespace    = constants.PlatformName + metric.NamespaceSeparator + "metal"
 	handlerNamespace      = constants.PlatformName + metric.NamespaceSeparator + "handler"
 	meterchainvmNamespace = constants.PlatformName + metric.NamespaceSeparator + "meterchainvm"
@@ -106,6 +117,9 @@ var (
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
 	errPartialSyncAsAValidator = errors.New("partial sync should not be configured for a validator")
+	errTrackingPrimaryNetwork  = errors.New("the primary network is always tracked")
+	errNoSubnetTracker         = errors.New("subnet tracker not initialized")
+	errUnknownChain            = errors.New("unknown chain")
 
 	fxs = map[ids.ID]fx.Factory{
 		secp256k1fx.ID: &secp256k1fx.Factory{},
@@ -147,10 +161,29 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Returns the recent consensus events of the chain with the given ID.
+	ConsensusEvents(ids.ID) (*recorder.Dump, error)
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
 
+	// Starts tracking the subnet and queues the creation of its chains.
+	TrackSubnet(subnetID ids.ID) error
+
+	// Stops tracking the subnet and stops all of its chains.
+	UntrackSubnet(subnetID ids.ID) error
+
+	// Replaces the chain and subnet configs. Running chains whose config
+	// changed are reconfigured if their VM implements
+	// [common.ReconfigurableVM]. Returns [ErrRunningSubnetConfigChanged],
+	// without applying any of the configs, if the config of a running subnet
+	// would change.
+	SetConfigs(
+		chainConfigs map[string]ChainConfig,
+		subnetConfigs map[ids.ID]subnets.Config,
+	) error
+
 	Shutdown()
 }
 
@@ -172,9 +205,20 @@ type ChainParameters struct {
 
 type chain struct {
 	Name    string
+	VMID    ids.ID
 	Context *snow.ConsensusContext
 	VM      common.VM
 	Handler handler.Handler
+
+	// ConfigBytes is the chain config the VM is currently using. It is only
+	// accessed while holding the manager's trackingLock.
+	ConfigBytes []byte
+	// ReconfigurableVM is nil if the VM doesn't support updating its config.
+	ReconfigurableVM common.ReconfigurableVM
+
+	// ValidatorListeners are unregistered from the validator manager when the
+	// chain is stopped.
+	ValidatorListeners []validators.SetCallbackListener
 }
 
 // ChainConfig is configuration settings for the current execution.
@@ -226,6 +270,9 @@ type ManagerConfig struct {
 
 	FrontierPollFrequency   time.Duration
 	ConsensusAppConcurrency int
+	// Number of recent consensus events each chain keeps in memory. If 0,
+	// consensus events are not recorded.
+	ConsensusRecorderSize int
 
 	// Max Time to spend fetching a container and its
 	// ancestors when responding to a GetAncestors
@@ -235,6 +282,11 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// If non-empty, snowman chains read the blocks to bootstrap from this
+	// archive before requesting them from peers. This is either a directory
+	// containing a blockdb per chainID or an HTTP URL serving the blocks of
+	// each chain under /<chainID>.
+	BootstrapArchive string
 
 	Upgrades upgrade.Config
 
@@ -269,7 +321,18 @@ type manager struct {
 	chainsLock sync.Mutex
 	// Key: Chain's ID
 	// Value: The chain
-	chains map[ids.ID]handler.Handler
+	chains map[ids.ID]*chain
+
+	// trackingLock is held while creating a chain or while tracking or
+	// untracking a subnet.
+	trackingLock sync.Mutex
+	// subnetTracker is the P-chain, which is used to start and stop tracking
+	// subnets.
+	subnetTracker SubnetTracker
+	// Key: Subnet's ID
+	// Value: The aliases of the health checks of the chains of the subnet that
+	// failed to be created
+	failedChains map[ids.ID][]string
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
@@ -330,7 +393,8 @@ func New(config *ManagerConfig) (Manager, error) {
 	return &manager{
 		Aliaser:                ids.NewAliaser(),
 		ManagerConfig:          *config,
-		chains:                 make(map[ids.ID]handler.Handler),
+		chains:                 make(map[ids.ID]*chain),
+		failedChains:           make(map[ids.ID][]string),
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
@@ -375,14 +439,26 @@ func (m *manager) QueueChainCreation(chainParams ChainParameters) {
 // Note: it is expected for the subnet to already have the chain registered as
 // bootstrapping before this function is called
 func (m *manager) createChain(chainParams ChainParameters) {
+	m.trackingLock.Lock()
+	defer m.trackingLock.Unlock()
+
+	sb, ok := m.Subnets.Get(chainParams.SubnetID)
+	if !ok {
+		m.Log.Info("skipping chain creation",
+			zap.String("reason", "subnet is no longer tracked"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+		)
+		return
+	}
+
 	m.Log.Info("creating chain",
 		zap.Stringer("subnetID", chainParams.SubnetID),
 		zap.Stringer("chainID", chainParams.ID),
 		zap.Stringer("vmID", chainParams.VMID),
 	)
 
-	sb, _ := m.Subnets.GetOrCreate(chainParams.SubnetID)
-
 	// Note: buildChain builds all chain's relevant objects (notably engine and handler)
 	// but does not start their operations. Starting of the handler (which could potentially
 	// issue some internal messages), is delayed until chain dispatching is started and
@@ -431,22 +507,27 @@ func (m *manager) createChain(chainParams ChainParameters) {
 				zap.Stringer("vmID", chainParams.VMID),
 				zap.Error(err),
 			)
+			return
 		}
+		m.failedChains[chainParams.SubnetID] = append(m.failedChains[chainParams.SubnetID], chainAlias)
 		return
 	}
 
 	m.chainsLock.Lock()
-	m.chains[chainParams.ID] = chain.Handler
+	m.chains[chainParams.ID] = chain
 	m.chainsLock.Unlock()
 
-	// Associate the newly created chain with its default alias
-	if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
-		m.Log.Error("failed to alias the new chain with itself",
-			zap.Stringer("subnetID", chainParams.SubnetID),
-			zap.Stringer("chainID", chainParams.ID),
-			zap.Stringer("vmID", chainParams.VMID),
-			zap.Error(err),
-		)
+	// Associate the newly created chain with its default alias. If the chain
+	// was previously stopped, it is already aliased with itself.
+	if _, err := m.Lookup(chainParams.ID.String()); err != nil {
+		if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
+			m.Log.Error("failed to alias the new chain with itself",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+		}
 	}
 
 	// Notify those who registered to be notified when a new chain is created
@@ -493,6 +574,11 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		return nil, fmt.Errorf("error while creating chain's log %w", err)
 	}
 
+	consensusRecorder, err := recorder.New(m.ConsensusRecorderSize)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating chain's consensus recorder %w", err)
+	}
+
 	ctx := &snow.ConsensusContext{
 		Context: &snow.Context{
 			NetworkID:       m.NetworkID,
@@ -521,6 +607,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		BlockAcceptor:  m.BlockAcceptorGroup,
 		TxAcceptor:     m.TxAcceptorGroup,
 		VertexAcceptor: m.VertexAcceptorGroup,
+		Recorder:       consensusRecorder,
 	}
 
 	// The Banff timestamp for mainnet c-chain needs to change, no way around this
@@ -611,6 +698,8 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		return nil, err
 	}
 
+	chain.VMID = chainParams.VMID
+	chain.ReconfigurableVM, _ = vm.(common.ReconfigurableVM)
 	return chain, errors.Join(
 		m.snowmanGatherer.Register(primaryAlias, ctx.Registerer),
 		vmGatherer.Register(primaryAlias, ctx.Metrics),
@@ -767,7 +856,7 @@ func (m *manager) createAvalancheChain(
 	// Initialize the ProposerVM and the vm wrapped inside it
 	var (
 		// A default subnet configuration will be present if explicit configuration is not provided
-		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
+		subnetCfg           = sb.Config()
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
 	)
@@ -920,6 +1009,9 @@ func (m *manager) createAvalancheChain(
 	if err != nil {
 		return nil, fmt.Errorf("error initializing network handler: %w", err)
 	}
+	if m.TracingEnabled {
+		h = handler.Trace(h, m.Tracer)
+	}
 
 	connectedBeacons := tracker.NewPeers()
 	startupTracker := tracker.NewStartup(connectedBeacons, (3*bootstrapWeight+3)/4)
@@ -964,6 +1056,11 @@ func (m *manager) createAvalancheChain(
 		snowmanEngine = common.TraceEngine(snowmanEngine, m.Tracer)
 	}
 
+	bootstrapArchive, closeBootstrapArchive, err := m.newBootstrapArchive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing bootstrap archive: %w", err)
+	}
+
 	// create bootstrap gear
 	bootstrapCfg := smbootstrap.Config{
 		Haltable:                       &halter,
@@ -977,8 +1074,10 @@ func (m *manager) createAvalancheChain(
 		BootstrapTracker:               sb,
 		PeerTracker:                    peerTracker,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		Archive:                        bootstrapArchive,
 		DB:                             blockBootstrappingDB,
 		VM:                             vmWrappingProposerVM,
+		Bootstrapped:                   closeBootstrapArchive,
 	}
 	var snowmanBootstrapper common.BootstrapableEngine
 	snowmanBootstrapper, err = smbootstrap.New(
@@ -986,6 +1085,7 @@ func (m *manager) createAvalancheChain(
 		snowmanEngine.Start,
 	)
 	if err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("error initializing snowman bootstrapper: %w", err)
 	}
 
@@ -1002,6 +1102,7 @@ func (m *manager) createAvalancheChain(
 		avalancheMetrics,
 	)
 	if err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("couldn't initialize avalanche base message handler: %w", err)
 	}
 
@@ -1036,6 +1137,7 @@ func (m *manager) createAvalancheChain(
 		avalancheMetrics,
 	)
 	if err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("error initializing avalanche bootstrapper: %w", err)
 	}
 
@@ -1058,14 +1160,20 @@ func (m *manager) createAvalancheChain(
 
 	// Register health check for this chain
 	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
 
 	return &chain{
-		Name:    primaryAlias,
-		Context: ctx,
-		VM:      dagVM,
-		Handler: h,
+		Name:        primaryAlias,
+		Context:     ctx,
+		VM:          dagVM,
+		Handler:     h,
+		ConfigBytes: chainConfig.Config,
+		ValidatorListeners: []validators.SetCallbackListener{
+			connectedValidators,
+			startupTracker,
+		},
 	}, nil
 }
 
@@ -1143,6 +1251,13 @@ func (m *manager) createSnowmanChain(
 		// P-chain.
 		ctx.ValidatorState = valState
 
+		if tracker, ok := vm.(SubnetTracker); ok {
+			m.subnetTracker = &lockedSubnetTracker{
+				lock:    &ctx.Lock,
+				tracker: tracker,
+			}
+		}
+
 		// Initialize the validator state for future chains.
 		m.validatorState = validators.NewLockedState(&ctx.Lock, valState)
 		if m.TracingEnabled {
@@ -1171,7 +1286,7 @@ func (m *manager) createSnowmanChain(
 
 	var (
 		// A default subnet configuration will be present if explicit configuration is not provided
-		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
+		subnetCfg           = sb.Config()
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
 	)
@@ -1313,6 +1428,9 @@ func (m *manager) createSnowmanChain(
 	if err != nil {
 		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
 	}
+	if m.TracingEnabled {
+		h = handler.Trace(h, m.Tracer)
+	}
 
 	connectedBeacons := tracker.NewPeers()
 	startupTracker := tracker.NewStartup(connectedBeacons, (3*bootstrapWeight+3)/4)
@@ -1358,6 +1476,18 @@ func (m *manager) createSnowmanChain(
 		engine = common.TraceEngine(engine, m.Tracer)
 	}
 
+	bootstrapArchive, closeBootstrapArchive, err := m.newBootstrapArchive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing bootstrap archive: %w", err)
+	}
+	onBootstrapped := bootstrapFunc
+	bootstrapFunc = func() {
+		if onBootstrapped != nil {
+			onBootstrapped()
+		}
+		closeBootstrapArchive()
+	}
+
 	// create bootstrap gear
 	bootstrapCfg := smbootstrap.Config{
 		Haltable:                       &halter,
@@ -1371,6 +1501,7 @@ func (m *manager) createSnowmanChain(
 		BootstrapTracker:               sb,
 		PeerTracker:                    peerTracker,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		Archive:                        bootstrapArchive,
 		DB:                             bootstrappingDB,
 		VM:                             vm,
 		Bootstrapped:                   bootstrapFunc,
@@ -1381,6 +1512,7 @@ func (m *manager) createSnowmanChain(
 		engine.Start,
 	)
 	if err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("error initializing snowman bootstrapper: %w", err)
 	}
 
@@ -1401,6 +1533,7 @@ func (m *manager) createSnowmanChain(
 		vm,
 	)
 	if err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("couldn't initialize state syncer configuration: %w", err)
 	}
 	stateSyncer := syncer.New(
@@ -1423,14 +1556,20 @@ func (m *manager) createSnowmanChain(
 
 	// Register health checks
 	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
+		closeBootstrapArchive()
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
 
 	return &chain{
-		Name:    primaryAlias,
-		Context: ctx,
-		VM:      vm,
-		Handler: h,
+		Name:        primaryAlias,
+		Context:     ctx,
+		VM:          vm,
+		Handler:     h,
+		ConfigBytes: chainConfig.Config,
+		ValidatorListeners: []validators.SetCallbackListener{
+			connectedValidators,
+			startupTracker,
+		},
 	}, nil
 }
 
@@ -1442,7 +1581,26 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 		return false
 	}
 
-	return chain.Context().State.Get().State == snow.NormalOp
+	return chain.Context.State.Get().State == snow.NormalOp
+}
+
+func (m *manager) ConsensusEvents(id ids.ID) (*recorder.Dump, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[id]
+	m.chainsLock.Unlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", errUnknownChain, id)
+	}
+
+	ctx := chain.Context
+	return &recorder.Dump{
+		Version:  recorder.Version,
+		NodeID:   ctx.NodeID,
+		ChainID:  ctx.ChainID,
+		SubnetID: ctx.SubnetID,
+		DumpedAt: time.Now(),
+		Events:   ctx.Recorder.Events(),
+	}, nil
 }
 
 func (m *manager) registerBootstrappedHealthChecks() error {
@@ -1528,6 +1686,204 @@ func (m *manager) dispatchChainCreator() {
 	}
 }
 
+func (m *manager) TrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID {
+		return errTrackingPrimaryNetwork
+	}
+
+	m.trackingLock.Lock()
+	defer m.trackingLock.Unlock()
+
+	if m.subnetTracker == nil {
+		return errNoSubnetTracker
+	}
+	if err := m.subnetTracker.TrackSubnet(subnetID); err != nil {
+		return fmt.Errorf("couldn't track subnet %s: %w", subnetID, err)
+	}
+
+	m.Log.Info("tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+	)
+	return nil
+}
+
+func (m *manager) UntrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID {
+		return errTrackingPrimaryNetwork
+	}
+
+	m.trackingLock.Lock()
+	defer m.trackingLock.Unlock()
+
+	if m.subnetTracker == nil {
+		return errNoSubnetTracker
+	}
+	if err := m.subnetTracker.UntrackSubnet(subnetID); err != nil {
+		return fmt.Errorf("couldn't untrack subnet %s: %w", subnetID, err)
+	}
+
+	// Removing the subnet causes any of its chains that are still queued to be
+	// skipped.
+	m.Subnets.Remove(subnetID)
+
+	m.chainsLock.Lock()
+	var stopped []*chain
+	for chainID, chain := range m.chains {
+		if chain.Context.SubnetID != subnetID {
+			continue
+		}
+		delete(m.chains, chainID)
+		stopped = append(stopped, chain)
+	}
+	m.chainsLock.Unlock()
+
+	for _, chain := range stopped {
+		m.stopChain(chain)
+	}
+
+	for _, chainAlias := range m.failedChains[subnetID] {
+		if err := m.Health.DeregisterHealthCheck(chainAlias); err != nil {
+			m.Log.Warn("failed to deregister failing health check",
+				zap.Stringer("subnetID", subnetID),
+				zap.String("chainAlias", chainAlias),
+				zap.Error(err),
+			)
+		}
+	}
+	delete(m.failedChains, subnetID)
+
+	m.Log.Info("stopped tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numStoppedChains", len(stopped)),
+	)
+	return nil
+}
+
+func (m *manager) SetConfigs(
+	chainConfigs map[string]ChainConfig,
+	subnetConfigs map[ids.ID]subnets.Config,
+) error {
+	m.trackingLock.Lock()
+	defer m.trackingLock.Unlock()
+
+	if err := m.Subnets.SetConfigs(subnetConfigs); err != nil {
+		return err
+	}
+	m.SubnetConfigs = subnetConfigs
+	m.ChainConfigs = chainConfigs
+
+	m.chainsLock.Lock()
+	chains := make([]*chain, 0, len(m.chains))
+	for _, chain := range m.chains {
+		chains = append(chains, chain)
+	}
+	m.chainsLock.Unlock()
+
+	var errs []error
+	for _, chain := range chains {
+		chainConfig, err := m.getChainConfig(chain.Context.ChainID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if bytes.Equal(chainConfig.Config, chain.ConfigBytes) {
+			continue
+		}
+		if chain.ReconfigurableVM == nil {
+			m.warnNotReconfigurable(chain)
+			continue
+		}
+
+		chain.Context.Lock.Lock()
+		err = chain.ReconfigurableVM.Reconfigure(context.TODO(), chainConfig.Config)
+		chain.Context.Lock.Unlock()
+		if errors.Is(err, common.ErrReconfigurableVMNotImplemented) {
+			// Plugin VMs are only known not to support reconfiguration once
+			// the call is made.
+			m.warnNotReconfigurable(chain)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("couldn't reconfigure chain %s: %w", chain.Name, err))
+			continue
+		}
+
+		chain.ConfigBytes = chainConfig.Config
+		m.Log.Info("reconfigured chain",
+			zap.Stringer("chainID", chain.Context.ChainID),
+			zap.String("chainAlias", chain.Name),
+		)
+	}
+	return errors.Join(errs...)
+}
+
+func (m *manager) warnNotReconfigurable(chain *chain) {
+	m.Log.Warn("chain config changed but the VM doesn't support reconfiguration",
+		zap.Stringer("chainID", chain.Context.ChainID),
+		zap.String("chainAlias", chain.Name),
+	)
+}
+
+// stopChain shuts down the chain and releases everything that was registered
+// when the chain was created.
+func (m *manager) stopChain(chain *chain) {
+	ctx := chain.Context
+	m.Log.Info("stopping chain",
+		zap.Stringer("subnetID", ctx.SubnetID),
+		zap.Stringer("chainID", ctx.ChainID),
+		zap.String("chainAlias", chain.Name),
+	)
+
+	// Stopping the handler shuts down the VM and removes the chain from the
+	// router.
+	chain.Handler.Stop(context.TODO())
+
+	stopCtx, cancel := context.WithTimeout(context.TODO(), stopChainTimeout)
+	_, err := chain.Handler.AwaitStopped(stopCtx)
+	cancel()
+	if err != nil {
+		m.Log.Warn("timed out while stopping chain",
+			zap.Stringer("chainID", ctx.ChainID),
+			zap.Error(err),
+		)
+	}
+
+	for _, registrant := range m.registrants {
+		registrant.DeregisterChain(ctx)
+	}
+
+	for _, listener := range chain.ValidatorListeners {
+		m.Validators.UnregisterSetCallbackListener(ctx.SubnetID, listener)
+	}
+
+	m.avalancheGatherer.Deregister(chain.Name)
+	m.handlerGatherer.Deregister(chain.Name)
+	m.meterChainVMGatherer.Deregister(chain.Name)
+	m.meterDAGVMGatherer.Deregister(chain.Name)
+	m.proposervmGatherer.Deregister(chain.Name)
+	m.p2pGatherer.Deregister(chain.Name)
+	m.snowmanGatherer.Deregister(chain.Name)
+	m.stakeGatherer.Deregister(chain.Name)
+	if vmGatherer, ok := m.vmGatherer[chain.VMID]; ok {
+		vmGatherer.Deregister(chain.Name)
+	}
+	m.MeterDBMetrics.Deregister(chain.Name)
+
+	if err := m.Health.DeregisterHealthCheck(chain.Name); err != nil {
+		m.Log.Warn("failed to deregister chain health check",
+			zap.Stringer("chainID", ctx.ChainID),
+			zap.Error(err),
+		)
+	}
+
+	if err := m.LogFactory.CloseLogger(chain.Name); err != nil {
+		m.Log.Warn("failed to close chain logger",
+			zap.Stringer("chainID", ctx.ChainID),
+			zap.Error(err),
+		)
+	}
+}
+
 // Shutdown stops all the chains
 func (m *manager) Shutdown() {
 	m.Log.Info("shutting down chain manager")
@@ -1588,3 +1944,43 @@ func (m *manager) getOrMakeVMGatherer(vmID ids.ID) (metrics.MultiGatherer, error
 	m.vmGatherer[vmID] = vmGatherer
 	return vmGatherer, nil
 }
+
+// newBootstrapArchive returns the archive that the chain should be bootstrapped
+// from, if any. The returned function releases the archive and should be
+// called once the chain has finished bootstrapping.
+func (m *manager) newBootstrapArchive(ctx *snow.ConsensusContext) (smarchive.Archive, func(), error) {
+	noop := func() {}
+	if m.BootstrapArchive == "" {
+		return nil, noop, nil
+	}
+
+	chainID := ctx.ChainID.String()
+	if strings.HasPrefix(m.BootstrapArchive, "http://") || strings.HasPrefix(m.BootstrapArchive, "https://") {
+		uri, err := url.JoinPath(m.BootstrapArchive, chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return smarchive.NewClient(uri), noop, nil
+	}
+
+	dir := filepath.Join(m.BootstrapArchive, chainID)
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		ctx.Log.Info("no bootstrap archive found for chain",
+			zap.String("dir", dir),
+		)
+		return nil, noop, nil
+	}
+
+	db, err := blockdb.New(blockdb.DefaultConfig().WithDir(dir), ctx.Log)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeArchive := func() {
+		if err := db.Close(); err != nil {
+			ctx.Log.Warn("failed to close bootstrap archive",
+				zap.Error(err),
+			)
+		}
+	}
+	return smarchive.NewBlockDB(db), closeArchive, nil
+}
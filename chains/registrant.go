@@ -14,4 +14,8 @@ type Registrant interface {
 	// This function is called before the chain starts processing messages
 	// [vm] should be a vertex.DAGVM or block.ChainVM
 	RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM)
+
+	// Called after a chain has been stopped because its subnet is no longer
+	// tracked
+	DeregisterChain(ctx *snow.ConsensusContext)
 }
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"sync"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+)
+
+var _ SubnetTracker = (*lockedSubnetTracker)(nil)
+
+// SubnetTracker is implemented by the VM that decides which chains are run on
+// this node. Tracking a subnet causes its chains to be queued for creation.
+type SubnetTracker interface {
+	// TrackSubnet starts tracking the subnet and queues the creation of its
+	// chains.
+	TrackSubnet(subnetID ids.ID) error
+	// UntrackSubnet stops tracking the subnet. The chains of the subnet are
+	// not stopped.
+	UntrackSubnet(subnetID ids.ID) error
+}
+
+// lockedSubnetTracker grabs the lock of the chain implementing the tracker
+// before calling it.
+type lockedSubnetTracker struct {
+	lock    sync.Locker
+	tracker SubnetTracker
+}
+
+func (l *lockedSubnetTracker) TrackSubnet(subnetID ids.ID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.tracker.TrackSubnet(subnetID)
+}
+
+func (l *lockedSubnetTracker) UntrackSubnet(subnetID ids.ID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.tracker.UntrackSubnet(subnetID)
+}
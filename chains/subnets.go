@@ -5,6 +5,7 @@ package chains
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/MetalBlockchain/metalgo/ids"
@@ -12,7 +13,10 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 )
 
-var ErrNoPrimaryNetworkConfig = errors.New("no subnet config for primary network found")
+var (
+	ErrNoPrimaryNetworkConfig     = errors.New("no subnet config for primary network found")
+	ErrRunningSubnetConfigChanged = errors.New("config of a running subnet can't be changed")
+)
 
 // Subnets holds the currently running subnets on this node
 type Subnets struct {
@@ -33,19 +37,53 @@ func (s *Subnets) GetOrCreate(subnetID ids.ID) (subnets.Subnet, bool) {
 		return subnet, false
 	}
 
-	// Default to the primary network config if a subnet config was not
-	// specified
-	config, ok := s.configs[subnetID]
-	if !ok {
-		config = s.configs[constants.PrimaryNetworkID]
-	}
-
-	subnet := subnets.New(s.nodeID, config)
+	subnet := subnets.New(s.nodeID, configFor(s.configs, subnetID))
 	s.subnets[subnetID] = subnet
 
 	return subnet, true
 }
 
+// Get returns the subnet running on this node, if it exists.
+func (s *Subnets) Get(subnetID ids.ID) (subnets.Subnet, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	subnet, ok := s.subnets[subnetID]
+	return subnet, ok
+}
+
+// Remove stops considering the subnet to be running on this node. If the
+// subnet is later created again, none of its chains will be considered added.
+func (s *Subnets) Remove(subnetID ids.ID) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.subnets, subnetID)
+}
+
+// SetConfigs replaces the subnet configs. The config of a running subnet can't
+// be updated in place, so the configs are rejected if they would change the
+// config of any running subnet.
+func (s *Subnets) SetConfigs(configs map[ids.ID]subnets.Config) error {
+	if _, ok := configs[constants.PrimaryNetworkID]; !ok {
+		return ErrNoPrimaryNetworkConfig
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for subnetID, subnet := range s.subnets {
+		currentConfig := subnet.Config()
+		newConfig := configFor(configs, subnetID)
+		if !currentConfig.Equal(&newConfig) {
+			return fmt.Errorf("%w: %s", ErrRunningSubnetConfigChanged, subnetID)
+		}
+	}
+
+	s.configs = configs
+	return nil
+}
+
 // Bootstrapping returns the subnetIDs of any chains that are still
 // bootstrapping.
 func (s *Subnets) Bootstrapping() []ids.ID {
@@ -62,6 +100,15 @@ func (s *Subnets) Bootstrapping() []ids.ID {
 	return subnetsBootstrapping
 }
 
+// configFor returns the config of [subnetID], defaulting to the primary network
+// config if a subnet config was not specified.
+func configFor(configs map[ids.ID]subnets.Config, subnetID ids.ID) subnets.Config {
+	if config, ok := configs[subnetID]; ok {
+		return config
+	}
+	return configs[constants.PrimaryNetworkID]
+}
+
 // NewSubnets returns an instance of Subnets
 func NewSubnets(
 	nodeID ids.NodeID,
@@ -167,3 +167,77 @@ func TestSubnetsBootstrapping(t *testing.T) {
 	subnet.Bootstrapped(chainID)
 	require.Empty(subnets.Bootstrapping())
 }
+
+func TestSubnetsRemove(t *testing.T) {
+	require := require.New(t)
+
+	config := map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	}
+
+	subnets, err := NewSubnets(ids.EmptyNodeID, config)
+	require.NoError(err)
+
+	subnetID := ids.GenerateTestID()
+	chainID := ids.GenerateTestID()
+
+	subnet, _ := subnets.GetOrCreate(subnetID)
+	require.True(subnet.AddChain(chainID))
+
+	got, ok := subnets.Get(subnetID)
+	require.True(ok)
+	require.Equal(subnet, got)
+	require.Contains(subnets.Bootstrapping(), subnetID)
+
+	// Removing a bootstrapping subnet stops reporting it as bootstrapping.
+	subnets.Remove(subnetID)
+	_, ok = subnets.Get(subnetID)
+	require.False(ok)
+	require.Empty(subnets.Bootstrapping())
+
+	// The chains of a re-created subnet can be added again.
+	subnet, created := subnets.GetOrCreate(subnetID)
+	require.True(created)
+	require.True(subnet.AddChain(chainID))
+}
+
+func TestSubnetsSetConfigs(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	config := map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	}
+
+	s, err := NewSubnets(ids.EmptyNodeID, config)
+	require.NoError(err)
+
+	running, _ := s.GetOrCreate(subnetID)
+
+	err = s.SetConfigs(map[ids.ID]subnets.Config{})
+	require.ErrorIs(err, ErrNoPrimaryNetworkConfig)
+
+	newConfig := subnets.Config{
+		ValidatorOnly: true,
+	}
+	newConfigs := map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+		subnetID:                   newConfig,
+	}
+
+	// Changing the config of a running subnet is rejected.
+	err = s.SetConfigs(newConfigs)
+	require.ErrorIs(err, ErrRunningSubnetConfigChanged)
+	require.Equal(subnets.Config{}, running.Config())
+
+	// Configs of subnets that aren't running can be changed.
+	s.Remove(subnetID)
+	require.NoError(s.SetConfigs(newConfigs))
+
+	subnet, created := s.GetOrCreate(subnetID)
+	require.True(created)
+	require.Equal(newConfig, subnet.Config())
+
+	// Setting the same configs again is allowed.
+	require.NoError(s.SetConfigs(newConfigs))
+}
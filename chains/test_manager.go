@@ -3,7 +3,11 @@
 
 package chains
 
-import "github.com/MetalBlockchain/metalgo/ids"
+import (
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
+	"github.com/MetalBlockchain/metalgo/subnets"
+)
 
 // TestManager implements Manager but does nothing. Always returns nil error.
 // To be used only in tests
@@ -39,10 +43,26 @@ func (testManager) StartChainCreator(ChainParameters) error {
 	return nil
 }
 
+func (testManager) TrackSubnet(ids.ID) error {
+	return nil
+}
+
+func (testManager) UntrackSubnet(ids.ID) error {
+	return nil
+}
+
+func (testManager) SetConfigs(map[string]ChainConfig, map[ids.ID]subnets.Config) error {
+	return nil
+}
+
 func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) ConsensusEvents(ids.ID) (*recorder.Dump, error) {
+	return &recorder.Dump{Version: recorder.Version}, nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/MetalBlockchain/metalgo/api/audit"
 	"github.com/MetalBlockchain/metalgo/api/server"
 	"github.com/MetalBlockchain/metalgo/chains"
 	"github.com/MetalBlockchain/metalgo/config/node"
@@ -32,6 +34,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/staking"
 	"github.com/MetalBlockchain/metalgo/subnets"
 	"github.com/MetalBlockchain/metalgo/trace"
+	"github.com/MetalBlockchain/metalgo/trace/otlp"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils/bag"
 	"github.com/MetalBlockchain/metalgo/utils/compression"
@@ -80,6 +83,7 @@ var (
 	errCannotReadDirectory                    = errors.New("cannot read directory")
 	errUnmarshalling                          = errors.New("unmarshalling failed")
 	errFileDoesNotExist                       = errors.New("file does not exist")
+	errOTLPExporterDisabled                   = fmt.Errorf("%s and %s require %s to be set", TracingMetricsEnabledKey, TracingLogsEnabledKey, TracingExporterTypeKey)
 	errInvalidSignerConfig                    = fmt.Errorf("only one of the following flags can be set: %s, %s, %s, %s", StakingEphemeralSignerEnabledKey, StakingSignerKeyContentKey, StakingSignerKeyPathKey, StakingRPCSignerEndpointKey)
 )
 
@@ -98,6 +102,12 @@ func getConsensusConfig(v *viper.Viper) snowball.Parameters {
 		p.AlphaPreference = v.GetInt(SnowQuorumSizeKey)
 		p.AlphaConfidence = p.AlphaPreference
 	}
+	if v.GetBool(SnowAdaptiveSamplingEnabledKey) {
+		p.AdaptiveSampling = &snowball.AdaptiveSamplingParameters{
+			MinWeightFactor: v.GetFloat64(SnowAdaptiveSamplingMinWeightFactorKey),
+			HalfLife:        v.GetDuration(SnowAdaptiveSamplingHalfLifeKey),
+		}
+	}
 	return p
 }
 
@@ -163,6 +173,14 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		}
 	}
 
+	auditConfig := audit.Config{
+		Enabled: v.GetBool(APIAuditLogEnabledKey),
+		Classes: v.GetStringSlice(APIAuditLogClassesKey),
+	}
+	if err := auditConfig.Verify(); err != nil {
+		return node.HTTPConfig{}, fmt.Errorf("invalid %s: %w", APIAuditLogClassesKey, err)
+	}
+
 	return node.HTTPConfig{
 		HTTPConfig: server.HTTPConfig{
 			ReadTimeout:       v.GetDuration(HTTPReadTimeoutKey),
@@ -189,6 +207,7 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		HTTPAllowedHosts:   v.GetStringSlice(HTTPAllowedHostsKey),
 		ShutdownTimeout:    v.GetDuration(HTTPShutdownTimeoutKey),
 		ShutdownWait:       v.GetDuration(HTTPShutdownWaitKey),
+		AuditConfig:        auditConfig,
 	}, nil
 }
 
@@ -479,6 +498,7 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		BootstrapArchive:                        v.GetString(BootstrapArchiveKey),
 	}
 
 	// TODO: Add a "BootstrappersKey" flag to more clearly enforce ID and IP
@@ -564,6 +584,13 @@ func getProfilerConfig(v *viper.Viper) (profiler.Config, error) {
 	if config.Freq < 0 {
 		return profiler.Config{}, fmt.Errorf("%s must be >= 0", ProfileContinuousFreqKey)
 	}
+	for _, typeName := range v.GetStringSlice(ProfileContinuousTypesKey) {
+		profileType, err := profiler.ParseType(typeName)
+		if err != nil {
+			return profiler.Config{}, fmt.Errorf("invalid %s: %w", ProfileContinuousTypesKey, err)
+		}
+		config.Types = append(config.Types, profileType)
+	}
 	return config, nil
 }
 
@@ -832,6 +859,23 @@ func getTrackedSubnets(v *viper.Viper) (set.Set[ids.ID], error) {
 	return trackedSubnetIDs, nil
 }
 
+func getMessageRecordingChains(v *viper.Viper) (set.Set[ids.ID], error) {
+	chainsStr := v.GetString(ConsensusMessageRecordingChainsKey)
+	chainsStrs := strings.Split(chainsStr, ",")
+	chainIDs := set.NewSet[ids.ID](len(chainsStrs))
+	for _, chain := range chainsStrs {
+		if chain == "" {
+			continue
+		}
+		chainID, err := ids.FromString(chain)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse chainID %q: %w", chain, err)
+		}
+		chainIDs.Add(chainID)
+	}
+	return chainIDs, nil
+}
+
 func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, error) {
 	var (
 		configBytes []byte
@@ -1175,12 +1219,32 @@ func getTraceConfig(v *viper.Viper) (trace.Config, error) {
 			Insecure: v.GetBool(TracingInsecureKey),
 			Headers:  v.GetStringMapString(TracingHeadersKey),
 		},
-		TraceSampleRate: v.GetFloat64(TracingSampleRateKey),
-		AppName:         constants.AppName,
-		Version:         version.Current.String(),
+		TraceSampleRate:  v.GetFloat64(TracingSampleRateKey),
+		PropagateContext: v.GetBool(TracingPropagateContextKey),
+		DatabaseEnabled:  v.GetBool(TracingDatabaseEnabledKey),
+		AppName:          constants.AppName,
+		Version:          version.Current.String(),
 	}, nil
 }
 
+func getOTLPConfig(v *viper.Viper, traceConfig trace.Config) (otlp.Config, error) {
+	config := otlp.Config{
+		ExporterConfig:   traceConfig.ExporterConfig,
+		MetricsEnabled:   v.GetBool(TracingMetricsEnabledKey),
+		MetricsFrequency: v.GetDuration(TracingMetricsFrequencyKey),
+		LogsEnabled:      v.GetBool(TracingLogsEnabledKey),
+		AppName:          traceConfig.AppName,
+		Version:          traceConfig.Version,
+	}
+	if (config.MetricsEnabled || config.LogsEnabled) && config.Type == trace.Disabled {
+		return otlp.Config{}, errOTLPExporterDisabled
+	}
+	if config.MetricsEnabled && config.MetricsFrequency <= 0 {
+		return otlp.Config{}, fmt.Errorf("%s must be > 0", TracingMetricsFrequencyKey)
+	}
+	return config, nil
+}
+
 // Returns the path to the directory that contains VM binaries.
 func getPluginDir(v *viper.Viper) (string, error) {
 	pluginDir := getExpandedArg(v, PluginDirKey)
@@ -1232,6 +1296,16 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, fmt.Errorf("%s must be > 0", ConsensusAppConcurrencyKey)
 	}
 
+	// Consensus event recording
+	nodeConfig.ConsensusRecorderSize = int(v.GetUint(ConsensusRecorderSizeKey))
+
+	// Message recording
+	nodeConfig.MessageRecordingChains, err = getMessageRecordingChains(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+	nodeConfig.MessageRecordingDir = getExpandedArg(v, ConsensusMessageRecordingDirKey)
+
 	nodeConfig.UseCurrentHeight = v.GetBool(ProposerVMUseCurrentHeightKey)
 
 	// Logging
@@ -1281,6 +1355,12 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if nodeConfig.HealthCheckFreq < 0 {
 		return node.Config{}, fmt.Errorf("%s must be positive", HealthCheckFreqKey)
 	}
+	nodeConfig.HealthCheckWebhookURL = v.GetString(HealthCheckWebhookURLKey)
+	if nodeConfig.HealthCheckWebhookURL != "" {
+		if _, err := url.ParseRequestURI(nodeConfig.HealthCheckWebhookURL); err != nil {
+			return node.Config{}, fmt.Errorf("invalid %s: %w", HealthCheckWebhookURLKey, err)
+		}
+	}
 	// Halflife of continuous averager used in health checks
 	healthCheckAveragerHalflife := v.GetDuration(HealthCheckAveragerHalflifeKey)
 	if healthCheckAveragerHalflife <= 0 {
@@ -1412,6 +1492,11 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	nodeConfig.OTLPConfig, err = getOTLPConfig(v, nodeConfig.TraceConfig)
+	if err != nil {
+		return node.Config{}, err
+	}
+
 	nodeConfig.ChainDataDir = getExpandedArg(v, ChainDataDirKey)
 
 	nodeConfig.ProcessContextFilePath = getExpandedArg(v, ProcessContextFileKey)
@@ -1420,6 +1505,16 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	return nodeConfig, nil
 }
 
+// LoadNodeConfig parses [args] and the config file they refer to, if any, into
+// a node config. This can be used to re-read the config of a running node.
+func LoadNodeConfig(args []string) (node.Config, error) {
+	v, err := BuildViper(BuildFlagSet(), args)
+	if err != nil {
+		return node.Config{}, err
+	}
+	return GetNodeConfig(v)
+}
+
 func providedFlags(v *viper.Viper) map[string]interface{} {
 	settings := v.AllSettings()
 	customSettings := make(map[string]interface{}, len(settings))
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/MetalBlockchain/metalgo/api/audit"
 	"github.com/MetalBlockchain/metalgo/database/leveldb"
 	"github.com/MetalBlockchain/metalgo/database/memdb"
 	"github.com/MetalBlockchain/metalgo/database/pebbledb"
@@ -45,6 +46,7 @@ var (
 	defaultDBDir                = filepath.Join(defaultUnexpandedDataDir, "db")
 	defaultLogDir               = filepath.Join(defaultUnexpandedDataDir, "logs")
 	defaultProfileDir           = filepath.Join(defaultUnexpandedDataDir, "profiles")
+	defaultMessageRecordingDir  = filepath.Join(defaultUnexpandedDataDir, "recordings")
 	defaultStakingPath          = filepath.Join(defaultUnexpandedDataDir, "staking")
 	defaultStakingTLSKeyPath    = filepath.Join(defaultStakingPath, "staker.key")
 	defaultStakingCertPath      = filepath.Join(defaultStakingPath, "staker.crt")
@@ -200,6 +202,9 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint(ConsensusAppConcurrencyKey, constants.DefaultConsensusAppConcurrency, "Maximum number of goroutines to use when handling App messages on a chain")
 	fs.Duration(ConsensusShutdownTimeoutKey, constants.DefaultConsensusShutdownTimeout, "Timeout before killing an unresponsive chain")
 	fs.Duration(ConsensusFrontierPollFrequencyKey, constants.DefaultFrontierPollFrequency, "Frequency of polling for new consensus frontiers")
+	fs.Uint(ConsensusRecorderSizeKey, constants.DefaultConsensusRecorderSize, "Number of recent consensus events to keep in memory per chain. If 0, consensus events are not recorded")
+	fs.String(ConsensusMessageRecordingChainsKey, "", "List of chain IDs whose inbound messages are recorded so that they can be replayed")
+	fs.String(ConsensusMessageRecordingDirKey, defaultMessageRecordingDir, "Path to the directory message recordings are written to")
 
 	// Inbound Throttling
 	fs.Uint64(InboundThrottlerAtLargeAllocSizeKey, constants.DefaultInboundThrottlerAtLargeAllocSize, "Size, in bytes, of at-large byte allocation in inbound message throttler")
@@ -238,10 +243,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(InfoAPIEnabledKey, true, "If true, this node exposes the Info API")
 	fs.Bool(MetricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(HealthAPIEnabledKey, true, "If true, this node exposes the Health API")
+	fs.Bool(APIAuditLogEnabledKey, false, "If true, calls to the API methods of the classes in --api-audit-log-classes are recorded in audit.log in the log directory")
+	fs.StringSlice(APIAuditLogClassesKey, []string{audit.AdminClass, audit.IssueClass}, fmt.Sprintf("Classes of the API methods whose calls are recorded in the audit log. Options are [%s, %s, %s]", audit.AdminClass, audit.IssueClass, audit.AllClass))
 
 	// Health Checks
 	fs.Duration(HealthCheckFreqKey, 30*time.Second, "Time between health checks")
 	fs.Duration(HealthCheckAveragerHalflifeKey, constants.DefaultHealthCheckAveragerHalflife, "Halflife of averager when calculating a running average in a health check")
+	fs.String(HealthCheckWebhookURLKey, "", "URL that health check transitions are POSTed to. If empty, transitions are not posted")
 	// Network Layer Health
 	fs.Duration(NetworkHealthMaxTimeSinceMsgSentKey, constants.DefaultNetworkHealthMaxTimeSinceMsgSent, "Network layer returns unhealthy if haven't sent a message for at least this much time")
 	fs.Duration(NetworkHealthMaxTimeSinceMsgReceivedKey, constants.DefaultNetworkHealthMaxTimeSinceMsgReceived, "Network layer returns unhealthy if haven't received a message for at least this much time")
@@ -301,6 +309,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
+	fs.String(BootstrapArchiveKey, "", "Directory or HTTP URL of an archive of accepted blocks, organized by chainID, to bootstrap snowman chains from before requesting blocks from peers")
 
 	// Consensus
 	fs.Int(SnowSampleSizeKey, snowball.DefaultParameters.K, "Number of nodes to query for each network poll")
@@ -314,6 +323,9 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Int(SnowOptimalProcessingKey, snowball.DefaultParameters.OptimalProcessing, "Optimal number of processing containers in consensus")
 	fs.Int(SnowMaxProcessingKey, snowball.DefaultParameters.MaxOutstandingItems, "Maximum number of processing items to be considered healthy")
 	fs.Duration(SnowMaxTimeProcessingKey, snowball.DefaultParameters.MaxItemProcessingTime, "Maximum amount of time an item should be processing and still be healthy")
+	fs.Bool(SnowAdaptiveSamplingEnabledKey, false, "If true, validators are sampled for network polls with a bias toward validators that respond quickly and reliably")
+	fs.Float64(SnowAdaptiveSamplingMinWeightFactorKey, snowball.DefaultAdaptiveSamplingParameters.MinWeightFactor, fmt.Sprintf("Smallest fraction of a validator's stake used to sample it when %s is true", SnowAdaptiveSamplingEnabledKey))
+	fs.Duration(SnowAdaptiveSamplingHalfLifeKey, snowball.DefaultAdaptiveSamplingParameters.HalfLife, fmt.Sprintf("Half life of the validator responsiveness averages used when %s is true", SnowAdaptiveSamplingEnabledKey))
 
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted P-chain block height")
@@ -341,6 +353,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(ProfileContinuousEnabledKey, false, "Whether the app should continuously produce performance profiles")
 	fs.Duration(ProfileContinuousFreqKey, 15*time.Minute, "How frequently to rotate performance profiles")
 	fs.Int(ProfileContinuousMaxFilesKey, 5, "Maximum number of historical profiles to keep")
+	fs.StringSlice(ProfileContinuousTypesKey, []string{"cpu", "heap", "mutex"}, "Types of profiles to continuously produce. Supported types are cpu, heap, allocs, goroutine, block, mutex and trace")
 
 	// Aliasing
 	fs.String(VMAliasesFileKey, defaultVMAliasFilePath, fmt.Sprintf("Specifies a JSON file that maps vmIDs with custom aliases. Ignored if %s is specified", VMAliasesContentKey))
@@ -376,6 +389,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(TracingInsecureKey, true, "If true, don't use TLS when sending trace data")
 	fs.Float64(TracingSampleRateKey, 0.1, "The fraction of traces to sample. If >= 1, always sample. If <= 0, never sample")
 	fs.StringToString(TracingHeadersKey, map[string]string{}, "The headers to provide the trace indexer")
+	fs.Bool(TracingMetricsEnabledKey, false, "If true, push the node's metrics over OTLP using the tracing exporter settings")
+	fs.Duration(TracingMetricsFrequencyKey, 10*time.Second, "Frequency at which the node's metrics are pushed over OTLP")
+	fs.Bool(TracingLogsEnabledKey, false, "If true, push the records written to the log files over OTLP using the tracing exporter settings")
+	fs.Bool(TracingPropagateContextKey, false, "If true, include the trace context of sampled spans in the AppRequest messages sent to other nodes and continue the traces received from them")
+	fs.Bool(TracingDatabaseEnabledKey, false, "If true, record a span for every call to the node's database")
 
 	fs.String(ProcessContextFileKey, defaultProcessContextPath, "The path to write process context to (including PID, API URI, and staking address).")
 }
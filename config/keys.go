@@ -143,16 +143,24 @@ const (
 	SnowOptimalProcessingKey                           = "snow-optimal-processing"
 	SnowMaxProcessingKey                               = "snow-max-processing"
 	SnowMaxTimeProcessingKey                           = "snow-max-time-processing"
+	SnowAdaptiveSamplingEnabledKey                     = "snow-adaptive-sampling-enabled"
+	SnowAdaptiveSamplingMinWeightFactorKey             = "snow-adaptive-sampling-min-weight-factor"
+	SnowAdaptiveSamplingHalfLifeKey                    = "snow-adaptive-sampling-half-life"
 	PartialSyncPrimaryNetworkKey                       = "partial-sync-primary-network"
 	TrackSubnetsKey                                    = "track-subnets"
 	AdminAPIEnabledKey                                 = "api-admin-enabled"
 	InfoAPIEnabledKey                                  = "api-info-enabled"
 	MetricsAPIEnabledKey                               = "api-metrics-enabled"
 	HealthAPIEnabledKey                                = "api-health-enabled"
+	APIAuditLogEnabledKey                              = "api-audit-log-enabled"
+	APIAuditLogClassesKey                              = "api-audit-log-classes"
 	MeterVMsEnabledKey                                 = "meter-vms-enabled"
 	ConsensusAppConcurrencyKey                         = "consensus-app-concurrency"
 	ConsensusShutdownTimeoutKey                        = "consensus-shutdown-timeout"
 	ConsensusFrontierPollFrequencyKey                  = "consensus-frontier-poll-frequency"
+	ConsensusRecorderSizeKey                           = "consensus-recorder-size"
+	ConsensusMessageRecordingChainsKey                 = "consensus-message-recording-chains"
+	ConsensusMessageRecordingDirKey                    = "consensus-message-recording-dir"
 	ProposerVMUseCurrentHeightKey                      = "proposervm-use-current-height"
 	ProposerVMMinBlockDelayKey                         = "proposervm-min-block-delay"
 	FdLimitKey                                         = "fd-limit"
@@ -162,11 +170,13 @@ const (
 	RouterHealthMaxOutstandingRequestsKey              = "router-health-max-outstanding-requests"
 	HealthCheckFreqKey                                 = "health-check-frequency"
 	HealthCheckAveragerHalflifeKey                     = "health-check-averager-halflife"
+	HealthCheckWebhookURLKey                           = "health-check-webhook-url"
 	PluginDirKey                                       = "plugin-dir"
 	BootstrapBeaconConnectionTimeoutKey                = "bootstrap-beacon-connection-timeout"
 	BootstrapMaxTimeGetAncestorsKey                    = "bootstrap-max-time-get-ancestors"
 	BootstrapAncestorsMaxContainersSentKey             = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey         = "bootstrap-ancestors-max-containers-received"
+	BootstrapArchiveKey                                = "bootstrap-archive"
 	ChainDataDirKey                                    = "chain-data-dir"
 	ChainConfigDirKey                                  = "chain-config-dir"
 	ChainConfigContentKey                              = "chain-config-content"
@@ -176,6 +186,7 @@ const (
 	ProfileContinuousEnabledKey                        = "profile-continuous-enabled"
 	ProfileContinuousFreqKey                           = "profile-continuous-freq"
 	ProfileContinuousMaxFilesKey                       = "profile-continuous-max-files"
+	ProfileContinuousTypesKey                          = "profile-continuous-types"
 	InboundThrottlerAtLargeAllocSizeKey                = "throttler-inbound-at-large-alloc-size"
 	InboundThrottlerVdrAllocSizeKey                    = "throttler-inbound-validator-alloc-size"
 	InboundThrottlerNodeMaxAtLargeBytesKey             = "throttler-inbound-node-max-at-large-bytes"
@@ -209,5 +220,10 @@ const (
 	TracingSampleRateKey                               = "tracing-sample-rate"
 	TracingExporterTypeKey                             = "tracing-exporter-type"
 	TracingHeadersKey                                  = "tracing-headers"
+	TracingMetricsEnabledKey                           = "tracing-metrics-enabled"
+	TracingMetricsFrequencyKey                         = "tracing-metrics-frequency"
+	TracingLogsEnabledKey                              = "tracing-logs-enabled"
+	TracingPropagateContextKey                         = "tracing-propagate-context"
+	TracingDatabaseEnabledKey                          = "tracing-database-enabled"
 	ProcessContextFileKey                              = "process-context-file"
 )
@@ -8,6 +8,7 @@ import (
 	"net/netip"
 	"time"
 
+	"github.com/MetalBlockchain/metalgo/api/audit"
 	"github.com/MetalBlockchain/metalgo/api/server"
 	"github.com/MetalBlockchain/metalgo/chains"
 	"github.com/MetalBlockchain/metalgo/genesis"
@@ -18,6 +19,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/networking/tracker"
 	"github.com/MetalBlockchain/metalgo/subnets"
 	"github.com/MetalBlockchain/metalgo/trace"
+	"github.com/MetalBlockchain/metalgo/trace/otlp"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/profiler"
@@ -45,6 +47,8 @@ type HTTPConfig struct {
 
 	ShutdownTimeout time.Duration `json:"shutdownTimeout"`
 	ShutdownWait    time.Duration `json:"shutdownWait"`
+
+	AuditConfig audit.Config `json:"auditConfig"`
 }
 
 type APIConfig struct {
@@ -109,6 +113,10 @@ type BootstrapConfig struct {
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
 
+	// Directory or HTTP URL of an archive of accepted blocks to bootstrap
+	// snowman chains from
+	BootstrapArchive string `json:"bootstrapArchive"`
+
 	Bootstrappers []genesis.Bootstrapper `json:"bootstrappers"`
 }
 
@@ -147,6 +155,9 @@ type Config struct {
 
 	// Health
 	HealthCheckFreq time.Duration `json:"healthCheckFreq"`
+	// HealthCheckWebhookURL is the URL that health check transitions are
+	// POSTed to. If empty, transitions are not posted.
+	HealthCheckWebhookURL string `json:"healthCheckWebhookURL"`
 
 	// Network configuration
 	NetworkConfig network.Config `json:"networkConfig"`
@@ -174,6 +185,13 @@ type Config struct {
 	// ConsensusAppConcurrency defines the maximum number of goroutines to
 	// handle App messages per chain.
 	ConsensusAppConcurrency int `json:"consensusAppConcurrency"`
+	// ConsensusRecorderSize is the number of recent consensus events each
+	// chain keeps in memory.
+	ConsensusRecorderSize int `json:"consensusRecorderSize"`
+	// MessageRecordingChains are the chains whose routed messages are
+	// persisted to [MessageRecordingDir] so that they can be replayed.
+	MessageRecordingChains set.Set[ids.ID] `json:"messageRecordingChains"`
+	MessageRecordingDir    string          `json:"messageRecordingDir"`
 
 	TrackedSubnets set.Set[ids.ID] `json:"trackedSubnets"`
 
@@ -209,6 +227,9 @@ type Config struct {
 	WarningThresholdAvailableDiskSpace uint64 `json:"warningThresholdAvailableDiskSpace"`
 
 	TraceConfig trace.Config `json:"traceConfig"`
+	// OTLPConfig configures pushing the metrics and logs with the exporter
+	// settings of [TraceConfig].
+	OTLPConfig otlp.Config `json:"otlpConfig"`
 
 	// See comment on [UseCurrentHeight] in platformvm.Config
 	UseCurrentHeight bool `json:"useCurrentHeight"`
@@ -0,0 +1,251 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package traceddb
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ database.Database = (*Database)(nil)
+	_ database.Batch    = (*batch)(nil)
+	_ database.Iterator = (*iterator)(nil)
+)
+
+// Database records a span for every call to the underlying database instance.
+//
+// The database interface doesn't carry contexts, so the spans of its methods
+// are the roots of their traces. Callers that have a context should use the
+// WithContext variants so that the spans are attached to their traces.
+type Database struct {
+	db     database.Database
+	tracer trace.Tracer
+}
+
+// New returns a new database that traces calls to [db].
+func New(db database.Database, tracer trace.Tracer) *Database {
+	return &Database{
+		db:     db,
+		tracer: tracer,
+	}
+}
+
+func (db *Database) Has(key []byte) (bool, error) {
+	return db.HasWithContext(context.Background(), key)
+}
+
+// HasWithContext is [Database.Has] with its span started from [ctx].
+func (db *Database) HasWithContext(ctx context.Context, key []byte) (bool, error) {
+	_, span := db.tracer.Start(ctx, "traceddb.Has", oteltrace.WithAttributes(
+		attribute.Int("keyLen", len(key)),
+	))
+	defer span.End()
+
+	return db.db.Has(key)
+}
+
+func (db *Database) Get(key []byte) ([]byte, error) {
+	return db.GetWithContext(context.Background(), key)
+}
+
+// GetWithContext is [Database.Get] with its span started from [ctx].
+func (db *Database) GetWithContext(ctx context.Context, key []byte) ([]byte, error) {
+	_, span := db.tracer.Start(ctx, "traceddb.Get", oteltrace.WithAttributes(
+		attribute.Int("keyLen", len(key)),
+	))
+	defer span.End()
+
+	value, err := db.db.Get(key)
+	span.SetAttributes(attribute.Int("valueLen", len(value)))
+	return value, err
+}
+
+func (db *Database) Put(key, value []byte) error {
+	return db.PutWithContext(context.Background(), key, value)
+}
+
+// PutWithContext is [Database.Put] with its span started from [ctx].
+func (db *Database) PutWithContext(ctx context.Context, key, value []byte) error {
+	_, span := db.tracer.Start(ctx, "traceddb.Put", oteltrace.WithAttributes(
+		attribute.Int("keyLen", len(key)),
+		attribute.Int("valueLen", len(value)),
+	))
+	defer span.End()
+
+	return db.db.Put(key, value)
+}
+
+func (db *Database) Delete(key []byte) error {
+	return db.DeleteWithContext(context.Background(), key)
+}
+
+// DeleteWithContext is [Database.Delete] with its span started from [ctx].
+func (db *Database) DeleteWithContext(ctx context.Context, key []byte) error {
+	_, span := db.tracer.Start(ctx, "traceddb.Delete", oteltrace.WithAttributes(
+		attribute.Int("keyLen", len(key)),
+	))
+	defer span.End()
+
+	return db.db.Delete(key)
+}
+
+func (db *Database) NewBatch() database.Batch {
+	return db.NewBatchWithContext(context.Background())
+}
+
+// NewBatchWithContext returns a batch that records the span of its writes from
+// [ctx]. It doesn't record a span, as the batch only touches the underlying
+// database when it is written.
+func (db *Database) NewBatchWithContext(ctx context.Context) database.Batch {
+	return &batch{
+		batch: db.db.NewBatch(),
+		db:    db,
+		ctx:   ctx,
+	}
+}
+
+func (db *Database) NewIterator() database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+func (db *Database) NewIteratorWithStartAndPrefix(
+	start,
+	prefix []byte,
+) database.Iterator {
+	return db.NewIteratorWithStartAndPrefixWithContext(context.Background(), start, prefix)
+}
+
+// NewIteratorWithStartAndPrefixWithContext records a span, started from [ctx],
+// that lasts until the returned iterator is released.
+func (db *Database) NewIteratorWithStartAndPrefixWithContext(
+	ctx context.Context,
+	start,
+	prefix []byte,
+) database.Iterator {
+	_, span := db.tracer.Start(ctx, "traceddb.Iterator", oteltrace.WithAttributes(
+		attribute.Int("startLen", len(start)),
+		attribute.Int("prefixLen", len(prefix)),
+	))
+	return &iterator{
+		iterator: db.db.NewIteratorWithStartAndPrefix(start, prefix),
+		span:     span,
+	}
+}
+
+func (db *Database) Compact(start, limit []byte) error {
+	return db.CompactWithContext(context.Background(), start, limit)
+}
+
+// CompactWithContext is [Database.Compact] with its span started from [ctx].
+func (db *Database) CompactWithContext(ctx context.Context, start, limit []byte) error {
+	_, span := db.tracer.Start(ctx, "traceddb.Compact")
+	defer span.End()
+
+	return db.db.Compact(start, limit)
+}
+
+func (db *Database) Close() error {
+	_, span := db.tracer.Start(context.Background(), "traceddb.Close")
+	defer span.End()
+
+	return db.db.Close()
+}
+
+func (db *Database) HealthCheck(ctx context.Context) (interface{}, error) {
+	ctx, span := db.tracer.Start(ctx, "traceddb.HealthCheck")
+	defer span.End()
+
+	return db.db.HealthCheck(ctx)
+}
+
+type batch struct {
+	batch database.Batch
+	db    *Database
+	ctx   context.Context
+}
+
+func (b *batch) Put(key, value []byte) error {
+	return b.batch.Put(key, value)
+}
+
+func (b *batch) Delete(key []byte) error {
+	return b.batch.Delete(key)
+}
+
+func (b *batch) Size() int {
+	return b.batch.Size()
+}
+
+func (b *batch) Write() error {
+	_, span := b.db.tracer.Start(b.ctx, "traceddb.Batch.Write", oteltrace.WithAttributes(
+		attribute.Int("size", b.batch.Size()),
+	))
+	defer span.End()
+
+	return b.batch.Write()
+}
+
+func (b *batch) Reset() {
+	b.batch.Reset()
+}
+
+func (b *batch) Replay(w database.KeyValueWriterDeleter) error {
+	return b.batch.Replay(w)
+}
+
+func (b *batch) Inner() database.Batch {
+	return b.batch.Inner()
+}
+
+type iterator struct {
+	iterator database.Iterator
+	span     oteltrace.Span
+	numNext  int
+	released bool
+}
+
+func (it *iterator) Next() bool {
+	it.numNext++
+	return it.iterator.Next()
+}
+
+func (it *iterator) Error() error {
+	return it.iterator.Error()
+}
+
+func (it *iterator) Key() []byte {
+	return it.iterator.Key()
+}
+
+func (it *iterator) Value() []byte {
+	return it.iterator.Value()
+}
+
+func (it *iterator) Release() {
+	it.iterator.Release()
+
+	// Release may be called multiple times, but the span must only be ended
+	// once.
+	if it.released {
+		return
+	}
+	it.released = true
+	it.span.SetAttributes(attribute.Int("numNext", it.numNext))
+	it.span.End()
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package traceddb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/database/dbtest"
+	"github.com/MetalBlockchain/metalgo/database/memdb"
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// recordingTracer records the spans it starts.
+type recordingTracer struct {
+	trace.Tracer
+	tracer oteltrace.Tracer
+}
+
+func (t recordingTracer) Start(ctx context.Context, spanName string, opts ...oteltrace.SpanStartOption) (context.Context, oteltrace.Span) {
+	return t.tracer.Start(ctx, spanName, opts...)
+}
+
+func TestInterface(t *testing.T) {
+	for name, test := range dbtest.Tests {
+		t.Run(name, func(t *testing.T) {
+			test(t, newDB())
+		})
+	}
+}
+
+func newDB() database.Database {
+	return New(memdb.New(), trace.Noop)
+}
+
+func FuzzKeyValue(f *testing.F) {
+	dbtest.FuzzKeyValue(f, newDB())
+}
+
+func FuzzNewIteratorWithPrefix(f *testing.F) {
+	dbtest.FuzzNewIteratorWithPrefix(f, newDB())
+}
+
+func FuzzNewIteratorWithStartAndPrefix(f *testing.F) {
+	dbtest.FuzzNewIteratorWithStartAndPrefix(f, newDB())
+}
+
+func BenchmarkInterface(b *testing.B) {
+	for _, size := range dbtest.BenchmarkSizes {
+		keys, values := dbtest.SetupBenchmark(b, size[0], size[1], size[2])
+		for name, bench := range dbtest.Benchmarks {
+			b.Run(fmt.Sprintf("traceddb_%d_pairs_%d_keys_%d_values_%s", size[0], size[1], size[2], name), func(b *testing.B) {
+				bench(b, newDB(), keys, values)
+			})
+		}
+	}
+}
+
+func TestSpansAreChildrenOfContext(t *testing.T) {
+	require := require.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := recordingTracer{
+		Tracer: trace.Noop,
+		tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"),
+	}
+	db := New(memdb.New(), tracer)
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	key := []byte("key")
+	require.NoError(db.PutWithContext(ctx, key, []byte("value")))
+	_, err := db.GetWithContext(ctx, key)
+	require.NoError(err)
+	_, err = db.HasWithContext(ctx, key)
+	require.NoError(err)
+	require.NoError(db.CompactWithContext(ctx, nil, nil))
+
+	it := db.NewIteratorWithStartAndPrefixWithContext(ctx, nil, nil)
+	it.Release()
+
+	b := db.NewBatchWithContext(ctx)
+	require.NoError(b.Delete(key))
+	require.NoError(b.Write())
+
+	require.NoError(db.DeleteWithContext(ctx, key))
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(spans, 8)
+	for _, span := range spans[:len(spans)-1] {
+		require.Equal(parent.SpanContext(), span.Parent())
+	}
+
+	// Calls without a context are the roots of their traces.
+	require.NoError(db.Put(key, []byte("value")))
+	spans = recorder.Ended()
+	require.False(spans[len(spans)-1].Parent().IsValid())
+}
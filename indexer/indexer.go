@@ -128,6 +128,10 @@ type indexer struct {
 	vertexAcceptorGroup snow.AcceptorGroup
 }
 
+// DeregisterChain is a no-op because only chains in the primary network are
+// indexed and the primary network is always tracked.
+func (*indexer) DeregisterChain(*snow.ConsensusContext) {}
+
 // Assumes [ctx.Lock] is not held
 func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM) {
 	i.lock.Lock()
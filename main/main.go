@@ -14,6 +14,7 @@ import (
 
 	"github.com/MetalBlockchain/metalgo/app"
 	"github.com/MetalBlockchain/metalgo/config"
+	"github.com/MetalBlockchain/metalgo/config/node"
 	"github.com/MetalBlockchain/metalgo/version"
 )
 
@@ -61,7 +62,9 @@ func main() {
 		fmt.Println(app.Header)
 	}
 
-	nodeApp, err := app.New(nodeConfig)
+	nodeApp, err := app.New(nodeConfig, func() (node.Config, error) {
+		return config.LoadNodeConfig(os.Args[1:])
+	})
 	if err != nil {
 		fmt.Printf("couldn't start node: %s\n", err)
 		os.Exit(1)
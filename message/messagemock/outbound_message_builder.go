@@ -150,6 +150,21 @@ func (mr *OutboundMsgBuilderMockRecorder) AppRequest(chainID, requestID, deadlin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRequest", reflect.TypeOf((*OutboundMsgBuilder)(nil).AppRequest), chainID, requestID, deadline, msg)
 }
 
+// AppRequestWithTraceParent mocks base method.
+func (m *OutboundMsgBuilder) AppRequestWithTraceParent(chainID ids.ID, requestID uint32, deadline time.Duration, msg []byte, traceParent string) (message.OutboundMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRequestWithTraceParent", chainID, requestID, deadline, msg, traceParent)
+	ret0, _ := ret[0].(message.OutboundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRequestWithTraceParent indicates an expected call of AppRequestWithTraceParent.
+func (mr *OutboundMsgBuilderMockRecorder) AppRequestWithTraceParent(chainID, requestID, deadline, msg, traceParent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRequestWithTraceParent", reflect.TypeOf((*OutboundMsgBuilder)(nil).AppRequestWithTraceParent), chainID, requestID, deadline, msg, traceParent)
+}
+
 // AppResponse mocks base method.
 func (m *OutboundMsgBuilder) AppResponse(chainID ids.ID, requestID uint32, msg []byte) (message.OutboundMessage, error) {
 	m.ctrl.T.Helper()
@@ -567,6 +567,24 @@ func TestMessage(t *testing.T) {
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
+		{
+			desc: "app_request message with trace parent",
+			op:   AppRequestOp,
+			msg: &p2p.Message{
+				Message: &p2p.Message_AppRequest{
+					AppRequest: &p2p.AppRequest{
+						ChainId:     testID[:],
+						RequestId:   1,
+						Deadline:    1,
+						AppBytes:    compressibleContainers[0],
+						TraceParent: "00-01000000000000000000000000000000-0200000000000000-01",
+					},
+				},
+			},
+			compressionType:  compression.TypeNone,
+			bypassThrottling: true,
+			bytesSaved:       false,
+		},
 		{
 			desc: "app_response message with no compression",
 			op:   AppResponseOp,
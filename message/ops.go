@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	"github.com/MetalBlockchain/metalgo/utils/set"
 )
@@ -245,6 +247,70 @@ func Unwrap(m *p2p.Message) (fmt.Stringer, error) {
 	}
 }
 
+// Wrap is the inverse of [Unwrap].
+func Wrap(msg proto.Message) (*p2p.Message, error) {
+	switch msg := msg.(type) {
+	// Handshake:
+	case *p2p.Ping:
+		return &p2p.Message{Message: &p2p.Message_Ping{Ping: msg}}, nil
+	case *p2p.Pong:
+		return &p2p.Message{Message: &p2p.Message_Pong{Pong: msg}}, nil
+	case *p2p.Handshake:
+		return &p2p.Message{Message: &p2p.Message_Handshake{Handshake: msg}}, nil
+	case *p2p.GetPeerList:
+		return &p2p.Message{Message: &p2p.Message_GetPeerList{GetPeerList: msg}}, nil
+	case *p2p.PeerList:
+		return &p2p.Message{Message: &p2p.Message_PeerList_{PeerList_: msg}}, nil
+	// State sync:
+	case *p2p.GetStateSummaryFrontier:
+		return &p2p.Message{Message: &p2p.Message_GetStateSummaryFrontier{GetStateSummaryFrontier: msg}}, nil
+	case *p2p.StateSummaryFrontier:
+		return &p2p.Message{Message: &p2p.Message_StateSummaryFrontier_{StateSummaryFrontier_: msg}}, nil
+	case *p2p.GetAcceptedStateSummary:
+		return &p2p.Message{Message: &p2p.Message_GetAcceptedStateSummary{GetAcceptedStateSummary: msg}}, nil
+	case *p2p.AcceptedStateSummary:
+		return &p2p.Message{Message: &p2p.Message_AcceptedStateSummary_{AcceptedStateSummary_: msg}}, nil
+	// Bootstrapping:
+	case *p2p.GetAcceptedFrontier:
+		return &p2p.Message{Message: &p2p.Message_GetAcceptedFrontier{GetAcceptedFrontier: msg}}, nil
+	case *p2p.AcceptedFrontier:
+		return &p2p.Message{Message: &p2p.Message_AcceptedFrontier_{AcceptedFrontier_: msg}}, nil
+	case *p2p.GetAccepted:
+		return &p2p.Message{Message: &p2p.Message_GetAccepted{GetAccepted: msg}}, nil
+	case *p2p.Accepted:
+		return &p2p.Message{Message: &p2p.Message_Accepted_{Accepted_: msg}}, nil
+	case *p2p.GetAncestors:
+		return &p2p.Message{Message: &p2p.Message_GetAncestors{GetAncestors: msg}}, nil
+	case *p2p.Ancestors:
+		return &p2p.Message{Message: &p2p.Message_Ancestors_{Ancestors_: msg}}, nil
+	// Consensus:
+	case *p2p.Get:
+		return &p2p.Message{Message: &p2p.Message_Get{Get: msg}}, nil
+	case *p2p.Put:
+		return &p2p.Message{Message: &p2p.Message_Put{Put: msg}}, nil
+	case *p2p.PushQuery:
+		return &p2p.Message{Message: &p2p.Message_PushQuery{PushQuery: msg}}, nil
+	case *p2p.PullQuery:
+		return &p2p.Message{Message: &p2p.Message_PullQuery{PullQuery: msg}}, nil
+	case *p2p.Chits:
+		return &p2p.Message{Message: &p2p.Message_Chits{Chits: msg}}, nil
+	// Application:
+	case *p2p.AppRequest:
+		return &p2p.Message{Message: &p2p.Message_AppRequest{AppRequest: msg}}, nil
+	case *p2p.AppResponse:
+		return &p2p.Message{Message: &p2p.Message_AppResponse{AppResponse: msg}}, nil
+	case *p2p.AppError:
+		return &p2p.Message{Message: &p2p.Message_AppError{AppError: msg}}, nil
+	case *p2p.AppGossip:
+		return &p2p.Message{Message: &p2p.Message_AppGossip{AppGossip: msg}}, nil
+	// Simplex
+	case *p2p.Simplex:
+		return &p2p.Message{Message: &p2p.Message_Simplex{Simplex: msg}}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnknownMessageType, msg)
+	}
+}
+
 func ToOp(m *p2p.Message) (Op, error) {
 	switch msg := m.GetMessage().(type) {
 	case *p2p.Message_Ping:
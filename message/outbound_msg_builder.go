@@ -164,6 +164,16 @@ type OutboundMsgBuilder interface {
 		msg []byte,
 	) (OutboundMessage, error)
 
+	// AppRequestWithTraceParent is an AppRequest that carries the W3C
+	// [traceParent] of the requester's span.
+	AppRequestWithTraceParent(
+		chainID ids.ID,
+		requestID uint32,
+		deadline time.Duration,
+		msg []byte,
+		traceParent string,
+	) (OutboundMessage, error)
+
 	AppResponse(
 		chainID ids.ID,
 		requestID uint32,
@@ -665,15 +675,32 @@ func (b *outMsgBuilder) AppRequest(
 	requestID uint32,
 	deadline time.Duration,
 	msg []byte,
+) (OutboundMessage, error) {
+	return b.AppRequestWithTraceParent(
+		chainID,
+		requestID,
+		deadline,
+		msg,
+		"",
+	)
+}
+
+func (b *outMsgBuilder) AppRequestWithTraceParent(
+	chainID ids.ID,
+	requestID uint32,
+	deadline time.Duration,
+	msg []byte,
+	traceParent string,
 ) (OutboundMessage, error) {
 	return b.builder.createOutbound(
 		&p2p.Message{
 			Message: &p2p.Message_AppRequest{
 				AppRequest: &p2p.AppRequest{
-					ChainId:   chainID[:],
-					RequestId: requestID,
-					Deadline:  uint64(deadline),
-					AppBytes:  msg,
+					ChainId:     chainID[:],
+					RequestId:   requestID,
+					Deadline:    uint64(deadline),
+					AppBytes:    msg,
+					TraceParent: traceParent,
 				},
 			},
 		},
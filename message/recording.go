@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+)
+
+// MarshalInbound returns the serialized content of [msg] so that it can be
+// recorded and later recreated with [UnmarshalInbound]. Messages received from
+// peers are serialized as a p2p.Message and internal messages are serialized as
+// JSON.
+func MarshalInbound(msg InboundMessage) ([]byte, error) {
+	switch m := msg.Message().(type) {
+	case proto.Message:
+		wrapped, err := Wrap(m)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(wrapped)
+	default:
+		return json.Marshal(m)
+	}
+}
+
+// UnmarshalInbound recreates the message of type [op] from [nodeID] that was
+// serialized with [MarshalInbound]. The message never expires.
+func UnmarshalInbound(
+	nodeID ids.NodeID,
+	op Op,
+	b []byte,
+	onFinishedHandling func(),
+) (InboundMessage, error) {
+	msg, err := unmarshalInternal(op, b)
+	if errors.Is(err, errUnknownMessageType) {
+		msg, err = unmarshalExternal(op, b)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inboundMessage{
+		nodeID:             nodeID,
+		op:                 op,
+		message:            msg,
+		expiration:         mockable.MaxTime,
+		onFinishedHandling: onFinishedHandling,
+	}, nil
+}
+
+func unmarshalExternal(op Op, b []byte) (fmt.Stringer, error) {
+	m := new(p2p.Message)
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+	parsedOp, err := ToOp(m)
+	if err != nil {
+		return nil, err
+	}
+	if parsedOp != op {
+		return nil, fmt.Errorf("%w: expected %s but got %s", errUnknownMessageType, op, parsedOp)
+	}
+	return Unwrap(m)
+}
+
+// unmarshalInternal returns [errUnknownMessageType] if [op] isn't the op of an
+// internal message.
+func unmarshalInternal(op Op, b []byte) (fmt.Stringer, error) {
+	var msg fmt.Stringer
+	switch op {
+	case GetStateSummaryFrontierFailedOp:
+		msg = &GetStateSummaryFrontierFailed{}
+	case GetAcceptedStateSummaryFailedOp:
+		msg = &GetAcceptedStateSummaryFailed{}
+	case GetAcceptedFrontierFailedOp:
+		msg = &GetAcceptedFrontierFailed{}
+	case GetAcceptedFailedOp:
+		msg = &GetAcceptedFailed{}
+	case GetAncestorsFailedOp:
+		msg = &GetAncestorsFailed{}
+	case GetFailedOp:
+		msg = &GetFailed{}
+	case QueryFailedOp:
+		msg = &QueryFailed{}
+	case ConnectedOp:
+		msg = &Connected{}
+	case DisconnectedOp:
+		return disconnected, nil
+	case NotifyOp:
+		msg = &VMMessage{}
+	case GossipRequestOp:
+		return gossipRequest, nil
+	default:
+		return nil, errUnknownMessageType
+	}
+	return msg, json.Unmarshal(b, msg)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
+	"github.com/MetalBlockchain/metalgo/version"
+)
+
+func TestMarshalInbound(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	chainID := ids.GenerateTestID()
+	tests := []struct {
+		name string
+		msg  InboundMessage
+	}{
+		{
+			name: "chits",
+			msg: InboundChits(
+				chainID,
+				1,
+				ids.GenerateTestID(),
+				ids.GenerateTestID(),
+				ids.GenerateTestID(),
+				nodeID,
+			),
+		},
+		{
+			name: "push query",
+			msg: InboundPushQuery(
+				chainID,
+				1,
+				0,
+				[]byte{1, 2, 3},
+				2,
+				nodeID,
+			),
+		},
+		{
+			name: "query failed",
+			msg:  InternalQueryFailed(nodeID, chainID, 1),
+		},
+		{
+			name: "get ancestors failed",
+			msg:  InternalGetAncestorsFailed(nodeID, chainID, 1, p2p.EngineType_ENGINE_TYPE_CHAIN),
+		},
+		{
+			name: "connected",
+			msg:  InternalConnected(nodeID, version.CurrentApp),
+		},
+		{
+			name: "disconnected",
+			msg:  InternalDisconnected(nodeID),
+		},
+		{
+			name: "gossip request",
+			msg:  InternalGossipRequest(nodeID),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			msgBytes, err := MarshalInbound(test.msg)
+			require.NoError(err)
+
+			var handled bool
+			msg, err := UnmarshalInbound(nodeID, test.msg.Op(), msgBytes, func() {
+				handled = true
+			})
+			require.NoError(err)
+			require.Equal(nodeID, msg.NodeID())
+			require.Equal(test.msg.Op(), msg.Op())
+
+			expected := test.msg.Message()
+			if expectedProto, ok := expected.(proto.Message); ok {
+				require.True(proto.Equal(expectedProto, msg.Message().(proto.Message)))
+			} else {
+				require.Equal(expected.String(), msg.Message().String())
+			}
+
+			msg.OnFinishedHandling()
+			require.True(handled)
+		})
+	}
+}
+
+func TestUnmarshalInboundWrongOp(t *testing.T) {
+	msg := InboundChits(
+		ids.GenerateTestID(),
+		1,
+		ids.GenerateTestID(),
+		ids.GenerateTestID(),
+		ids.GenerateTestID(),
+		ids.EmptyNodeID,
+	)
+	msgBytes, err := MarshalInbound(msg)
+	require.NoError(t, err)
+
+	_, err = UnmarshalInbound(ids.EmptyNodeID, PutOp, msgBytes, nil)
+	require.ErrorIs(t, err, errUnknownMessageType)
+}
@@ -51,10 +51,11 @@ func (t *testExternalHandler) Connected(nodeID ids.NodeID, version *version.Appl
 	)
 }
 
-func (t *testExternalHandler) Disconnected(nodeID ids.NodeID) {
+func (t *testExternalHandler) Disconnected(nodeID ids.NodeID, subnetID ids.ID) {
 	t.log.Info(
 		"disconnected",
 		zap.Stringer("nodeID", nodeID),
+		zap.Stringer("subnetID", subnetID),
 	)
 }
 
@@ -14,7 +14,7 @@ var _ router.ExternalHandler = (*testHandler)(nil)
 type testHandler struct {
 	router.InboundHandler
 	ConnectedF    func(nodeID ids.NodeID, nodeVersion *version.Application, subnetID ids.ID)
-	DisconnectedF func(nodeID ids.NodeID)
+	DisconnectedF func(nodeID ids.NodeID, subnetID ids.ID)
 }
 
 func (h *testHandler) Connected(id ids.NodeID, nodeVersion *version.Application, subnetID ids.ID) {
@@ -23,8 +23,8 @@ func (h *testHandler) Connected(id ids.NodeID, nodeVersion *version.Application,
 	}
 }
 
-func (h *testHandler) Disconnected(id ids.NodeID) {
+func (h *testHandler) Disconnected(id ids.NodeID, subnetID ids.ID) {
 	if h.DisconnectedF != nil {
-		h.DisconnectedF(id)
+		h.DisconnectedF(id, subnetID)
 	}
 }
@@ -263,6 +263,49 @@ func (i *ipTracker) ManuallyGossip(subnetID ids.ID, nodeID ids.NodeID) {
 	i.addGossipableID(nodeID, subnetID, true)
 }
 
+// TrackSubnet marks the validators of [subnetID] as being desirable to connect
+// to. The most recent IPs of the validators that were not previously desirable
+// to connect to are returned.
+func (i *ipTracker) TrackSubnet(subnetID ids.ID) []*ips.ClaimedIPPort {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.trackedSubnets.Contains(subnetID) {
+		return nil
+	}
+	i.trackedSubnets.Add(subnetID)
+
+	var newIPs []*ips.ClaimedIPPort
+	for _, node := range i.tracked {
+		if !node.validatedSubnets.Contains(subnetID) {
+			continue
+		}
+
+		wantedConnection := node.wantsConnection()
+		node.trackedSubnets.Add(subnetID)
+		if !wantedConnection && node.ip != nil {
+			newIPs = append(newIPs, node.ip)
+		}
+	}
+	return newIPs
+}
+
+// UntrackSubnet stops considering the validators of [subnetID] as being
+// desirable to connect to. Existing connections are not closed.
+func (i *ipTracker) UntrackSubnet(subnetID ids.ID) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if !i.trackedSubnets.Contains(subnetID) {
+		return
+	}
+	i.trackedSubnets.Remove(subnetID)
+
+	for _, node := range i.tracked {
+		node.trackedSubnets.Remove(subnetID)
+	}
+}
+
 // WantsConnection returns true if any of the following conditions are met:
 //  1. The node has been manually tracked.
 //  2. The node has been manually gossiped on a tracked subnet.
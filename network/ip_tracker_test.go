@@ -1170,6 +1170,28 @@ func TestIPTracker_OnValidatorRemoved(t *testing.T) {
 	}
 }
 
+func TestIPTracker_TrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	tracker := newTestIPTracker(t)
+	tracker.OnValidatorAdded(subnetID, ip.NodeID, nil, ids.Empty, 0)
+	require.False(tracker.AddIP(ip))
+	require.False(tracker.WantsConnection(ip.NodeID))
+
+	require.Equal([]*ips.ClaimedIPPort{ip}, tracker.TrackSubnet(subnetID))
+	require.True(tracker.WantsConnection(ip.NodeID))
+	require.Contains(tracker.trackedSubnets, subnetID)
+
+	// Tracking an already tracked subnet is a no-op.
+	require.Empty(tracker.TrackSubnet(subnetID))
+
+	tracker.UntrackSubnet(subnetID)
+	require.False(tracker.WantsConnection(ip.NodeID))
+	require.NotContains(tracker.trackedSubnets, subnetID)
+	requireMetricsConsistent(t, tracker)
+}
+
 func TestIPTracker_BloomGrows(t *testing.T) {
 	tests := []struct {
 		name string
@@ -16,7 +16,8 @@ import (
 )
 
 type metrics struct {
-	// trackedSubnets does not include the primary network ID
+	// trackedSubnets does not include the primary network ID. It is protected
+	// by [lock].
 	trackedSubnets set.Set[ids.ID]
 
 	numTracked                   prometheus.Gauge
@@ -147,10 +148,33 @@ func newMetrics(
 	return m, err
 }
 
+// trackSubnet starts reporting the number of connected peers that are tracking
+// [subnetID], which is initialized to [numPeers].
+func (m *metrics) trackSubnet(subnetID ids.ID, numPeers int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.trackedSubnets.Add(subnetID)
+	m.numSubnetPeers.WithLabelValues(subnetID.String()).Set(float64(numPeers))
+}
+
+// untrackSubnet stops reporting the number of connected peers that are
+// tracking [subnetID].
+func (m *metrics) untrackSubnet(subnetID ids.ID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.trackedSubnets.Remove(subnetID)
+	m.numSubnetPeers.DeleteLabelValues(subnetID.String())
+}
+
 func (m *metrics) markConnected(peer peer.Peer) {
 	m.numPeers.Inc()
 	m.connected.Inc()
 
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	trackedSubnets := peer.TrackedSubnets()
 	for subnetID := range m.trackedSubnets {
 		if trackedSubnets.Contains(subnetID) {
@@ -158,9 +182,6 @@ func (m *metrics) markConnected(peer peer.Peer) {
 		}
 	}
 
-	m.lock.Lock()
-	defer m.lock.Unlock()
-
 	now := float64(time.Now().UnixNano())
 	m.peerConnectedStartTimes[peer.ID()] = now
 	m.peerConnectedStartTimesSum += now
@@ -170,6 +191,9 @@ func (m *metrics) markDisconnected(peer peer.Peer) {
 	m.numPeers.Dec()
 	m.disconnected.Inc()
 
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	trackedSubnets := peer.TrackedSubnets()
 	for subnetID := range m.trackedSubnets {
 		if trackedSubnets.Contains(subnetID) {
@@ -177,9 +201,6 @@ func (m *metrics) markDisconnected(peer peer.Peer) {
 		}
 	}
 
-	m.lock.Lock()
-	defer m.lock.Unlock()
-
 	peerID := peer.ID()
 	start := m.peerConnectedStartTimes[peerID]
 	m.peerConnectedStartTimesSum -= start
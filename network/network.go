@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net"
 	"net/netip"
@@ -30,6 +31,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/networking/router"
 	"github.com/MetalBlockchain/metalgo/snow/networking/sender"
 	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/bloom"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/ips"
@@ -81,6 +83,24 @@ type Network interface {
 	// connect to this ID.
 	ManuallyTrack(nodeID ids.NodeID, ip netip.AddrPort)
 
+	// TrackSubnet starts connecting to the validators of [subnetID] and
+	// reporting to peers that this node is tracking [subnetID]. Connected
+	// validators of [subnetID] are reconnected so that they learn that this
+	// node is now tracking [subnetID].
+	TrackSubnet(subnetID ids.ID) error
+
+	// UntrackSubnet stops reporting to peers that this node is tracking
+	// [subnetID]. Existing connections are not closed.
+	UntrackSubnet(subnetID ids.ID) error
+
+	// SetThrottlerConfig updates the limits of the inbound and outbound
+	// message throttlers.
+	SetThrottlerConfig(config ThrottlerConfig)
+
+	// SetHealthConfig updates the thresholds used by the health check. The
+	// halflife of the send fail rate is not updated.
+	SetHealthConfig(config HealthConfig)
+
 	// PeerInfo returns information about peers. If [nodeIDs] is empty, returns
 	// info about all peers that have finished the handshake. Otherwise, returns
 	// info about the peers in [nodeIDs] that have finished the handshake.
@@ -142,6 +162,14 @@ type network struct {
 
 	sendFailRateCalculator safemath.Averager
 
+	// healthConfig is the current configuration of the health check. It may
+	// be updated after the network is created, so [config.HealthConfig]
+	// should not be read directly.
+	healthConfig *utils.Atomic[HealthConfig]
+
+	// trackedSubnetsLock serializes changes to the set of tracked subnets.
+	trackedSubnetsLock sync.Mutex
+
 	// Tracks which peers know about which peers
 	ipTracker *ipTracker
 	peersLock sync.RWMutex
@@ -237,12 +265,12 @@ func NewNetwork(
 		return nil, fmt.Errorf("initializing peer metrics failed with: %w", err)
 	}
 
-	metrics, err := newMetrics(metricsRegisterer, config.TrackedSubnets)
+	metrics, err := newMetrics(metricsRegisterer, maps.Clone(config.TrackedSubnets))
 	if err != nil {
 		return nil, fmt.Errorf("initializing network metrics failed with: %w", err)
 	}
 
-	ipTracker, err := newIPTracker(config.TrackedSubnets, log, metricsRegisterer)
+	ipTracker, err := newIPTracker(maps.Clone(config.TrackedSubnets), log, metricsRegisterer)
 	if err != nil {
 		return nil, fmt.Errorf("initializing ip tracker failed with: %w", err)
 	}
@@ -282,6 +310,7 @@ func NewNetwork(
 		ResourceTracker:      config.ResourceTracker,
 		UptimeCalculator:     config.UptimeCalculator,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey),
+		Tracer:               config.Tracer,
 	}
 
 	onCloseCtx, cancel := context.WithCancel(context.Background())
@@ -291,6 +320,7 @@ func NewNetwork(
 		peerConfig:           peerConfig,
 		metrics:              metrics,
 		outboundMsgThrottler: outboundMsgThrottler,
+		healthConfig:         utils.NewAtomic(config.HealthConfig),
 
 		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
 		listener:                    listener,
@@ -364,9 +394,10 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	n.peersLock.RUnlock()
 
 	sendFailRate := n.sendFailRateCalculator.Read()
+	healthConfig := n.healthConfig.Get()
 
 	// Make sure we're connected to at least the minimum number of peers
-	isConnected := connectedTo >= int(n.config.HealthConfig.MinConnectedPeers)
+	isConnected := connectedTo >= int(healthConfig.MinConnectedPeers)
 	healthy := isConnected
 	details := map[string]interface{}{
 		ConnectedPeersKey: connectedTo,
@@ -380,7 +411,7 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	timeSinceLastMsgReceived := time.Duration(0)
 	if msgReceived {
 		timeSinceLastMsgReceived = now.Sub(lastMsgReceivedAt)
-		wasMsgReceivedRecently = timeSinceLastMsgReceived <= n.config.HealthConfig.MaxTimeSinceMsgReceived
+		wasMsgReceivedRecently = timeSinceLastMsgReceived <= healthConfig.MaxTimeSinceMsgReceived
 		details[TimeSinceLastMsgReceivedKey] = timeSinceLastMsgReceived.String()
 		n.metrics.timeSinceLastMsgReceived.Set(float64(timeSinceLastMsgReceived))
 	}
@@ -392,21 +423,21 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	timeSinceLastMsgSent := time.Duration(0)
 	if msgSent {
 		timeSinceLastMsgSent = now.Sub(lastMsgSentAt)
-		wasMsgSentRecently = timeSinceLastMsgSent <= n.config.HealthConfig.MaxTimeSinceMsgSent
+		wasMsgSentRecently = timeSinceLastMsgSent <= healthConfig.MaxTimeSinceMsgSent
 		details[TimeSinceLastMsgSentKey] = timeSinceLastMsgSent.String()
 		n.metrics.timeSinceLastMsgSent.Set(float64(timeSinceLastMsgSent))
 	}
 	healthy = healthy && wasMsgSentRecently
 
 	// Make sure the message send failed rate isn't too high
-	isMsgFailRate := sendFailRate <= n.config.HealthConfig.MaxSendFailRate
+	isMsgFailRate := sendFailRate <= healthConfig.MaxSendFailRate
 	healthy = healthy && isMsgFailRate
 	details[SendFailRateKey] = sendFailRate
 	n.metrics.sendFailRate.Set(sendFailRate)
 
 	reachablePrimaryNetworkValidator := true
 	// If we're a primary network validator, make sure we have ingress connections
-	if time.Since(n.startupTime) > n.config.NoIngressValidatorConnectionGracePeriod {
+	if time.Since(n.startupTime) > healthConfig.NoIngressValidatorConnectionGracePeriod {
 		connectedPrimaryValidatorInfo, isConnectedPrimaryValidatorErr := checkNoIngressConnections(n.config.MyNodeID, n, n.config.Validators)
 		reachablePrimaryNetworkValidator = isConnectedPrimaryValidatorErr == nil
 		details[PrimaryNetworkValidatorHealthKey] = connectedPrimaryValidatorInfo
@@ -417,27 +448,27 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	n.metrics.updatePeerConnectionLifetimeMetrics()
 
 	// Network layer is healthy
-	if healthy || !n.config.HealthConfig.Enabled {
+	if healthy || !healthConfig.Enabled {
 		return details, nil
 	}
 
 	var errorReasons []string
 	if !isConnected {
-		errorReasons = append(errorReasons, fmt.Sprintf("not connected to a minimum of %d peer(s) only %d", n.config.HealthConfig.MinConnectedPeers, connectedTo))
+		errorReasons = append(errorReasons, fmt.Sprintf("not connected to a minimum of %d peer(s) only %d", healthConfig.MinConnectedPeers, connectedTo))
 	}
 	if !msgReceived {
 		errorReasons = append(errorReasons, "no messages received from network")
 	} else if !wasMsgReceivedRecently {
-		errorReasons = append(errorReasons, fmt.Sprintf("no messages from network received in %s > %s", timeSinceLastMsgReceived, n.config.HealthConfig.MaxTimeSinceMsgReceived))
+		errorReasons = append(errorReasons, fmt.Sprintf("no messages from network received in %s > %s", timeSinceLastMsgReceived, healthConfig.MaxTimeSinceMsgReceived))
 	}
 	if !msgSent {
 		errorReasons = append(errorReasons, "no messages sent to network")
 	} else if !wasMsgSentRecently {
-		errorReasons = append(errorReasons, fmt.Sprintf("no messages from network sent in %s > %s", timeSinceLastMsgSent, n.config.HealthConfig.MaxTimeSinceMsgSent))
+		errorReasons = append(errorReasons, fmt.Sprintf("no messages from network sent in %s > %s", timeSinceLastMsgSent, healthConfig.MaxTimeSinceMsgSent))
 	}
 
 	if !isMsgFailRate {
-		errorReasons = append(errorReasons, fmt.Sprintf("messages failure send rate %g > %g", sendFailRate, n.config.HealthConfig.MaxSendFailRate))
+		errorReasons = append(errorReasons, fmt.Sprintf("messages failure send rate %g > %g", sendFailRate, healthConfig.MaxSendFailRate))
 	}
 
 	if !reachablePrimaryNetworkValidator {
@@ -487,7 +518,7 @@ func (n *network) Connected(nodeID ids.NodeID) {
 
 	peerVersion := peer.Version()
 	n.router.Connected(nodeID, peerVersion, constants.PrimaryNetworkID)
-	for subnetID := range n.peerConfig.MySubnets {
+	for subnetID := range n.peerConfig.GetMySubnets() {
 		if trackedSubnets.Contains(subnetID) {
 			n.router.Connected(nodeID, peerVersion, subnetID)
 		}
@@ -692,6 +723,130 @@ func (n *network) ManuallyTrack(nodeID ids.NodeID, ip netip.AddrPort) {
 	}
 }
 
+func (n *network) TrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID {
+		return errTrackingPrimaryNetwork
+	}
+
+	n.trackedSubnetsLock.Lock()
+	defer n.trackedSubnetsLock.Unlock()
+
+	mySubnets := n.peerConfig.GetMySubnets()
+	if mySubnets.Contains(subnetID) {
+		return nil
+	}
+	mySubnets = maps.Clone(mySubnets)
+	mySubnets.Add(subnetID)
+	n.peerConfig.SetMySubnets(mySubnets)
+
+	newIPs := n.ipTracker.TrackSubnet(subnetID)
+
+	// Chains of [subnetID] should consider this node to be connected to them.
+	n.router.Connected(n.config.MyNodeID, version.CurrentApp, subnetID)
+
+	var (
+		connectedPeers   []peer.Peer
+		reconnectedPeers []peer.Peer
+	)
+	n.peersLock.Lock()
+	for _, ip := range newIPs {
+		if _, connected := n.connectedPeers.GetByID(ip.NodeID); connected {
+			continue
+		}
+		if _, isTracked := n.trackedIPs[ip.NodeID]; isTracked {
+			continue
+		}
+
+		tracked := newTrackedIP(ip.AddrPort)
+		n.trackedIPs[ip.NodeID] = tracked
+		n.dial(ip.NodeID, tracked)
+	}
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		p, _ := n.connectedPeers.GetByIndex(i)
+		if trackedSubnets := p.TrackedSubnets(); !trackedSubnets.Contains(subnetID) {
+			continue
+		}
+
+		// Validators of [subnetID] only learn which subnets this node is
+		// tracking during the handshake, so they must be reconnected.
+		if _, isValidator := n.config.Validators.GetValidator(subnetID, p.ID()); isValidator {
+			reconnectedPeers = append(reconnectedPeers, p)
+		} else {
+			connectedPeers = append(connectedPeers, p)
+		}
+	}
+	n.peersLock.Unlock()
+
+	n.metrics.trackSubnet(subnetID, len(connectedPeers)+len(reconnectedPeers))
+	for _, p := range connectedPeers {
+		n.router.Connected(p.ID(), p.Version(), subnetID)
+	}
+	for _, p := range reconnectedPeers {
+		p.StartClose()
+	}
+
+	n.peerConfig.Log.Info("started tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numNewIPs", len(newIPs)),
+		zap.Int("numConnectedPeers", len(connectedPeers)),
+		zap.Int("numReconnectedPeers", len(reconnectedPeers)),
+	)
+	return nil
+}
+
+func (n *network) UntrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID {
+		return errTrackingPrimaryNetwork
+	}
+
+	n.trackedSubnetsLock.Lock()
+	defer n.trackedSubnetsLock.Unlock()
+
+	mySubnets := n.peerConfig.GetMySubnets()
+	if !mySubnets.Contains(subnetID) {
+		return nil
+	}
+	mySubnets = maps.Clone(mySubnets)
+	mySubnets.Remove(subnetID)
+	n.peerConfig.SetMySubnets(mySubnets)
+
+	n.ipTracker.UntrackSubnet(subnetID)
+	n.metrics.untrackSubnet(subnetID)
+
+	// Chains of [subnetID] should no longer consider this node, or the peers
+	// tracking [subnetID], to be connected to them.
+	n.router.Disconnected(n.config.MyNodeID, subnetID)
+
+	var disconnectedPeers []ids.NodeID
+	n.peersLock.RLock()
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		p, _ := n.connectedPeers.GetByIndex(i)
+		if trackedSubnets := p.TrackedSubnets(); trackedSubnets.Contains(subnetID) {
+			disconnectedPeers = append(disconnectedPeers, p.ID())
+		}
+	}
+	n.peersLock.RUnlock()
+
+	for _, nodeID := range disconnectedPeers {
+		n.router.Disconnected(nodeID, subnetID)
+	}
+
+	n.peerConfig.Log.Info("stopped tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numDisconnectedPeers", len(disconnectedPeers)),
+	)
+	return nil
+}
+
+func (n *network) SetThrottlerConfig(config ThrottlerConfig) {
+	n.peerConfig.InboundMsgThrottler.SetConfig(config.InboundMsgThrottlerConfig)
+	n.outboundMsgThrottler.SetConfig(config.OutboundMsgThrottlerConfig)
+}
+
+func (n *network) SetHealthConfig(config HealthConfig) {
+	n.healthConfig.Set(config)
+}
+
 func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 	// To avoid signature verification when the IP isn't needed, we
 	// optimistically filter out IPs. This can result in us not tracking an IP
@@ -858,7 +1013,13 @@ func (n *network) disconnectedFromConnecting(nodeID ids.NodeID) {
 
 func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	n.ipTracker.Disconnected(nodeID)
-	n.router.Disconnected(nodeID)
+	trackedSubnets := peer.TrackedSubnets()
+	for subnetID := range n.peerConfig.GetMySubnets() {
+		if trackedSubnets.Contains(subnetID) {
+			n.router.Disconnected(nodeID, subnetID)
+		}
+	}
+	n.router.Disconnected(nodeID, constants.PrimaryNetworkID)
 
 	n.peersLock.Lock()
 	defer n.peersLock.Unlock()
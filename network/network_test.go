@@ -27,6 +27,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/staking"
 	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/trace"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/bloom"
@@ -123,6 +124,7 @@ var (
 
 		MaximumInboundMessageTimeout: 30 * time.Second,
 		ResourceTracker:              newDefaultResourceTracker(),
+		Tracer:                       trace.Noop,
 		CPUTargeter:                  nil, // Set in init
 		DiskTargeter:                 nil, // Set in init
 	}
@@ -260,7 +262,7 @@ func newFullyConnectedTestNetwork(t *testing.T, handlers []router.InboundHandler
 						close(onAllConnected)
 					}
 				},
-				DisconnectedF: func(nodeID ids.NodeID) {
+				DisconnectedF: func(nodeID ids.NodeID, _ ids.ID) {
 					t.Logf("%s disconnected from %s", config.MyNodeID, nodeID)
 
 					globalLock.Lock()
@@ -307,6 +309,46 @@ func TestNewNetwork(t *testing.T) {
 	require.NoError(eg.Wait())
 }
 
+func TestTrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	_, networks, eg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil})
+	net := networks[0]
+
+	var connectedSubnets set.Set[ids.ID]
+	net.router = &testHandler{
+		ConnectedF: func(nodeID ids.NodeID, _ *version.Application, subnetID ids.ID) {
+			require.Equal(net.config.MyNodeID, nodeID)
+			connectedSubnets.Add(subnetID)
+		},
+		DisconnectedF: func(nodeID ids.NodeID, subnetID ids.ID) {
+			require.Equal(net.config.MyNodeID, nodeID)
+			require.Contains(connectedSubnets, subnetID)
+			connectedSubnets.Remove(subnetID)
+		},
+	}
+
+	err := net.TrackSubnet(constants.PrimaryNetworkID)
+	require.ErrorIs(err, errTrackingPrimaryNetwork)
+
+	subnetID := ids.GenerateTestID()
+	require.NoError(net.TrackSubnet(subnetID))
+	require.Contains(net.peerConfig.GetMySubnets(), subnetID)
+	require.Contains(net.ipTracker.trackedSubnets, subnetID)
+	require.Contains(net.metrics.trackedSubnets, subnetID)
+	require.NotContains(net.config.TrackedSubnets, subnetID)
+	require.Equal(set.Of(subnetID), connectedSubnets)
+
+	require.NoError(net.UntrackSubnet(subnetID))
+	require.NotContains(net.peerConfig.GetMySubnets(), subnetID)
+	require.NotContains(net.ipTracker.trackedSubnets, subnetID)
+	require.NotContains(net.metrics.trackedSubnets, subnetID)
+	require.Empty(connectedSubnets)
+
+	net.StartClose()
+	require.NoError(eg.Wait())
+}
+
 func TestIngressConnCount(t *testing.T) {
 	require := require.New(t)
 
@@ -320,8 +362,10 @@ func TestIngressConnCount(t *testing.T) {
 		})
 
 	for _, net := range networks {
-		net.config.NoIngressValidatorConnectionGracePeriod = 0
-		net.config.HealthConfig.Enabled = true
+		healthConfig := net.config.HealthConfig
+		healthConfig.NoIngressValidatorConnectionGracePeriod = 0
+		healthConfig.Enabled = true
+		net.SetHealthConfig(healthConfig)
 	}
 
 	require.Eventually(func() bool {
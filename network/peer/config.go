@@ -4,6 +4,7 @@
 package peer
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/networking/tracker"
 	"github.com/MetalBlockchain/metalgo/snow/uptime"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/trace"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/set"
 	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
@@ -35,8 +37,11 @@ type Config struct {
 	Router               router.InboundHandler
 	VersionCompatibility version.Compatibility
 	MyNodeID             ids.NodeID
-	// MySubnets does not include the primary network ID
+	// MySubnets does not include the primary network ID. Once the peers have
+	// been started, MySubnets must only be accessed through [GetMySubnets] and
+	// [SetMySubnets].
 	MySubnets          set.Set[ids.ID]
+	mySubnetsLock      sync.RWMutex
 	Beacons            validators.Manager
 	Validators         validators.Manager
 	NetworkID          uint32
@@ -62,4 +67,25 @@ type Config struct {
 
 	// IngressConnectionCount counts the ingress (to us) connections.
 	IngressConnectionCount atomic.Int64
+
+	// Traces the messages exchanged with the peer.
+	Tracer trace.Tracer
+}
+
+// GetMySubnets returns the subnets this node is tracking. The returned set must
+// not be modified.
+func (c *Config) GetMySubnets() set.Set[ids.ID] {
+	c.mySubnetsLock.RLock()
+	defer c.mySubnetsLock.RUnlock()
+
+	return c.MySubnets
+}
+
+// SetMySubnets replaces the subnets this node is tracking. Peers that have
+// already completed the handshake are not notified of the change.
+func (c *Config) SetMySubnets(mySubnets set.Set[ids.ID]) {
+	c.mySubnetsLock.Lock()
+	defer c.mySubnetsLock.Unlock()
+
+	c.MySubnets = mySubnets
 }
@@ -15,6 +15,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/MetalBlockchain/metalgo/ids"
@@ -30,6 +31,8 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/set"
 	"github.com/MetalBlockchain/metalgo/utils/wrappers"
 	"github.com/MetalBlockchain/metalgo/version"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -540,7 +543,7 @@ func (p *peer) writeMessages() {
 		mySignedIP.Timestamp,
 		mySignedIP.TLSSignature,
 		mySignedIP.BLSSignatureBytes,
-		p.MySubnets.List(),
+		p.GetMySubnets().List(),
 		p.SupportedACPs,
 		p.ObjectedACPs,
 		knownPeersFilter,
@@ -587,6 +590,13 @@ func (p *peer) writeMessages() {
 
 func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 	msgBytes := msg.Bytes()
+	_, span := p.Tracer.Start(context.Background(), "peer.writeMessage", oteltrace.WithAttributes(
+		attribute.Stringer("nodeID", p.id),
+		attribute.Stringer("messageOp", msg.Op()),
+		attribute.Int("size", len(msgBytes)),
+	))
+	defer span.End()
+
 	p.Log.Verbo("sending message",
 		zap.Stringer("op", msg.Op()),
 		zap.Stringer("nodeID", p.id),
@@ -770,7 +780,18 @@ func (p *peer) handle(msg message.InboundMessage) {
 	}
 
 	// Consensus and app-level messages
-	p.Router.HandleInbound(context.Background(), msg)
+	ctx := context.Background()
+	if m, ok := msg.Message().(*p2p.AppRequest); ok {
+		// Continue the trace of the requester, if it was propagated.
+		ctx = p.Tracer.ContextWithTraceParent(ctx, m.TraceParent)
+	}
+	ctx, span := p.Tracer.Start(ctx, "peer.handle", oteltrace.WithAttributes(
+		attribute.Stringer("nodeID", p.id),
+		attribute.Stringer("messageOp", msg.Op()),
+	))
+	defer span.End()
+
+	p.Router.HandleInbound(ctx, msg)
 }
 
 func (p *peer) handlePing(msg *p2p.Ping) {
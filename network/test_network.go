@@ -25,6 +25,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/staking"
 	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/trace"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
@@ -202,6 +203,7 @@ func NewTestNetworkConfig(
 			currentValidators,
 			resourceTracker.DiskTracker(),
 		),
+		Tracer: trace.Noop,
 	}, nil
 }
 
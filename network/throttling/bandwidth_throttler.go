@@ -47,6 +47,11 @@ type bandwidthThrottler interface {
 	// Must be called when we stop reading messages from [nodeID].
 	// It's safe for multiple goroutines to concurrently call RemoveNode.
 	RemoveNode(nodeID ids.NodeID)
+
+	// Update the refill rate and maximum burst size of all nodes.
+	// [config.MaxBurstSize] must be at least the maximum message size.
+	// It's safe for multiple goroutines to concurrently call SetConfig.
+	SetConfig(config BandwidthThrottlerConfig)
 }
 
 type BandwidthThrottlerConfig struct {
@@ -159,3 +164,15 @@ func (t *bandwidthThrottlerImpl) RemoveNode(nodeID ids.NodeID) {
 	}
 	delete(t.limiters, nodeID)
 }
+
+// See BandwidthThrottler.
+func (t *bandwidthThrottlerImpl) SetConfig(config BandwidthThrottlerConfig) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.BandwidthThrottlerConfig = config
+	for _, limiter := range t.limiters {
+		limiter.SetLimit(rate.Limit(config.RefillRate))
+		limiter.SetBurst(int(config.MaxBurstSize))
+	}
+}
@@ -37,4 +37,100 @@ type commonMsgThrottler struct {
 	nodeToAtLargeBytesUsed map[ids.NodeID]uint64
 	// Max number of unprocessed bytes from validators
 	maxVdrBytes uint64
+	// Max number of unprocessed bytes from the at-large allocation
+	maxAtLargeBytes uint64
+	// Number of bytes that must be released before the validator allocation
+	// is replenished. This is non-zero if the validator allocation shrank by
+	// more than the number of bytes that were remaining in it.
+	vdrBytesOwed uint64
+	// Number of bytes that must be released before the at-large allocation
+	// is replenished. This is non-zero if the at-large allocation shrank by
+	// more than the number of bytes that were remaining in it.
+	atLargeBytesOwed uint64
+}
+
+func newCommonMsgThrottler(
+	log logging.Logger,
+	vdrs validators.Manager,
+	config MsgByteThrottlerConfig,
+) commonMsgThrottler {
+	return commonMsgThrottler{
+		log:                    log,
+		vdrs:                   vdrs,
+		maxVdrBytes:            config.VdrAllocSize,
+		remainingVdrBytes:      config.VdrAllocSize,
+		maxAtLargeBytes:        config.AtLargeAllocSize,
+		remainingAtLargeBytes:  config.AtLargeAllocSize,
+		nodeMaxAtLargeBytes:    config.NodeMaxAtLargeBytes,
+		nodeToVdrBytesUsed:     make(map[ids.NodeID]uint64),
+		nodeToAtLargeBytesUsed: make(map[ids.NodeID]uint64),
+	}
+}
+
+// setConfig updates the size of the allocations. Bytes that are currently
+// used remain used until they are released.
+//
+// Assumes [t.lock] is held.
+func (t *commonMsgThrottler) setConfig(config MsgByteThrottlerConfig) {
+	t.remainingVdrBytes, t.vdrBytesOwed = resizeAllocation(
+		t.maxVdrBytes,
+		config.VdrAllocSize,
+		t.remainingVdrBytes,
+		t.vdrBytesOwed,
+	)
+	t.maxVdrBytes = config.VdrAllocSize
+
+	t.remainingAtLargeBytes, t.atLargeBytesOwed = resizeAllocation(
+		t.maxAtLargeBytes,
+		config.AtLargeAllocSize,
+		t.remainingAtLargeBytes,
+		t.atLargeBytesOwed,
+	)
+	t.maxAtLargeBytes = config.AtLargeAllocSize
+
+	t.nodeMaxAtLargeBytes = config.NodeMaxAtLargeBytes
+}
+
+// nodeAtLargeBytesAllowed returns the number of bytes [nodeID] may still take
+// from the at-large allocation.
+//
+// Assumes [t.lock] is held.
+func (t *commonMsgThrottler) nodeAtLargeBytesAllowed(nodeID ids.NodeID) uint64 {
+	used := t.nodeToAtLargeBytesUsed[nodeID]
+	if used >= t.nodeMaxAtLargeBytes {
+		return 0
+	}
+	return t.nodeMaxAtLargeBytes - used
+}
+
+// returnVdrBytes gives [numBytes] back to the validator allocation.
+//
+// Assumes [t.lock] is held.
+func (t *commonMsgThrottler) returnVdrBytes(numBytes uint64) {
+	owed := min(numBytes, t.vdrBytesOwed)
+	t.vdrBytesOwed -= owed
+	t.remainingVdrBytes += numBytes - owed
+}
+
+// returnAtLargeBytes gives [numBytes] back to the at-large allocation.
+//
+// Assumes [t.lock] is held.
+func (t *commonMsgThrottler) returnAtLargeBytes(numBytes uint64) {
+	owed := min(numBytes, t.atLargeBytesOwed)
+	t.atLargeBytesOwed -= owed
+	t.remainingAtLargeBytes += numBytes - owed
+}
+
+// resizeAllocation returns the number of remaining and owed bytes of an
+// allocation after its size is changed from [oldSize] to [newSize].
+func resizeAllocation(oldSize, newSize, remaining, owed uint64) (uint64, uint64) {
+	if newSize >= oldSize {
+		grown := newSize - oldSize
+		paid := min(grown, owed)
+		return remaining + grown - paid, owed - paid
+	}
+
+	shrunk := oldSize - newSize
+	taken := min(shrunk, remaining)
+	return remaining - taken, owed + shrunk - taken
 }
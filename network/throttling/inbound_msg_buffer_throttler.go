@@ -124,6 +124,22 @@ func (t *inboundMsgBufferThrottler) release(nodeID ids.NodeID) {
 	}
 }
 
+// setMaxProcessingMsgsPerNode updates the maximum number of messages that may
+// be processed from a node at once. Nodes that are waiting to acquire space
+// are unblocked if the new maximum allows them to proceed.
+func (t *inboundMsgBufferThrottler) setMaxProcessingMsgsPerNode(maxProcessingMsgsPerNode uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.maxProcessingMsgsPerNode = maxProcessingMsgsPerNode
+	for nodeID, waiting := range t.awaitingAcquire {
+		if t.nodeToNumProcessingMsgs[nodeID] < maxProcessingMsgsPerNode {
+			close(waiting)
+			delete(t.awaitingAcquire, nodeID)
+		}
+	}
+}
+
 type inboundMsgBufferThrottlerMetrics struct {
 	acquireLatency  metric.Averager
 	awaitingAcquire prometheus.Gauge
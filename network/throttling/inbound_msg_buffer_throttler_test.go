@@ -66,6 +66,31 @@ func TestMsgBufferThrottler(t *testing.T) {
 	require.Empty(throttler.nodeToNumProcessingMsgs)
 }
 
+// Test that raising the limit unblocks waiting acquires
+func TestMsgBufferThrottlerSetMaxProcessingMsgsPerNode(t *testing.T) {
+	require := require.New(t)
+	throttler, err := newInboundMsgBufferThrottler(prometheus.NewRegistry(), 1)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	throttler.Acquire(context.Background(), nodeID)
+
+	done := make(chan struct{})
+	go func() {
+		throttler.Acquire(context.Background(), nodeID)
+		close(done)
+	}()
+	select {
+	case <-done:
+		require.FailNow("should block on acquiring")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	throttler.setMaxProcessingMsgsPerNode(2)
+	<-done
+	require.Equal(uint64(2), throttler.nodeToNumProcessingMsgs[nodeID])
+}
+
 // Test inboundMsgBufferThrottler when an acquire is cancelled
 func TestMsgBufferThrottlerContextCancelled(t *testing.T) {
 	require := require.New(t)
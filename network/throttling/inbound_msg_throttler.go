@@ -40,6 +40,10 @@ type InboundMsgThrottler interface {
 	// Must be called when we stop reading messages from [nodeID].
 	// It's safe for multiple goroutines to concurrently call RemoveNode.
 	RemoveNode(nodeID ids.NodeID)
+
+	// SetConfig updates the byte, buffer and bandwidth limits of the
+	// throttler. The CPU and disk throttler configs are not updated.
+	SetConfig(config InboundMsgThrottlerConfig)
 }
 
 type InboundMsgThrottlerConfig struct {
@@ -174,3 +178,9 @@ func (t *inboundMsgThrottler) AddNode(nodeID ids.NodeID) {
 func (t *inboundMsgThrottler) RemoveNode(nodeID ids.NodeID) {
 	t.bandwidthThrottler.RemoveNode(nodeID)
 }
+
+func (t *inboundMsgThrottler) SetConfig(config InboundMsgThrottlerConfig) {
+	t.byteThrottler.setConfig(config.MsgByteThrottlerConfig)
+	t.bufferThrottler.setMaxProcessingMsgsPerNode(config.MaxProcessingMsgsPerNode)
+	t.bandwidthThrottler.SetConfig(config.BandwidthThrottlerConfig)
+}
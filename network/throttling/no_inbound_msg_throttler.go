@@ -26,3 +26,5 @@ func (*noInboundMsgThrottler) Acquire(context.Context, uint64, ids.NodeID) Relea
 func (*noInboundMsgThrottler) AddNode(ids.NodeID) {}
 
 func (*noInboundMsgThrottler) RemoveNode(ids.NodeID) {}
+
+func (*noInboundMsgThrottler) SetConfig(InboundMsgThrottlerConfig) {}
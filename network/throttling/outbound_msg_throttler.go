@@ -34,6 +34,10 @@ type OutboundMsgThrottler interface {
 	// sending the message. Must correspond to a previous call to
 	// Acquire([msg], [nodeID]) that returned true.
 	Release(msg message.OutboundMessage, nodeID ids.NodeID)
+
+	// SetConfig updates the limits of the throttler. Messages that were
+	// already acquired are not affected.
+	SetConfig(config MsgByteThrottlerConfig)
 }
 
 type outboundMsgThrottler struct {
@@ -48,16 +52,7 @@ func NewSybilOutboundMsgThrottler(
 	config MsgByteThrottlerConfig,
 ) (OutboundMsgThrottler, error) {
 	t := &outboundMsgThrottler{
-		commonMsgThrottler: commonMsgThrottler{
-			log:                    log,
-			vdrs:                   vdrs,
-			maxVdrBytes:            config.VdrAllocSize,
-			remainingVdrBytes:      config.VdrAllocSize,
-			remainingAtLargeBytes:  config.AtLargeAllocSize,
-			nodeMaxAtLargeBytes:    config.NodeMaxAtLargeBytes,
-			nodeToVdrBytesUsed:     make(map[ids.NodeID]uint64),
-			nodeToAtLargeBytesUsed: make(map[ids.NodeID]uint64),
-		},
+		commonMsgThrottler: newCommonMsgThrottler(log, vdrs, config),
 	}
 	return t, t.metrics.initialize(registerer)
 }
@@ -77,7 +72,7 @@ func (t *outboundMsgThrottler) Acquire(msg message.OutboundMessage, nodeID ids.N
 		// only give as many bytes as needed
 		bytesNeeded,
 		// don't exceed per-node limit
-		t.nodeMaxAtLargeBytes-t.nodeToAtLargeBytesUsed[nodeID],
+		t.nodeAtLargeBytesAllowed(nodeID),
 		// don't give more bytes than are in the allocation
 		t.remainingAtLargeBytes,
 	)
@@ -155,19 +150,28 @@ func (t *outboundMsgThrottler) Release(msg message.OutboundMessage, nodeID ids.N
 	if t.nodeToVdrBytesUsed[nodeID] == 0 {
 		delete(t.nodeToVdrBytesUsed, nodeID)
 	}
-	t.remainingVdrBytes += vdrBytesToReturn
+	t.returnVdrBytes(vdrBytesToReturn)
 
 	// [atLargeBytesToReturn] is the number of bytes from [msgSize]
 	// that will be given to the at-large allocation.
 	atLargeBytesToReturn := msgSize - vdrBytesToReturn
 	// Mark that [nodeID] has released these bytes.
-	t.remainingAtLargeBytes += atLargeBytesToReturn
+	t.returnAtLargeBytes(atLargeBytesToReturn)
 	t.nodeToAtLargeBytesUsed[nodeID] -= atLargeBytesToReturn
 	if t.nodeToAtLargeBytesUsed[nodeID] == 0 {
 		delete(t.nodeToAtLargeBytesUsed, nodeID)
 	}
 }
 
+func (t *outboundMsgThrottler) SetConfig(config MsgByteThrottlerConfig) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.commonMsgThrottler.setConfig(config)
+	t.metrics.remainingAtLargeBytes.Set(float64(t.remainingAtLargeBytes))
+	t.metrics.remainingVdrBytes.Set(float64(t.remainingVdrBytes))
+}
+
 type outboundMsgThrottlerMetrics struct {
 	acquireSuccesses      prometheus.Counter
 	acquireFailures       prometheus.Counter
@@ -218,3 +222,5 @@ func (*noOutboundMsgThrottler) Acquire(message.OutboundMessage, ids.NodeID) bool
 }
 
 func (*noOutboundMsgThrottler) Release(message.OutboundMessage, ids.NodeID) {}
+
+func (*noOutboundMsgThrottler) SetConfig(MsgByteThrottlerConfig) {}
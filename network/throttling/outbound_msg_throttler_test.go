@@ -259,6 +259,54 @@ func TestBypassThrottling(t *testing.T) {
 	require.Equal(config.AtLargeAllocSize-1, throttler.remainingAtLargeBytes)
 }
 
+// Ensure that bytes in use when an allocation shrinks are not returned to it
+func TestSybilOutboundMsgThrottlerSetConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	require := require.New(t)
+	config := MsgByteThrottlerConfig{
+		VdrAllocSize:        100,
+		AtLargeAllocSize:    100,
+		NodeMaxAtLargeBytes: 100,
+	}
+	vdrs := validators.NewManager()
+	throttlerIntf, err := NewSybilOutboundMsgThrottler(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		vdrs,
+		config,
+	)
+	require.NoError(err)
+	throttler := throttlerIntf.(*outboundMsgThrottler)
+
+	nodeID := ids.GenerateTestNodeID()
+	msg := testMsgWithSize(ctrl, 80)
+	require.True(throttlerIntf.Acquire(msg, nodeID))
+	require.Equal(uint64(20), throttler.remainingAtLargeBytes)
+
+	// Shrink the at-large allocation below the number of bytes in use.
+	throttlerIntf.SetConfig(MsgByteThrottlerConfig{
+		VdrAllocSize:        100,
+		AtLargeAllocSize:    50,
+		NodeMaxAtLargeBytes: 10,
+	})
+	require.Zero(throttler.remainingAtLargeBytes)
+	require.Equal(uint64(30), throttler.atLargeBytesOwed)
+	require.Equal(uint64(10), throttler.nodeMaxAtLargeBytes)
+
+	// [nodeID] is above the new per-node limit.
+	require.False(throttlerIntf.Acquire(testMsgWithSize(ctrl, 1), nodeID))
+
+	// Releasing the bytes should first pay back the owed bytes.
+	throttlerIntf.Release(msg, nodeID)
+	require.Equal(uint64(50), throttler.remainingAtLargeBytes)
+	require.Zero(throttler.atLargeBytesOwed)
+
+	// Growing the allocation should make the new bytes available.
+	throttlerIntf.SetConfig(config)
+	require.Equal(config.AtLargeAllocSize, throttler.remainingAtLargeBytes)
+	require.Equal(config.VdrAllocSize, throttler.remainingVdrBytes)
+}
+
 func testMsgWithSize(ctrl *gomock.Controller, size uint64) message.OutboundMessage {
 	msg := messagemock.NewOutboundMessage(ctrl)
 	msg.EXPECT().BypassThrottling().Return(false).AnyTimes()
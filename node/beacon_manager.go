@@ -37,9 +37,10 @@ func (b *beaconManager) Connected(nodeID ids.NodeID, nodeVersion *version.Applic
 	b.Router.Connected(nodeID, nodeVersion, subnetID)
 }
 
-func (b *beaconManager) Disconnected(nodeID ids.NodeID) {
-	if _, isBeacon := b.beacons.GetValidator(constants.PrimaryNetworkID, nodeID); isBeacon {
+func (b *beaconManager) Disconnected(nodeID ids.NodeID, subnetID ids.ID) {
+	_, isBeacon := b.beacons.GetValidator(constants.PrimaryNetworkID, nodeID)
+	if isBeacon && constants.PrimaryNetworkID == subnetID {
 		atomic.AddInt64(&b.numConns, -1)
 	}
-	b.Router.Disconnected(nodeID)
+	b.Router.Disconnected(nodeID, subnetID)
 }
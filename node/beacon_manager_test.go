@@ -66,16 +66,19 @@ func TestBeaconManager_DataRace(t *testing.T) {
 	require.Equal(int64(numValidators), b.numConns)
 
 	// disconnect numValidators validators
-	wg.Add(numValidators)
+	wg.Add(2 * numValidators)
 	mockRouter.EXPECT().
-		Disconnected(gomock.Any()).
-		Times(numValidators).
-		Do(func(ids.NodeID) {
+		Disconnected(gomock.Any(), gomock.Any()).
+		Times(2 * numValidators).
+		Do(func(ids.NodeID, ids.ID) {
 			wg.Done()
 		})
 
 	for _, nodeID := range validatorIDs {
-		go b.Disconnected(nodeID)
+		go func() {
+			b.Disconnected(nodeID, ids.GenerateTestID())
+			b.Disconnected(nodeID, constants.PrimaryNetworkID)
+		}()
 	}
 	wg.Wait()
 
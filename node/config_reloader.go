@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/api/admin"
+	"github.com/MetalBlockchain/metalgo/chains"
+	"github.com/MetalBlockchain/metalgo/config/node"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/network"
+	"github.com/MetalBlockchain/metalgo/network/throttling"
+	"github.com/MetalBlockchain/metalgo/snow/networking/router"
+	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+var (
+	_ admin.ConfigReloader = (*Node)(nil)
+
+	errNoConfigLoader = errors.New("node was created without a config loader")
+)
+
+// ConfigLoader returns the current config of the node, for example by
+// re-reading the config file.
+type ConfigLoader func() (node.Config, error)
+
+// reloadableConfig is the subset of the node config that can be updated while
+// the node is running.
+type reloadableConfig struct {
+	LogLevel             logging.Level
+	DisplayLevel         logging.Level
+	RotatingWriterConfig logging.RotatingWriterConfig
+
+	InboundMsgThrottlerConfig  throttling.InboundMsgThrottlerConfig
+	OutboundMsgThrottlerConfig throttling.MsgByteThrottlerConfig
+	NetworkHealthConfig        network.HealthConfig
+	RouterHealthConfig         router.HealthConfig
+
+	HTTPAllowedHosts []string
+	TraceSampleRate  float64
+
+	ChainConfigs  map[string]chains.ChainConfig
+	SubnetConfigs map[ids.ID]subnets.Config
+}
+
+func newReloadableConfig(config *node.Config) reloadableConfig {
+	return reloadableConfig{
+		LogLevel:                   config.LoggingConfig.LogLevel,
+		DisplayLevel:               config.LoggingConfig.DisplayLevel,
+		RotatingWriterConfig:       config.LoggingConfig.RotatingWriterConfig,
+		InboundMsgThrottlerConfig:  config.NetworkConfig.ThrottlerConfig.InboundMsgThrottlerConfig,
+		OutboundMsgThrottlerConfig: config.NetworkConfig.ThrottlerConfig.OutboundMsgThrottlerConfig,
+		NetworkHealthConfig:        config.NetworkConfig.HealthConfig,
+		RouterHealthConfig:         config.RouterHealthConfig,
+		HTTPAllowedHosts:           config.HTTPAllowedHosts,
+		TraceSampleRate:            config.TraceConfig.TraceSampleRate,
+		ChainConfigs:               config.ChainConfigs,
+		SubnetConfigs:              config.SubnetConfigs,
+	}
+}
+
+// ReloadConfig loads the config of the node again and applies the changes to
+// the settings that can be updated while the node is running:
+//
+//   - Log levels and log rotation
+//   - Inbound and outbound message throttler limits
+//   - Network and router health check thresholds
+//   - HTTP allowed hosts
+//   - Tracing sample rate
+//   - Chain and subnet configs, for VMs that support reconfiguration
+//
+// Changes to any other setting require the node to be restarted.
+func (n *Node) ReloadConfig() error {
+	if n.loadConfig == nil {
+		return errNoConfigLoader
+	}
+
+	n.reloadLock.Lock()
+	defer n.reloadLock.Unlock()
+
+	config, err := n.loadConfig()
+	if err != nil {
+		return fmt.Errorf("couldn't load config: %w", err)
+	}
+
+	var (
+		current = n.reloadableConfig
+		updated = newReloadableConfig(&config)
+		errs    []error
+	)
+	if current.LogLevel != updated.LogLevel || current.DisplayLevel != updated.DisplayLevel {
+		n.LogFactory.SetLevels(updated.LogLevel, updated.DisplayLevel)
+		n.Log.Info("updated log levels",
+			zap.Stringer("logLevel", updated.LogLevel),
+			zap.Stringer("displayLevel", updated.DisplayLevel),
+		)
+	}
+	if current.RotatingWriterConfig != updated.RotatingWriterConfig {
+		if err := n.LogFactory.SetRotatingWriterConfig(updated.RotatingWriterConfig); err != nil {
+			errs = append(errs, fmt.Errorf("couldn't update log rotation: %w", err))
+			updated.RotatingWriterConfig = current.RotatingWriterConfig
+		} else {
+			n.Log.Info("updated log rotation",
+				zap.Reflect("config", updated.RotatingWriterConfig),
+			)
+		}
+	}
+	if current.InboundMsgThrottlerConfig != updated.InboundMsgThrottlerConfig ||
+		current.OutboundMsgThrottlerConfig != updated.OutboundMsgThrottlerConfig {
+		n.Net.SetThrottlerConfig(network.ThrottlerConfig{
+			InboundMsgThrottlerConfig:  updated.InboundMsgThrottlerConfig,
+			OutboundMsgThrottlerConfig: updated.OutboundMsgThrottlerConfig,
+		})
+		n.Log.Info("updated message throttler limits",
+			zap.Reflect("inboundMsgThrottlerConfig", updated.InboundMsgThrottlerConfig),
+			zap.Reflect("outboundMsgThrottlerConfig", updated.OutboundMsgThrottlerConfig),
+		)
+	}
+	if current.NetworkHealthConfig != updated.NetworkHealthConfig {
+		n.Net.SetHealthConfig(updated.NetworkHealthConfig)
+		n.Log.Info("updated network health config",
+			zap.Reflect("config", updated.NetworkHealthConfig),
+		)
+	}
+	if current.RouterHealthConfig != updated.RouterHealthConfig {
+		n.chainRouter.SetHealthConfig(updated.RouterHealthConfig)
+		n.Log.Info("updated router health config",
+			zap.Reflect("config", updated.RouterHealthConfig),
+		)
+	}
+	if !slices.Equal(current.HTTPAllowedHosts, updated.HTTPAllowedHosts) {
+		n.APIServer.SetAllowedHosts(updated.HTTPAllowedHosts)
+	}
+	if current.TraceSampleRate != updated.TraceSampleRate {
+		n.tracer.SetSampleRate(updated.TraceSampleRate)
+		n.Log.Info("updated trace sample rate",
+			zap.Float64("traceSampleRate", updated.TraceSampleRate),
+		)
+	}
+
+	// The chain manager only reconfigures the chains whose config changed.
+	if err := n.chainManager.SetConfigs(updated.ChainConfigs, updated.SubnetConfigs); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't update chain configs: %w", err))
+		updated.ChainConfigs = current.ChainConfigs
+		updated.SubnetConfigs = current.SubnetConfigs
+	}
+
+	n.reloadableConfig = updated
+	return errors.Join(errs...)
+}
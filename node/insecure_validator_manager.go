@@ -41,16 +41,18 @@ func (i *insecureValidatorManager) Connected(vdrID ids.NodeID, nodeVersion *vers
 	i.Router.Connected(vdrID, nodeVersion, subnetID)
 }
 
-func (i *insecureValidatorManager) Disconnected(vdrID ids.NodeID) {
-	// RemoveWeight will only error here if there was an error reported during
-	// Add.
-	err := i.vdrs.RemoveWeight(constants.PrimaryNetworkID, vdrID, i.weight)
-	if err != nil {
-		i.log.Error("failed to remove weight",
-			zap.Stringer("nodeID", vdrID),
-			zap.Stringer("subnetID", constants.PrimaryNetworkID),
-			zap.Error(err),
-		)
+func (i *insecureValidatorManager) Disconnected(vdrID ids.NodeID, subnetID ids.ID) {
+	if constants.PrimaryNetworkID == subnetID {
+		// RemoveWeight will only error here if there was an error reported
+		// during Add.
+		err := i.vdrs.RemoveWeight(constants.PrimaryNetworkID, vdrID, i.weight)
+		if err != nil {
+			i.log.Error("failed to remove weight",
+				zap.Stringer("nodeID", vdrID),
+				zap.Stringer("subnetID", constants.PrimaryNetworkID),
+				zap.Error(err),
+			)
+		}
 	}
-	i.Router.Disconnected(vdrID)
+	i.Router.Disconnected(vdrID, subnetID)
 }
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"maps"
 	"net"
 	"net/netip"
 	"os"
@@ -27,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/MetalBlockchain/metalgo/api/admin"
+	"github.com/MetalBlockchain/metalgo/api/audit"
 	"github.com/MetalBlockchain/metalgo/api/health"
 	"github.com/MetalBlockchain/metalgo/api/info"
 	"github.com/MetalBlockchain/metalgo/api/metrics"
@@ -38,6 +40,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/database/leveldb"
 	"github.com/MetalBlockchain/metalgo/database/pebbledb"
 	"github.com/MetalBlockchain/metalgo/database/prefixdb"
+	"github.com/MetalBlockchain/metalgo/database/traceddb"
 	"github.com/MetalBlockchain/metalgo/genesis"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/indexer"
@@ -49,6 +52,8 @@ import (
 	"github.com/MetalBlockchain/metalgo/network/throttling"
 	"github.com/MetalBlockchain/metalgo/snow"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
+	"github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	"github.com/MetalBlockchain/metalgo/snow/networking/router"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	"github.com/MetalBlockchain/metalgo/snow/networking/tracker"
@@ -56,6 +61,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/staking"
 	"github.com/MetalBlockchain/metalgo/trace"
+	"github.com/MetalBlockchain/metalgo/trace/otlp"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
@@ -121,6 +127,7 @@ func New(
 	config *node.Config,
 	logFactory logging.Factory,
 	logger logging.Logger,
+	loadConfig ConfigLoader,
 ) (*Node, error) {
 	tlsCert := config.StakingTLSCert.Leaf
 	stakingCert, err := staking.ParseCertificate(tlsCert.Raw)
@@ -135,6 +142,8 @@ func New(
 		StakingTLSCert:   stakingCert,
 		ID:               ids.NodeIDFromCert(stakingCert),
 		Config:           config,
+		loadConfig:       loadConfig,
+		reloadableConfig: newReloadableConfig(config),
 	}
 
 	n.StakingSigner, err = newStakingSigner(config.StakingSignerConfig)
@@ -186,6 +195,13 @@ func New(
 		return nil, fmt.Errorf("couldn't initialize metrics: %w", err)
 	}
 
+	if n.Config.OTLPConfig.MetricsEnabled {
+		n.metricExporter, err = otlp.NewMetricExporter(n.Log, n.Config.OTLPConfig, n.MetricsGatherer)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't initialize metric exporter: %w", err)
+		}
+	}
+
 	n.initNAT()
 	if err := n.initAPIServer(); err != nil { // Start the API Server
 		return nil, fmt.Errorf("couldn't initialize API server: %w", err)
@@ -314,6 +330,8 @@ type Node struct {
 
 	// Monitors node health and runs health checks
 	health health.Health
+	// Posts health check transitions to the configured webhook, if any
+	healthWebhook *health.Webhook
 
 	// Build and parse messages, for both network layer and chain manager
 	msgCreator message.Creator
@@ -329,6 +347,9 @@ type Node struct {
 
 	uptimeCalculator uptime.LockedCalculator
 
+	// Records how validators respond to our requests
+	performanceTracker performance.Tracker
+
 	// dispatcher for events as they happen in consensus
 	BlockAcceptorGroup  snow.AcceptorGroup
 	TxAcceptorGroup     snow.AcceptorGroup
@@ -360,7 +381,19 @@ type Node struct {
 	// This node's configuration
 	Config *node.Config
 
+	// loadConfig re-reads the node's configuration. Nil if the config can't
+	// be reloaded.
+	loadConfig ConfigLoader
+	// reloadLock is held while the config is being reloaded.
+	reloadLock sync.Mutex
+	// reloadableConfig is the subset of the config that was last applied.
+	reloadableConfig reloadableConfig
+
 	tracer trace.Tracer
+	// Pushes the metrics over OTLP, if enabled
+	metricExporter *otlp.MetricExporter
+	// Records privileged API calls, if enabled
+	auditLog *audit.Log
 
 	// ensures that we only close the node once.
 	shutdownOnce sync.Once
@@ -552,9 +585,23 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		n.chainRouter = router.Trace(n.chainRouter, n.tracer)
 	}
 
+	n.uptimeCalculator = uptime.NewLockedCalculator()
+
+	// Track the performance of the validators
+	n.performanceTracker = performance.NewTracker(
+		n.Log,
+		n.vdrs,
+		n.uptimeCalculator,
+		prefixdb.New([]byte("performance"), n.DB),
+	)
+	go n.Log.RecoverAndPanic(n.performanceTracker.Dispatch)
+
 	// Configure benchlist
 	n.Config.BenchlistConfig.Validators = n.vdrs
-	n.Config.BenchlistConfig.Benchable = n.chainRouter
+	n.Config.BenchlistConfig.Benchable = benchlist.Benchables{
+		n.chainRouter,
+		n.performanceTracker,
+	}
 	n.Config.BenchlistConfig.BenchlistRegisterer = metrics.NewLabelGatherer(chains.ChainLabel)
 
 	err = n.MetricsGatherer.Register(
@@ -567,8 +614,6 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 
 	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
 
-	n.uptimeCalculator = uptime.NewLockedCalculator()
-
 	consensusRouter := n.chainRouter
 	if !n.Config.SybilProtectionEnabled {
 		// Sybil protection is disabled so we don't have a txID that added us as
@@ -626,6 +671,7 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	n.Config.NetworkConfig.ResourceTracker = n.resourceTracker
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
+	n.Config.NetworkConfig.Tracer = n.tracer
 
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
@@ -783,6 +829,9 @@ func (n *Node) initDatabase() error {
 	if err != nil {
 		return fmt.Errorf("couldn't create database: %w", err)
 	}
+	if n.Config.TraceConfig.DatabaseEnabled && n.Config.TraceConfig.ExporterConfig.Type != trace.Disabled {
+		n.DB = traceddb.New(n.DB, n.tracer)
+	}
 
 	rawExpectedGenesisHash := hashing.ComputeHash256(n.Config.GenesisBytes)
 
@@ -1003,6 +1052,13 @@ func (n *Node) initAPIServer() error {
 		return err
 	}
 
+	if n.Config.AuditConfig.Enabled {
+		n.auditLog, err = audit.New(n.Config.AuditConfig, n.Config.LoggingConfig.RotatingWriterConfig)
+		if err != nil {
+			return fmt.Errorf("couldn't initialize API audit log: %w", err)
+		}
+	}
+
 	n.APIServer, err = server.New(
 		n.Log,
 		listener,
@@ -1014,6 +1070,7 @@ func (n *Node) initAPIServer() error {
 		apiRegisterer,
 		n.Config.HTTPConfig.HTTPConfig,
 		n.Config.HTTPAllowedHosts,
+		n.auditLog,
 	)
 	return err
 }
@@ -1032,6 +1089,39 @@ func (n *Node) addDefaultVMAliases() error {
 	return nil
 }
 
+// initMessageRecording records the messages routed to the configured chains so
+// that they can be replayed against a fresh engine.
+func (n *Node) initMessageRecording() error {
+	if n.Config.MessageRecordingChains.Len() == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(n.Config.MessageRecordingDir, perms.ReadWriteExecute); err != nil {
+		return err
+	}
+
+	now := time.Now().UnixNano()
+	for chainID := range n.Config.MessageRecordingChains {
+		fileName := filepath.Join(n.Config.MessageRecordingDir, fmt.Sprintf("%s-%d.rec", chainID, now))
+		file, err := perms.Create(fileName, perms.ReadWrite)
+		if err != nil {
+			return err
+		}
+		recorder, err := replay.NewRecorder(file)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+
+		// The router closes the recorder when it is shut down.
+		n.chainRouter.RecordChain(chainID, recorder)
+		n.Log.Info("recording chain messages",
+			zap.Stringer("chainID", chainID),
+			zap.String("path", fileName),
+		)
+	}
+	return nil
+}
+
 // Create the chainManager and register the following VMs:
 // AVM, Simple Payments DAG, Simple Payments Chain, and Platform VM
 // Assumes n.DBManager, n.vdrs all initialized (non-nil)
@@ -1074,6 +1164,7 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 	n.timeoutManager, err = timeout.NewManager(
 		&n.Config.AdaptiveTimeoutConfig,
 		n.benchlistManager,
+		n.performanceTracker,
 		requestsReg,
 		responseReg,
 	)
@@ -1098,6 +1189,9 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 	if err != nil {
 		return fmt.Errorf("couldn't initialize chain router: %w", err)
 	}
+	if err := n.initMessageRecording(); err != nil {
+		return fmt.Errorf("couldn't initialize message recording: %w", err)
+	}
 
 	subnets, err := chains.NewSubnets(n.ID, n.Config.SubnetConfigs)
 	if err != nil {
@@ -1140,9 +1234,11 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			ChainConfigs:                            n.Config.ChainConfigs,
 			FrontierPollFrequency:                   n.Config.FrontierPollFrequency,
 			ConsensusAppConcurrency:                 n.Config.ConsensusAppConcurrency,
+			ConsensusRecorderSize:                   n.Config.ConsensusRecorderSize,
 			BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 			BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 			BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+			BootstrapArchive:                        n.Config.BootstrapArchive,
 			Upgrades:                                n.Config.UpgradeConfig,
 			ResourceTracker:                         n.resourceTracker,
 			StateSyncBeacons:                        n.Config.StateSyncIDs,
@@ -1183,7 +1279,7 @@ func (n *Node) initVMs() error {
 				UptimeLockedCalculator:    n.uptimeCalculator,
 				SybilProtectionEnabled:    n.Config.SybilProtectionEnabled,
 				PartialSyncPrimaryNetwork: n.Config.PartialSyncPrimaryNetwork,
-				TrackedSubnets:            n.Config.TrackedSubnets,
+				TrackedSubnets:            maps.Clone(n.Config.TrackedSubnets),
 				DynamicFeeConfig:          n.Config.DynamicFeeConfig,
 				ValidatorFeeConfig:        n.Config.ValidatorFeeConfig,
 				UptimePercentage:          n.Config.UptimeRequirement,
@@ -1309,15 +1405,27 @@ func (n *Node) initAdminAPI() error {
 			NodeConfig:   n.Config,
 			VMManager:    n.VMManager,
 			VMRegistry:   n.VMRegistry,
+			SubnetTracker: &subnetTracker{
+				net:    n.Net,
+				chains: n.chainManager,
+			},
+			ConfigReloader: n,
 		},
 	)
 	if err != nil {
 		return err
 	}
-	return n.APIServer.AddRoute(
+	if err := n.APIServer.AddRoute(
 		service,
 		"admin",
 		"",
+	); err != nil {
+		return err
+	}
+	return n.APIServer.AddRoute(
+		admin.NewProfileHandler(n.Log),
+		"admin",
+		"/profile",
 	)
 }
 
@@ -1333,6 +1441,7 @@ func (n *Node) initProfiler() {
 		filepath.Join(n.Config.ProfilerConfig.Dir, "continuous"),
 		n.Config.ProfilerConfig.Freq,
 		n.Config.ProfilerConfig.MaxNumFiles,
+		n.Config.ProfilerConfig.Types,
 	)
 	go n.Log.RecoverAndPanic(func() {
 		err := n.profiler.Dispatch()
@@ -1377,6 +1486,7 @@ func (n *Node) initInfoAPI() error {
 		n.Config.NetworkConfig.MyIPPort,
 		n.Net,
 		n.benchlistManager,
+		n.performanceTracker,
 	)
 	if err != nil {
 		return err
@@ -1388,6 +1498,33 @@ func (n *Node) initInfoAPI() error {
 	)
 }
 
+// newWrongBLSKeyCheck returns a health check that fails if [nodeID] is
+// registered as a primary network validator with a BLS key other than
+// [nodePK]. The node keeps operating with the wrong key, so the check is only a
+// warning.
+func newWrongBLSKeyCheck(vdrs validators.Manager, nodeID ids.NodeID, nodePK *bls.PublicKey) health.Checker {
+	wrongBLSKeyCheck := health.CheckerFunc(func(context.Context) (interface{}, error) {
+		vdr, ok := vdrs.GetValidator(constants.PrimaryNetworkID, nodeID)
+		if !ok {
+			return "node is not a validator", nil
+		}
+
+		vdrPK := vdr.PublicKey
+		if vdrPK == nil {
+			return "validator doesn't have a BLS key", nil
+		}
+
+		if nodePK.Equals(vdrPK) {
+			return "node has the correct BLS key", nil
+		}
+		return nil, fmt.Errorf("node has BLS key 0x%x, but is registered to the validator set with 0x%x",
+			bls.PublicKeyToCompressedBytes(nodePK),
+			bls.PublicKeyToCompressedBytes(vdrPK),
+		)
+	})
+	return health.WithSeverity(wrongBLSKeyCheck, health.Warning)
+}
+
 // initHealthAPI initializes the Health API service
 // Assumes n.Log, n.Net, n.APIServer, n.HTTPLog already initialized
 func (n *Node) initHealthAPI() error {
@@ -1399,7 +1536,13 @@ func (n *Node) initHealthAPI() error {
 		return err
 	}
 
-	n.health, err = health.New(n.Log, healthReg)
+	var notifiers []health.Notifier
+	if n.Config.HealthCheckWebhookURL != "" {
+		n.healthWebhook = health.NewWebhook(n.Log, n.Config.HealthCheckWebhookURL)
+		notifiers = append(notifiers, n.healthWebhook)
+	}
+
+	n.health, err = health.New(n.Log, healthReg, notifiers...)
 	if err != nil {
 		return err
 	}
@@ -1453,27 +1596,7 @@ func (n *Node) initHealthAPI() error {
 		return fmt.Errorf("couldn't register resource health check: %w", err)
 	}
 
-	wrongBLSKeyCheck := health.CheckerFunc(func(context.Context) (interface{}, error) {
-		vdr, ok := n.vdrs.GetValidator(constants.PrimaryNetworkID, n.ID)
-		if !ok {
-			return "node is not a validator", nil
-		}
-
-		vdrPK := vdr.PublicKey
-		if vdrPK == nil {
-			return "validator doesn't have a BLS key", nil
-		}
-
-		nodePK := n.StakingSigner.PublicKey()
-		if nodePK.Equals(vdrPK) {
-			return "node has the correct BLS key", nil
-		}
-		return nil, fmt.Errorf("node has BLS key 0x%x, but is registered to the validator set with 0x%x",
-			bls.PublicKeyToCompressedBytes(nodePK),
-			bls.PublicKeyToCompressedBytes(vdrPK),
-		)
-	})
-
+	wrongBLSKeyCheck := newWrongBLSKeyCheck(n.vdrs, n.ID, n.StakingSigner.PublicKey())
 	err = n.health.RegisterHealthCheck("bls", wrongBLSKeyCheck, health.ApplicationTag)
 	if err != nil {
 		return fmt.Errorf("couldn't register bls health check: %w", err)
@@ -1712,12 +1835,18 @@ func (n *Node) shutdown() {
 		n.resourceManager.Shutdown()
 	}
 	n.timeoutManager.Stop()
+	if n.performanceTracker != nil {
+		n.performanceTracker.Stop()
+	}
 	if n.chainManager != nil {
 		n.chainManager.Shutdown()
 	}
 	if n.profiler != nil {
 		n.profiler.Shutdown()
 	}
+	if n.healthWebhook != nil {
+		n.healthWebhook.Close()
+	}
 	if n.Net != nil {
 		n.Net.StartClose()
 	}
@@ -1726,6 +1855,13 @@ func (n *Node) shutdown() {
 			zap.Error(err),
 		)
 	}
+	if n.auditLog != nil {
+		if err := n.auditLog.Close(); err != nil {
+			n.Log.Debug("error closing API audit log",
+				zap.Error(err),
+			)
+		}
+	}
 	n.portMapper.UnmapAllPorts()
 	n.ipUpdater.Stop()
 	if err := n.indexer.Close(); err != nil {
@@ -1763,6 +1899,14 @@ func (n *Node) shutdown() {
 		)
 	}
 
+	if n.metricExporter != nil {
+		if err := n.metricExporter.Close(); err != nil {
+			n.Log.Warn("error during metric exporter shutdown",
+				zap.Error(err),
+			)
+		}
+	}
+
 	n.Log.Info("finished node shutdown")
 }
 
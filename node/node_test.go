@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/api/health"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/bls/signer/localsigner"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+// Verify that a node registered with the wrong BLS key is reported, without
+// being unhealthy.
+func TestWrongBLSKeyCheckIsWarning(t *testing.T) {
+	require := require.New(t)
+
+	nodeSigner, err := localsigner.New()
+	require.NoError(err)
+	vdrSigner, err := localsigner.New()
+	require.NoError(err)
+
+	var (
+		nodeID = ids.GenerateTestNodeID()
+		vdrs   = validators.NewManager()
+	)
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, vdrSigner.PublicKey(), ids.Empty, 1))
+
+	h, err := health.New(logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(h.RegisterHealthCheck(
+		"bls",
+		newWrongBLSKeyCheck(vdrs, nodeID, nodeSigner.PublicKey()),
+		health.ApplicationTag,
+	))
+
+	h.Start(context.Background(), time.Millisecond)
+	defer h.Stop()
+
+	require.Eventually(func() bool {
+		results, _ := h.Health()
+		return results["bls"].ContiguousFailures > 0
+	}, 30*time.Second, time.Millisecond)
+
+	results, healthy := h.Health()
+	require.Equal(health.Warning, results["bls"].Severity)
+	require.NotNil(results["bls"].Error)
+	require.True(healthy)
+}
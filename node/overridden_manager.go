@@ -87,6 +87,10 @@ func (o *overriddenManager) RegisterSetCallbackListener(_ ids.ID, listener valid
 	o.manager.RegisterSetCallbackListener(o.subnetID, listener)
 }
 
+func (o *overriddenManager) UnregisterSetCallbackListener(_ ids.ID, listener validators.SetCallbackListener) {
+	o.manager.UnregisterSetCallbackListener(o.subnetID, listener)
+}
+
 func (o *overriddenManager) String() string {
 	return fmt.Sprintf("Overridden Validator Manager (SubnetID = %s): %s", o.subnetID, o.manager)
 }
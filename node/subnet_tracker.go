@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"github.com/MetalBlockchain/metalgo/chains"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/network"
+)
+
+var _ chains.SubnetTracker = (*subnetTracker)(nil)
+
+// subnetTracker starts and stops tracking subnets at runtime by updating both
+// the peers this node connects to and the chains this node runs.
+type subnetTracker struct {
+	net    network.Network
+	chains chains.Manager
+}
+
+// TrackSubnet queues the creation of the chains of the subnet and connects to
+// the validators of the subnet. The chains are created asynchronously, so they
+// will not start bootstrapping before the connections are established.
+func (s *subnetTracker) TrackSubnet(subnetID ids.ID) error {
+	if err := s.chains.TrackSubnet(subnetID); err != nil {
+		return err
+	}
+	return s.net.TrackSubnet(subnetID)
+}
+
+// UntrackSubnet stops the chains of the subnet before telling peers that the
+// subnet is no longer tracked.
+func (s *subnetTracker) UntrackSubnet(subnetID ids.ID) error {
+	if err := s.chains.UntrackSubnet(subnetID); err != nil {
+		return err
+	}
+	return s.net.UntrackSubnet(subnetID)
+}
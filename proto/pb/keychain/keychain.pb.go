@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: keychain/keychain.proto
+
+package keychain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressesRequest) Reset() {
+	*x = AddressesRequest{}
+	mi := &file_keychain_keychain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesRequest) ProtoMessage() {}
+
+func (x *AddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesRequest.ProtoReflect.Descriptor instead.
+func (*AddressesRequest) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{0}
+}
+
+type AddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     [][]byte               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressesResponse) Reset() {
+	*x = AddressesResponse{}
+	mi := &file_keychain_keychain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesResponse) ProtoMessage() {}
+
+func (x *AddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesResponse.ProtoReflect.Descriptor instead.
+func (*AddressesResponse) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddressesResponse) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type SignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message       []byte                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	mi := &file_keychain_keychain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignRequest) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SignRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type SignResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	mi := &file_keychain_keychain_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type SignHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignHashRequest) Reset() {
+	*x = SignHashRequest{}
+	mi := &file_keychain_keychain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignHashRequest) ProtoMessage() {}
+
+func (x *SignHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignHashRequest.ProtoReflect.Descriptor instead.
+func (*SignHashRequest) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignHashRequest) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SignHashRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type SignHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignHashResponse) Reset() {
+	*x = SignHashResponse{}
+	mi := &file_keychain_keychain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignHashResponse) ProtoMessage() {}
+
+func (x *SignHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_keychain_keychain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignHashResponse.ProtoReflect.Descriptor instead.
+func (*SignHashResponse) Descriptor() ([]byte, []int) {
+	return file_keychain_keychain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignHashResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_keychain_keychain_proto protoreflect.FileDescriptor
+
+const file_keychain_keychain_proto_rawDesc = "" +
+	"\n" +
+	"\x17keychain/keychain.proto\x12\bkeychain\"\x12\n" +
+	"\x10AddressesRequest\"1\n" +
+	"\x11AddressesResponse\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\fR\taddresses\"A\n" +
+	"\vSignRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\fR\aaddress\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\fR\amessage\",\n" +
+	"\fSignResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\"?\n" +
+	"\x0fSignHashRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\fR\aaddress\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\"0\n" +
+	"\x10SignHashResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature2\xd0\x01\n" +
+	"\bKeychain\x12F\n" +
+	"\tAddresses\x12\x1a.keychain.AddressesRequest\x1a\x1b.keychain.AddressesResponse\"\x00\x127\n" +
+	"\x04Sign\x12\x15.keychain.SignRequest\x1a\x16.keychain.SignResponse\"\x00\x12C\n" +
+	"\bSignHash\x12\x19.keychain.SignHashRequest\x1a\x1a.keychain.SignHashResponse\"\x00B6Z4github.com/MetalBlockchain/metalgo/proto/pb/keychainb\x06proto3"
+
+var (
+	file_keychain_keychain_proto_rawDescOnce sync.Once
+	file_keychain_keychain_proto_rawDescData []byte
+)
+
+func file_keychain_keychain_proto_rawDescGZIP() []byte {
+	file_keychain_keychain_proto_rawDescOnce.Do(func() {
+		file_keychain_keychain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_keychain_keychain_proto_rawDesc), len(file_keychain_keychain_proto_rawDesc)))
+	})
+	return file_keychain_keychain_proto_rawDescData
+}
+
+var file_keychain_keychain_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_keychain_keychain_proto_goTypes = []any{
+	(*AddressesRequest)(nil),  // 0: keychain.AddressesRequest
+	(*AddressesResponse)(nil), // 1: keychain.AddressesResponse
+	(*SignRequest)(nil),       // 2: keychain.SignRequest
+	(*SignResponse)(nil),      // 3: keychain.SignResponse
+	(*SignHashRequest)(nil),   // 4: keychain.SignHashRequest
+	(*SignHashResponse)(nil),  // 5: keychain.SignHashResponse
+}
+var file_keychain_keychain_proto_depIdxs = []int32{
+	0, // 0: keychain.Keychain.Addresses:input_type -> keychain.AddressesRequest
+	2, // 1: keychain.Keychain.Sign:input_type -> keychain.SignRequest
+	4, // 2: keychain.Keychain.SignHash:input_type -> keychain.SignHashRequest
+	1, // 3: keychain.Keychain.Addresses:output_type -> keychain.AddressesResponse
+	3, // 4: keychain.Keychain.Sign:output_type -> keychain.SignResponse
+	5, // 5: keychain.Keychain.SignHash:output_type -> keychain.SignHashResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_keychain_keychain_proto_init() }
+func file_keychain_keychain_proto_init() {
+	if File_keychain_keychain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_keychain_keychain_proto_rawDesc), len(file_keychain_keychain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_keychain_keychain_proto_goTypes,
+		DependencyIndexes: file_keychain_keychain_proto_depIdxs,
+		MessageInfos:      file_keychain_keychain_proto_msgTypes,
+	}.Build()
+	File_keychain_keychain_proto = out.File
+	file_keychain_keychain_proto_goTypes = nil
+	file_keychain_keychain_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: keychain/keychain.proto
+
+package keychain
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Keychain_Addresses_FullMethodName = "/keychain.Keychain/Addresses"
+	Keychain_Sign_FullMethodName      = "/keychain.Keychain/Sign"
+	Keychain_SignHash_FullMethodName  = "/keychain.Keychain/SignHash"
+)
+
+// KeychainClient is the client API for Keychain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KeychainClient interface {
+	Addresses(ctx context.Context, in *AddressesRequest, opts ...grpc.CallOption) (*AddressesResponse, error)
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	SignHash(ctx context.Context, in *SignHashRequest, opts ...grpc.CallOption) (*SignHashResponse, error)
+}
+
+type keychainClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeychainClient(cc grpc.ClientConnInterface) KeychainClient {
+	return &keychainClient{cc}
+}
+
+func (c *keychainClient) Addresses(ctx context.Context, in *AddressesRequest, opts ...grpc.CallOption) (*AddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressesResponse)
+	err := c.cc.Invoke(ctx, Keychain_Addresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keychainClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, Keychain_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keychainClient) SignHash(ctx context.Context, in *SignHashRequest, opts ...grpc.CallOption) (*SignHashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignHashResponse)
+	err := c.cc.Invoke(ctx, Keychain_SignHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeychainServer is the server API for Keychain service.
+// All implementations must embed UnimplementedKeychainServer
+// for forward compatibility.
+type KeychainServer interface {
+	Addresses(context.Context, *AddressesRequest) (*AddressesResponse, error)
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	SignHash(context.Context, *SignHashRequest) (*SignHashResponse, error)
+	mustEmbedUnimplementedKeychainServer()
+}
+
+// UnimplementedKeychainServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKeychainServer struct{}
+
+func (UnimplementedKeychainServer) Addresses(context.Context, *AddressesRequest) (*AddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Addresses not implemented")
+}
+func (UnimplementedKeychainServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedKeychainServer) SignHash(context.Context, *SignHashRequest) (*SignHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHash not implemented")
+}
+func (UnimplementedKeychainServer) mustEmbedUnimplementedKeychainServer() {}
+func (UnimplementedKeychainServer) testEmbeddedByValue()                  {}
+
+// UnsafeKeychainServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeychainServer will
+// result in compilation errors.
+type UnsafeKeychainServer interface {
+	mustEmbedUnimplementedKeychainServer()
+}
+
+func RegisterKeychainServer(s grpc.ServiceRegistrar, srv KeychainServer) {
+	// If the following call pancis, it indicates UnimplementedKeychainServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Keychain_ServiceDesc, srv)
+}
+
+func _Keychain_Addresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeychainServer).Addresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keychain_Addresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeychainServer).Addresses(ctx, req.(*AddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keychain_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeychainServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keychain_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeychainServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keychain_SignHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeychainServer).SignHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keychain_SignHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeychainServer).SignHash(ctx, req.(*SignHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Keychain_ServiceDesc is the grpc.ServiceDesc for Keychain service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Keychain_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keychain.Keychain",
+	HandlerType: (*KeychainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Addresses",
+			Handler:    _Keychain_Addresses_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Keychain_Sign_Handler,
+		},
+		{
+			MethodName: "SignHash",
+			Handler:    _Keychain_SignHash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keychain/keychain.proto",
+}
@@ -2228,7 +2228,10 @@ type AppRequest struct {
 	// Timeout (ns) for this request
 	Deadline uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	// Request body
-	AppBytes      []byte `protobuf:"bytes,4,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
+	AppBytes []byte `protobuf:"bytes,4,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
+	// W3C traceparent of the requester's span. Only set if the requester
+	// propagates trace contexts and the span was sampled.
+	TraceParent   string `protobuf:"bytes,5,opt,name=trace_parent,json=traceParent,proto3" json:"trace_parent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2291,6 +2294,13 @@ func (x *AppRequest) GetAppBytes() []byte {
 	return nil
 }
 
+func (x *AppRequest) GetTraceParent() string {
+	if x != nil {
+		return x.TraceParent
+	}
+	return ""
+}
+
 // AppResponse is a VM-defined response sent in response to AppRequest
 type AppResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3535,14 +3545,15 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\vaccepted_id\x18\x04 \x01(\fR\n" +
 	"acceptedId\x123\n" +
 	"\x16preferred_id_at_height\x18\x05 \x01(\fR\x13preferredIdAtHeight\x12'\n" +
-	"\x0faccepted_height\x18\x06 \x01(\x04R\x0eacceptedHeight\"\x7f\n" +
+	"\x0faccepted_height\x18\x06 \x01(\x04R\x0eacceptedHeight\"\xa2\x01\n" +
 	"\n" +
 	"AppRequest\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\rR\trequestId\x12\x1a\n" +
 	"\bdeadline\x18\x03 \x01(\x04R\bdeadline\x12\x1b\n" +
-	"\tapp_bytes\x18\x04 \x01(\fR\bappBytes\"d\n" +
+	"\tapp_bytes\x18\x04 \x01(\fR\bappBytes\x12!\n" +
+	"\ftrace_parent\x18\x05 \x01(\tR\vtraceParent\"d\n" +
 	"\vAppResponse\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
@@ -80,10 +80,11 @@ type Error int32
 
 const (
 	// ERROR_UNSPECIFIED is used to indicate that no error occurred.
-	Error_ERROR_UNSPECIFIED                Error = 0
-	Error_ERROR_CLOSED                     Error = 1
-	Error_ERROR_NOT_FOUND                  Error = 2
-	Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED Error = 3
+	Error_ERROR_UNSPECIFIED                 Error = 0
+	Error_ERROR_CLOSED                      Error = 1
+	Error_ERROR_NOT_FOUND                   Error = 2
+	Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED  Error = 3
+	Error_ERROR_RECONFIGURE_NOT_IMPLEMENTED Error = 4
 )
 
 // Enum value maps for Error.
@@ -93,12 +94,14 @@ var (
 		1: "ERROR_CLOSED",
 		2: "ERROR_NOT_FOUND",
 		3: "ERROR_STATE_SYNC_NOT_IMPLEMENTED",
+		4: "ERROR_RECONFIGURE_NOT_IMPLEMENTED",
 	}
 	Error_value = map[string]int32{
-		"ERROR_UNSPECIFIED":                0,
-		"ERROR_CLOSED":                     1,
-		"ERROR_NOT_FOUND":                  2,
-		"ERROR_STATE_SYNC_NOT_IMPLEMENTED": 3,
+		"ERROR_UNSPECIFIED":                 0,
+		"ERROR_CLOSED":                      1,
+		"ERROR_NOT_FOUND":                   2,
+		"ERROR_STATE_SYNC_NOT_IMPLEMENTED":  3,
+		"ERROR_RECONFIGURE_NOT_IMPLEMENTED": 4,
 	}
 )
 
@@ -2850,6 +2853,94 @@ func (x *StateSummaryAcceptResponse) GetErr() Error {
 	return Error_ERROR_UNSPECIFIED
 }
 
+type ReconfigureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigBytes   []byte                 `protobuf:"bytes,1,opt,name=config_bytes,json=configBytes,proto3" json:"config_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconfigureRequest) Reset() {
+	*x = ReconfigureRequest{}
+	mi := &file_vm_vm_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconfigureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconfigureRequest) ProtoMessage() {}
+
+func (x *ReconfigureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vm_vm_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconfigureRequest.ProtoReflect.Descriptor instead.
+func (*ReconfigureRequest) Descriptor() ([]byte, []int) {
+	return file_vm_vm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReconfigureRequest) GetConfigBytes() []byte {
+	if x != nil {
+		return x.ConfigBytes
+	}
+	return nil
+}
+
+type ReconfigureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Err           Error                  `protobuf:"varint,1,opt,name=err,proto3,enum=vm.Error" json:"err,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconfigureResponse) Reset() {
+	*x = ReconfigureResponse{}
+	mi := &file_vm_vm_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconfigureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconfigureResponse) ProtoMessage() {}
+
+func (x *ReconfigureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vm_vm_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconfigureResponse.ProtoReflect.Descriptor instead.
+func (*ReconfigureResponse) Descriptor() ([]byte, []int) {
+	return file_vm_vm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReconfigureResponse) GetErr() Error {
+	if x != nil {
+		return x.Err
+	}
+	return Error_ERROR_UNSPECIFIED
+}
+
 var File_vm_vm_proto protoreflect.FileDescriptor
 
 const file_vm_vm_proto_rawDesc = "" +
@@ -3046,21 +3137,26 @@ const file_vm_vm_proto_rawDesc = "" +
 	"\x10MODE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMODE_SKIPPED\x10\x01\x12\x0f\n" +
 	"\vMODE_STATIC\x10\x02\x12\x10\n" +
-	"\fMODE_DYNAMIC\x10\x03*e\n" +
+	"\fMODE_DYNAMIC\x10\x03\"7\n" +
+	"\x12ReconfigureRequest\x12!\n" +
+	"\fconfig_bytes\x18\x01 \x01(\fR\vconfigBytes\"2\n" +
+	"\x13ReconfigureResponse\x12\x1b\n" +
+	"\x03err\x18\x01 \x01(\x0e2\t.vm.ErrorR\x03err*e\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13STATE_STATE_SYNCING\x10\x01\x12\x17\n" +
 	"\x13STATE_BOOTSTRAPPING\x10\x02\x12\x13\n" +
-	"\x0fSTATE_NORMAL_OP\x10\x03*k\n" +
+	"\x0fSTATE_NORMAL_OP\x10\x03*\x92\x01\n" +
 	"\x05Error\x12\x15\n" +
 	"\x11ERROR_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fERROR_CLOSED\x10\x01\x12\x13\n" +
 	"\x0fERROR_NOT_FOUND\x10\x02\x12$\n" +
-	" ERROR_STATE_SYNC_NOT_IMPLEMENTED\x10\x03*\\\n" +
+	" ERROR_STATE_SYNC_NOT_IMPLEMENTED\x10\x03\x12%\n" +
+	"!ERROR_RECONFIGURE_NOT_IMPLEMENTED\x10\x04*\\\n" +
 	"\aMessage\x12\x17\n" +
 	"\x13MESSAGE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13MESSAGE_BUILD_BLOCK\x10\x01\x12\x1f\n" +
-	"\x1bMESSAGE_STATE_SYNC_FINISHED\x10\x022\x99\x10\n" +
+	"\x1bMESSAGE_STATE_SYNC_FINISHED\x10\x022\xd9\x10\n" +
 	"\x02VM\x12;\n" +
 	"\n" +
 	"Initialize\x12\x15.vm.InitializeRequest\x1a\x16.vm.InitializeResponse\x125\n" +
@@ -3096,7 +3192,8 @@ const file_vm_vm_proto_rawDesc = "" +
 	"\vBlockVerify\x12\x16.vm.BlockVerifyRequest\x1a\x17.vm.BlockVerifyResponse\x12=\n" +
 	"\vBlockAccept\x12\x16.vm.BlockAcceptRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
 	"\vBlockReject\x12\x16.vm.BlockRejectRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\x12StateSummaryAccept\x12\x1d.vm.StateSummaryAcceptRequest\x1a\x1e.vm.StateSummaryAcceptResponseB0Z.github.com/MetalBlockchain/metalgo/proto/pb/vmb\x06proto3"
+	"\x12StateSummaryAccept\x12\x1d.vm.StateSummaryAcceptRequest\x1a\x1e.vm.StateSummaryAcceptResponse\x12>\n" +
+	"\vReconfigure\x12\x16.vm.ReconfigureRequest\x1a\x17.vm.ReconfigureResponseB0Z.github.com/MetalBlockchain/metalgo/proto/pb/vmb\x06proto3"
 
 var (
 	file_vm_vm_proto_rawDescOnce sync.Once
@@ -3111,7 +3208,7 @@ func file_vm_vm_proto_rawDescGZIP() []byte {
 }
 
 var file_vm_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_vm_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_vm_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_vm_vm_proto_goTypes = []any{
 	(State)(0),                                 // 0: vm.State
 	(Error)(0),                                 // 1: vm.Error
@@ -3161,40 +3258,42 @@ var file_vm_vm_proto_goTypes = []any{
 	(*GetStateSummaryResponse)(nil),            // 45: vm.GetStateSummaryResponse
 	(*StateSummaryAcceptRequest)(nil),          // 46: vm.StateSummaryAcceptRequest
 	(*StateSummaryAcceptResponse)(nil),         // 47: vm.StateSummaryAcceptResponse
-	(*timestamppb.Timestamp)(nil),              // 48: google.protobuf.Timestamp
-	(*_go.MetricFamily)(nil),                   // 49: io.prometheus.client.MetricFamily
-	(*emptypb.Empty)(nil),                      // 50: google.protobuf.Empty
+	(*ReconfigureRequest)(nil),                 // 48: vm.ReconfigureRequest
+	(*ReconfigureResponse)(nil),                // 49: vm.ReconfigureResponse
+	(*timestamppb.Timestamp)(nil),              // 50: google.protobuf.Timestamp
+	(*_go.MetricFamily)(nil),                   // 51: io.prometheus.client.MetricFamily
+	(*emptypb.Empty)(nil),                      // 52: google.protobuf.Empty
 }
 var file_vm_vm_proto_depIdxs = []int32{
 	5,  // 0: vm.InitializeRequest.network_upgrades:type_name -> vm.NetworkUpgrades
-	48, // 1: vm.NetworkUpgrades.apricot_phase_1_time:type_name -> google.protobuf.Timestamp
-	48, // 2: vm.NetworkUpgrades.apricot_phase_2_time:type_name -> google.protobuf.Timestamp
-	48, // 3: vm.NetworkUpgrades.apricot_phase_3_time:type_name -> google.protobuf.Timestamp
-	48, // 4: vm.NetworkUpgrades.apricot_phase_4_time:type_name -> google.protobuf.Timestamp
-	48, // 5: vm.NetworkUpgrades.apricot_phase_5_time:type_name -> google.protobuf.Timestamp
-	48, // 6: vm.NetworkUpgrades.apricot_phase_pre_6_time:type_name -> google.protobuf.Timestamp
-	48, // 7: vm.NetworkUpgrades.apricot_phase_6_time:type_name -> google.protobuf.Timestamp
-	48, // 8: vm.NetworkUpgrades.apricot_phase_post_6_time:type_name -> google.protobuf.Timestamp
-	48, // 9: vm.NetworkUpgrades.banff_time:type_name -> google.protobuf.Timestamp
-	48, // 10: vm.NetworkUpgrades.cortina_time:type_name -> google.protobuf.Timestamp
-	48, // 11: vm.NetworkUpgrades.durango_time:type_name -> google.protobuf.Timestamp
-	48, // 12: vm.NetworkUpgrades.etna_time:type_name -> google.protobuf.Timestamp
-	48, // 13: vm.NetworkUpgrades.fortuna_time:type_name -> google.protobuf.Timestamp
-	48, // 14: vm.NetworkUpgrades.granite_time:type_name -> google.protobuf.Timestamp
-	48, // 15: vm.InitializeResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 1: vm.NetworkUpgrades.apricot_phase_1_time:type_name -> google.protobuf.Timestamp
+	50, // 2: vm.NetworkUpgrades.apricot_phase_2_time:type_name -> google.protobuf.Timestamp
+	50, // 3: vm.NetworkUpgrades.apricot_phase_3_time:type_name -> google.protobuf.Timestamp
+	50, // 4: vm.NetworkUpgrades.apricot_phase_4_time:type_name -> google.protobuf.Timestamp
+	50, // 5: vm.NetworkUpgrades.apricot_phase_5_time:type_name -> google.protobuf.Timestamp
+	50, // 6: vm.NetworkUpgrades.apricot_phase_pre_6_time:type_name -> google.protobuf.Timestamp
+	50, // 7: vm.NetworkUpgrades.apricot_phase_6_time:type_name -> google.protobuf.Timestamp
+	50, // 8: vm.NetworkUpgrades.apricot_phase_post_6_time:type_name -> google.protobuf.Timestamp
+	50, // 9: vm.NetworkUpgrades.banff_time:type_name -> google.protobuf.Timestamp
+	50, // 10: vm.NetworkUpgrades.cortina_time:type_name -> google.protobuf.Timestamp
+	50, // 11: vm.NetworkUpgrades.durango_time:type_name -> google.protobuf.Timestamp
+	50, // 12: vm.NetworkUpgrades.etna_time:type_name -> google.protobuf.Timestamp
+	50, // 13: vm.NetworkUpgrades.fortuna_time:type_name -> google.protobuf.Timestamp
+	50, // 14: vm.NetworkUpgrades.granite_time:type_name -> google.protobuf.Timestamp
+	50, // 15: vm.InitializeResponse.timestamp:type_name -> google.protobuf.Timestamp
 	0,  // 16: vm.SetStateRequest.state:type_name -> vm.State
-	48, // 17: vm.SetStateResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 17: vm.SetStateResponse.timestamp:type_name -> google.protobuf.Timestamp
 	10, // 18: vm.CreateHandlersResponse.handlers:type_name -> vm.Handler
 	2,  // 19: vm.WaitForEventResponse.message:type_name -> vm.Message
-	48, // 20: vm.BuildBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
-	48, // 21: vm.ParseBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
-	48, // 22: vm.GetBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 20: vm.BuildBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 21: vm.ParseBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 22: vm.GetBlockResponse.timestamp:type_name -> google.protobuf.Timestamp
 	1,  // 23: vm.GetBlockResponse.err:type_name -> vm.Error
-	48, // 24: vm.BlockVerifyResponse.timestamp:type_name -> google.protobuf.Timestamp
-	48, // 25: vm.AppRequestMsg.deadline:type_name -> google.protobuf.Timestamp
+	50, // 24: vm.BlockVerifyResponse.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 25: vm.AppRequestMsg.deadline:type_name -> google.protobuf.Timestamp
 	16, // 26: vm.BatchedParseBlockResponse.response:type_name -> vm.ParseBlockResponse
 	1,  // 27: vm.GetBlockIDAtHeightResponse.err:type_name -> vm.Error
-	49, // 28: vm.GatherResponse.metric_families:type_name -> io.prometheus.client.MetricFamily
+	51, // 28: vm.GatherResponse.metric_families:type_name -> io.prometheus.client.MetricFamily
 	1,  // 29: vm.StateSyncEnabledResponse.err:type_name -> vm.Error
 	1,  // 30: vm.GetOngoingSyncStateSummaryResponse.err:type_name -> vm.Error
 	1,  // 31: vm.GetLastStateSummaryResponse.err:type_name -> vm.Error
@@ -3202,73 +3301,76 @@ var file_vm_vm_proto_depIdxs = []int32{
 	1,  // 33: vm.GetStateSummaryResponse.err:type_name -> vm.Error
 	3,  // 34: vm.StateSummaryAcceptResponse.mode:type_name -> vm.StateSummaryAcceptResponse.Mode
 	1,  // 35: vm.StateSummaryAcceptResponse.err:type_name -> vm.Error
-	4,  // 36: vm.VM.Initialize:input_type -> vm.InitializeRequest
-	7,  // 37: vm.VM.SetState:input_type -> vm.SetStateRequest
-	50, // 38: vm.VM.Shutdown:input_type -> google.protobuf.Empty
-	50, // 39: vm.VM.CreateHandlers:input_type -> google.protobuf.Empty
-	50, // 40: vm.VM.NewHTTPHandler:input_type -> google.protobuf.Empty
-	50, // 41: vm.VM.WaitForEvent:input_type -> google.protobuf.Empty
-	30, // 42: vm.VM.Connected:input_type -> vm.ConnectedRequest
-	31, // 43: vm.VM.Disconnected:input_type -> vm.DisconnectedRequest
-	13, // 44: vm.VM.BuildBlock:input_type -> vm.BuildBlockRequest
-	15, // 45: vm.VM.ParseBlock:input_type -> vm.ParseBlockRequest
-	17, // 46: vm.VM.GetBlock:input_type -> vm.GetBlockRequest
-	19, // 47: vm.VM.SetPreference:input_type -> vm.SetPreferenceRequest
-	50, // 48: vm.VM.Health:input_type -> google.protobuf.Empty
-	50, // 49: vm.VM.Version:input_type -> google.protobuf.Empty
-	26, // 50: vm.VM.AppRequest:input_type -> vm.AppRequestMsg
-	27, // 51: vm.VM.AppRequestFailed:input_type -> vm.AppRequestFailedMsg
-	28, // 52: vm.VM.AppResponse:input_type -> vm.AppResponseMsg
-	29, // 53: vm.VM.AppGossip:input_type -> vm.AppGossipMsg
-	50, // 54: vm.VM.Gather:input_type -> google.protobuf.Empty
-	32, // 55: vm.VM.GetAncestors:input_type -> vm.GetAncestorsRequest
-	34, // 56: vm.VM.BatchedParseBlock:input_type -> vm.BatchedParseBlockRequest
-	36, // 57: vm.VM.GetBlockIDAtHeight:input_type -> vm.GetBlockIDAtHeightRequest
-	50, // 58: vm.VM.StateSyncEnabled:input_type -> google.protobuf.Empty
-	50, // 59: vm.VM.GetOngoingSyncStateSummary:input_type -> google.protobuf.Empty
-	50, // 60: vm.VM.GetLastStateSummary:input_type -> google.protobuf.Empty
-	42, // 61: vm.VM.ParseStateSummary:input_type -> vm.ParseStateSummaryRequest
-	44, // 62: vm.VM.GetStateSummary:input_type -> vm.GetStateSummaryRequest
-	20, // 63: vm.VM.BlockVerify:input_type -> vm.BlockVerifyRequest
-	22, // 64: vm.VM.BlockAccept:input_type -> vm.BlockAcceptRequest
-	23, // 65: vm.VM.BlockReject:input_type -> vm.BlockRejectRequest
-	46, // 66: vm.VM.StateSummaryAccept:input_type -> vm.StateSummaryAcceptRequest
-	6,  // 67: vm.VM.Initialize:output_type -> vm.InitializeResponse
-	8,  // 68: vm.VM.SetState:output_type -> vm.SetStateResponse
-	50, // 69: vm.VM.Shutdown:output_type -> google.protobuf.Empty
-	9,  // 70: vm.VM.CreateHandlers:output_type -> vm.CreateHandlersResponse
-	11, // 71: vm.VM.NewHTTPHandler:output_type -> vm.NewHTTPHandlerResponse
-	12, // 72: vm.VM.WaitForEvent:output_type -> vm.WaitForEventResponse
-	50, // 73: vm.VM.Connected:output_type -> google.protobuf.Empty
-	50, // 74: vm.VM.Disconnected:output_type -> google.protobuf.Empty
-	14, // 75: vm.VM.BuildBlock:output_type -> vm.BuildBlockResponse
-	16, // 76: vm.VM.ParseBlock:output_type -> vm.ParseBlockResponse
-	18, // 77: vm.VM.GetBlock:output_type -> vm.GetBlockResponse
-	50, // 78: vm.VM.SetPreference:output_type -> google.protobuf.Empty
-	24, // 79: vm.VM.Health:output_type -> vm.HealthResponse
-	25, // 80: vm.VM.Version:output_type -> vm.VersionResponse
-	50, // 81: vm.VM.AppRequest:output_type -> google.protobuf.Empty
-	50, // 82: vm.VM.AppRequestFailed:output_type -> google.protobuf.Empty
-	50, // 83: vm.VM.AppResponse:output_type -> google.protobuf.Empty
-	50, // 84: vm.VM.AppGossip:output_type -> google.protobuf.Empty
-	38, // 85: vm.VM.Gather:output_type -> vm.GatherResponse
-	33, // 86: vm.VM.GetAncestors:output_type -> vm.GetAncestorsResponse
-	35, // 87: vm.VM.BatchedParseBlock:output_type -> vm.BatchedParseBlockResponse
-	37, // 88: vm.VM.GetBlockIDAtHeight:output_type -> vm.GetBlockIDAtHeightResponse
-	39, // 89: vm.VM.StateSyncEnabled:output_type -> vm.StateSyncEnabledResponse
-	40, // 90: vm.VM.GetOngoingSyncStateSummary:output_type -> vm.GetOngoingSyncStateSummaryResponse
-	41, // 91: vm.VM.GetLastStateSummary:output_type -> vm.GetLastStateSummaryResponse
-	43, // 92: vm.VM.ParseStateSummary:output_type -> vm.ParseStateSummaryResponse
-	45, // 93: vm.VM.GetStateSummary:output_type -> vm.GetStateSummaryResponse
-	21, // 94: vm.VM.BlockVerify:output_type -> vm.BlockVerifyResponse
-	50, // 95: vm.VM.BlockAccept:output_type -> google.protobuf.Empty
-	50, // 96: vm.VM.BlockReject:output_type -> google.protobuf.Empty
-	47, // 97: vm.VM.StateSummaryAccept:output_type -> vm.StateSummaryAcceptResponse
-	67, // [67:98] is the sub-list for method output_type
-	36, // [36:67] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	1,  // 36: vm.ReconfigureResponse.err:type_name -> vm.Error
+	4,  // 37: vm.VM.Initialize:input_type -> vm.InitializeRequest
+	7,  // 38: vm.VM.SetState:input_type -> vm.SetStateRequest
+	52, // 39: vm.VM.Shutdown:input_type -> google.protobuf.Empty
+	52, // 40: vm.VM.CreateHandlers:input_type -> google.protobuf.Empty
+	52, // 41: vm.VM.NewHTTPHandler:input_type -> google.protobuf.Empty
+	52, // 42: vm.VM.WaitForEvent:input_type -> google.protobuf.Empty
+	30, // 43: vm.VM.Connected:input_type -> vm.ConnectedRequest
+	31, // 44: vm.VM.Disconnected:input_type -> vm.DisconnectedRequest
+	13, // 45: vm.VM.BuildBlock:input_type -> vm.BuildBlockRequest
+	15, // 46: vm.VM.ParseBlock:input_type -> vm.ParseBlockRequest
+	17, // 47: vm.VM.GetBlock:input_type -> vm.GetBlockRequest
+	19, // 48: vm.VM.SetPreference:input_type -> vm.SetPreferenceRequest
+	52, // 49: vm.VM.Health:input_type -> google.protobuf.Empty
+	52, // 50: vm.VM.Version:input_type -> google.protobuf.Empty
+	26, // 51: vm.VM.AppRequest:input_type -> vm.AppRequestMsg
+	27, // 52: vm.VM.AppRequestFailed:input_type -> vm.AppRequestFailedMsg
+	28, // 53: vm.VM.AppResponse:input_type -> vm.AppResponseMsg
+	29, // 54: vm.VM.AppGossip:input_type -> vm.AppGossipMsg
+	52, // 55: vm.VM.Gather:input_type -> google.protobuf.Empty
+	32, // 56: vm.VM.GetAncestors:input_type -> vm.GetAncestorsRequest
+	34, // 57: vm.VM.BatchedParseBlock:input_type -> vm.BatchedParseBlockRequest
+	36, // 58: vm.VM.GetBlockIDAtHeight:input_type -> vm.GetBlockIDAtHeightRequest
+	52, // 59: vm.VM.StateSyncEnabled:input_type -> google.protobuf.Empty
+	52, // 60: vm.VM.GetOngoingSyncStateSummary:input_type -> google.protobuf.Empty
+	52, // 61: vm.VM.GetLastStateSummary:input_type -> google.protobuf.Empty
+	42, // 62: vm.VM.ParseStateSummary:input_type -> vm.ParseStateSummaryRequest
+	44, // 63: vm.VM.GetStateSummary:input_type -> vm.GetStateSummaryRequest
+	20, // 64: vm.VM.BlockVerify:input_type -> vm.BlockVerifyRequest
+	22, // 65: vm.VM.BlockAccept:input_type -> vm.BlockAcceptRequest
+	23, // 66: vm.VM.BlockReject:input_type -> vm.BlockRejectRequest
+	46, // 67: vm.VM.StateSummaryAccept:input_type -> vm.StateSummaryAcceptRequest
+	48, // 68: vm.VM.Reconfigure:input_type -> vm.ReconfigureRequest
+	6,  // 69: vm.VM.Initialize:output_type -> vm.InitializeResponse
+	8,  // 70: vm.VM.SetState:output_type -> vm.SetStateResponse
+	52, // 71: vm.VM.Shutdown:output_type -> google.protobuf.Empty
+	9,  // 72: vm.VM.CreateHandlers:output_type -> vm.CreateHandlersResponse
+	11, // 73: vm.VM.NewHTTPHandler:output_type -> vm.NewHTTPHandlerResponse
+	12, // 74: vm.VM.WaitForEvent:output_type -> vm.WaitForEventResponse
+	52, // 75: vm.VM.Connected:output_type -> google.protobuf.Empty
+	52, // 76: vm.VM.Disconnected:output_type -> google.protobuf.Empty
+	14, // 77: vm.VM.BuildBlock:output_type -> vm.BuildBlockResponse
+	16, // 78: vm.VM.ParseBlock:output_type -> vm.ParseBlockResponse
+	18, // 79: vm.VM.GetBlock:output_type -> vm.GetBlockResponse
+	52, // 80: vm.VM.SetPreference:output_type -> google.protobuf.Empty
+	24, // 81: vm.VM.Health:output_type -> vm.HealthResponse
+	25, // 82: vm.VM.Version:output_type -> vm.VersionResponse
+	52, // 83: vm.VM.AppRequest:output_type -> google.protobuf.Empty
+	52, // 84: vm.VM.AppRequestFailed:output_type -> google.protobuf.Empty
+	52, // 85: vm.VM.AppResponse:output_type -> google.protobuf.Empty
+	52, // 86: vm.VM.AppGossip:output_type -> google.protobuf.Empty
+	38, // 87: vm.VM.Gather:output_type -> vm.GatherResponse
+	33, // 88: vm.VM.GetAncestors:output_type -> vm.GetAncestorsResponse
+	35, // 89: vm.VM.BatchedParseBlock:output_type -> vm.BatchedParseBlockResponse
+	37, // 90: vm.VM.GetBlockIDAtHeight:output_type -> vm.GetBlockIDAtHeightResponse
+	39, // 91: vm.VM.StateSyncEnabled:output_type -> vm.StateSyncEnabledResponse
+	40, // 92: vm.VM.GetOngoingSyncStateSummary:output_type -> vm.GetOngoingSyncStateSummaryResponse
+	41, // 93: vm.VM.GetLastStateSummary:output_type -> vm.GetLastStateSummaryResponse
+	43, // 94: vm.VM.ParseStateSummary:output_type -> vm.ParseStateSummaryResponse
+	45, // 95: vm.VM.GetStateSummary:output_type -> vm.GetStateSummaryResponse
+	21, // 96: vm.VM.BlockVerify:output_type -> vm.BlockVerifyResponse
+	52, // 97: vm.VM.BlockAccept:output_type -> google.protobuf.Empty
+	52, // 98: vm.VM.BlockReject:output_type -> google.protobuf.Empty
+	47, // 99: vm.VM.StateSummaryAccept:output_type -> vm.StateSummaryAcceptResponse
+	49, // 100: vm.VM.Reconfigure:output_type -> vm.ReconfigureResponse
+	69, // [69:101] is the sub-list for method output_type
+	37, // [37:69] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_vm_vm_proto_init() }
@@ -3284,7 +3386,7 @@ func file_vm_vm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vm_vm_proto_rawDesc), len(file_vm_vm_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   44,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -51,6 +51,7 @@ const (
 	VM_BlockAccept_FullMethodName                = "/vm.VM/BlockAccept"
 	VM_BlockReject_FullMethodName                = "/vm.VM/BlockReject"
 	VM_StateSummaryAccept_FullMethodName         = "/vm.VM/StateSummaryAccept"
+	VM_Reconfigure_FullMethodName                = "/vm.VM/Reconfigure"
 )
 
 // VMClient is the client API for VM service.
@@ -124,6 +125,10 @@ type VMClient interface {
 	BlockReject(ctx context.Context, in *BlockRejectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(ctx context.Context, in *StateSummaryAcceptRequest, opts ...grpc.CallOption) (*StateSummaryAcceptResponse, error)
+	// ReconfigurableVM
+	//
+	// Reconfigure updates the chain config of the VM.
+	Reconfigure(ctx context.Context, in *ReconfigureRequest, opts ...grpc.CallOption) (*ReconfigureResponse, error)
 }
 
 type vMClient struct {
@@ -444,6 +449,16 @@ func (c *vMClient) StateSummaryAccept(ctx context.Context, in *StateSummaryAccep
 	return out, nil
 }
 
+func (c *vMClient) Reconfigure(ctx context.Context, in *ReconfigureRequest, opts ...grpc.CallOption) (*ReconfigureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconfigureResponse)
+	err := c.cc.Invoke(ctx, VM_Reconfigure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VMServer is the server API for VM service.
 // All implementations must embed UnimplementedVMServer
 // for forward compatibility.
@@ -515,6 +530,10 @@ type VMServer interface {
 	BlockReject(context.Context, *BlockRejectRequest) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error)
+	// ReconfigurableVM
+	//
+	// Reconfigure updates the chain config of the VM.
+	Reconfigure(context.Context, *ReconfigureRequest) (*ReconfigureResponse, error)
 	mustEmbedUnimplementedVMServer()
 }
 
@@ -618,6 +637,9 @@ func (UnimplementedVMServer) BlockReject(context.Context, *BlockRejectRequest) (
 func (UnimplementedVMServer) StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateSummaryAccept not implemented")
 }
+func (UnimplementedVMServer) Reconfigure(context.Context, *ReconfigureRequest) (*ReconfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconfigure not implemented")
+}
 func (UnimplementedVMServer) mustEmbedUnimplementedVMServer() {}
 func (UnimplementedVMServer) testEmbeddedByValue()            {}
 
@@ -1197,6 +1219,24 @@ func _VM_StateSummaryAccept_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VM_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).Reconfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VM_Reconfigure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).Reconfigure(ctx, req.(*ReconfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VM_ServiceDesc is the grpc.ServiceDesc for VM service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1328,6 +1368,10 @@ var VM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StateSummaryAccept",
 			Handler:    _VM_StateSummaryAccept_Handler,
 		},
+		{
+			MethodName: "Reconfigure",
+			Handler:    _VM_Reconfigure_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vm/vm.proto",
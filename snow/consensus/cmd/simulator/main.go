@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MetalBlockchain/metalgo/snow/consensus/simulation"
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+)
+
+var errUnknownLatency = errors.New("unknown latency distribution")
+
+type flags struct {
+	nodes             int
+	byzantineFraction float64
+	strategy          string
+	colors            int
+
+	latency       string
+	latencyMin    time.Duration
+	latencyMax    time.Duration
+	latencyMean   time.Duration
+	latencyStdDev time.Duration
+
+	k                 []int
+	alphaPreference   []int
+	alphaConfidence   []int
+	beta              []int
+	concurrentRepolls int
+
+	runs        int
+	maxDuration time.Duration
+	seed        uint64
+}
+
+// output is printed, as a JSON line, for every simulated set of parameters.
+type output struct {
+	K                int                                   `json:"k"`
+	AlphaPreference  int                                   `json:"alphaPreference"`
+	AlphaConfidence  int                                   `json:"alphaConfidence"`
+	Beta             int                                   `json:"beta"`
+	Runs             int                                   `json:"runs"`
+	SafetyViolations int                                   `json:"safetyViolations"`
+	Unfinalized      int                                   `json:"unfinalized"`
+	Latency          simulation.Distribution[jsonDuration] `json:"latency"`
+	Polls            simulation.Distribution[int]          `json:"polls"`
+}
+
+// jsonDuration is a [time.Duration] that is marshalled as a human readable
+// string.
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func init() {
+	cobra.EnablePrefixMatching = true
+}
+
+func main() {
+	f := &flags{}
+	rootCmd := &cobra.Command{
+		Use:   "simulator",
+		Short: "Simulates snowball consensus to report finality latency and safety violations",
+		Long: "Simulates snowball consensus on a network of honest and byzantine nodes. " +
+			"Every combination of the provided k, alpha-preference, alpha-confidence, and beta values is simulated.",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd, f)
+		},
+	}
+
+	defaults := snowball.DefaultParameters
+	fs := rootCmd.Flags()
+	fs.IntVar(&f.nodes, "nodes", 100, "Number of nodes in the network, including byzantine nodes")
+	fs.Float64Var(&f.byzantineFraction, "byzantine-fraction", 0, "Fraction of nodes that are byzantine")
+	fs.StringVar(&f.strategy, "byzantine-strategy", string(simulation.Equivocate), "Byzantine strategy: equivocate (attacks safety) or split (attacks liveness)")
+	fs.IntVar(&f.colors, "colors", 2, "Number of conflicting values honest nodes initially prefer")
+	fs.StringVar(&f.latency, "latency", "uniform", "Message latency distribution: constant, uniform, normal, or exponential")
+	fs.DurationVar(&f.latencyMin, "latency-min", 10*time.Millisecond, "Latency of constant, minimum latency of uniform and exponential")
+	fs.DurationVar(&f.latencyMax, "latency-max", 100*time.Millisecond, "Maximum latency of uniform")
+	fs.DurationVar(&f.latencyMean, "latency-mean", 50*time.Millisecond, "Mean latency of normal, mean latency above the minimum of exponential")
+	fs.DurationVar(&f.latencyStdDev, "latency-stddev", 20*time.Millisecond, "Standard deviation of normal")
+	fs.IntSliceVar(&f.k, "k", []int{defaults.K}, "Sample sizes")
+	fs.IntSliceVar(&f.alphaPreference, "alpha-preference", []int{defaults.AlphaPreference}, "Quorum sizes to change preference")
+	fs.IntSliceVar(&f.alphaConfidence, "alpha-confidence", []int{defaults.AlphaConfidence}, "Quorum sizes to increase confidence")
+	fs.IntSliceVar(&f.beta, "beta", []int{defaults.Beta}, "Consecutive successful polls required to finalize")
+	fs.IntVar(&f.concurrentRepolls, "concurrent-repolls", defaults.ConcurrentRepolls, "Polls each honest node keeps outstanding")
+	fs.IntVar(&f.runs, "runs", 100, "Runs per set of parameters")
+	fs.DurationVar(&f.maxDuration, "max-duration", time.Minute, "Simulated time after which nodes are reported as unfinalized")
+	fs.Uint64Var(&f.seed, "seed", 0, "Seed of the first run")
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "command failed %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(cmd *cobra.Command, f *flags) error {
+	latency, err := f.parseLatency()
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	for _, k := range f.k {
+		for _, alphaPreference := range f.alphaPreference {
+			for _, alphaConfidence := range f.alphaConfidence {
+				for _, beta := range f.beta {
+					params := snowball.DefaultParameters
+					params.K = k
+					params.AlphaPreference = alphaPreference
+					params.AlphaConfidence = alphaConfidence
+					params.Beta = beta
+					params.ConcurrentRepolls = min(f.concurrentRepolls, beta)
+
+					config := simulation.Config{
+						Params:            params,
+						NumNodes:          f.nodes,
+						ByzantineFraction: f.byzantineFraction,
+						Strategy:          simulation.Strategy(f.strategy),
+						NumColors:         f.colors,
+						Latency:           latency,
+						MaxDuration:       f.maxDuration,
+					}
+					report, err := simulation.Simulate(config, f.runs, f.seed)
+					if err != nil {
+						// Skip invalid combinations so that the remainder of
+						// the sweep still runs.
+						fmt.Fprintf(cmd.ErrOrStderr(), "skipping k=%d alpha-preference=%d alpha-confidence=%d beta=%d: %v\n",
+							k, alphaPreference, alphaConfidence, beta, err,
+						)
+						continue
+					}
+
+					err = encoder.Encode(output{
+						K:                k,
+						AlphaPreference:  alphaPreference,
+						AlphaConfidence:  alphaConfidence,
+						Beta:             beta,
+						Runs:             report.Runs,
+						SafetyViolations: report.SafetyViolations,
+						Unfinalized:      report.Unfinalized,
+						Latency: simulation.Distribution[jsonDuration]{
+							Mean: jsonDuration(report.Latency.Mean),
+							P50:  jsonDuration(report.Latency.P50),
+							P90:  jsonDuration(report.Latency.P90),
+							P99:  jsonDuration(report.Latency.P99),
+							Max:  jsonDuration(report.Latency.Max),
+						},
+						Polls: report.Polls,
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (f *flags) parseLatency() (simulation.Latency, error) {
+	switch f.latency {
+	case "constant":
+		return simulation.ConstantLatency(f.latencyMin), nil
+	case "uniform":
+		return &simulation.UniformLatency{
+			Min: f.latencyMin,
+			Max: f.latencyMax,
+		}, nil
+	case "normal":
+		return &simulation.NormalLatency{
+			Mean:   f.latencyMean,
+			StdDev: f.latencyStdDev,
+		}, nil
+	case "exponential":
+		return &simulation.ExponentialLatency{
+			Min:  f.latencyMin,
+			Mean: f.latencyMean,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownLatency, f.latency)
+	}
+}
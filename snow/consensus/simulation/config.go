@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+)
+
+var (
+	_ Latency = ConstantLatency(0)
+	_ Latency = (*UniformLatency)(nil)
+	_ Latency = (*NormalLatency)(nil)
+	_ Latency = (*ExponentialLatency)(nil)
+
+	ErrTooFewNodes            = errors.New("too few nodes")
+	ErrTooFewColors           = errors.New("too few colors")
+	ErrInvalidByzantine       = errors.New("byzantine fraction must be in [0, 1)")
+	ErrUnknownByzantineMode   = errors.New("unknown byzantine strategy")
+	ErrMissingLatency         = errors.New("missing latency")
+	ErrNonPositiveMaxDuration = errors.New("max duration must be positive")
+)
+
+// Strategy is how byzantine nodes respond to queries.
+type Strategy string
+
+const (
+	// Equivocate responds with the preference of the querying node, which
+	// reinforces every honest node's current preference. This attacks safety.
+	Equivocate Strategy = "equivocate"
+	// Split responds with a color other than the preference of the querying
+	// node, which keeps honest nodes from agreeing. This attacks liveness.
+	Split Strategy = "split"
+)
+
+// Latency is the distribution of the one-way message latency between two
+// nodes.
+type Latency interface {
+	Sample(rng *rand.Rand) time.Duration
+}
+
+// ConstantLatency delivers every message after the same delay.
+type ConstantLatency time.Duration
+
+func (l ConstantLatency) Sample(*rand.Rand) time.Duration {
+	return time.Duration(l)
+}
+
+// UniformLatency delivers messages after a delay uniformly distributed in
+// [Min, Max].
+type UniformLatency struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+func (l *UniformLatency) Sample(rng *rand.Rand) time.Duration {
+	if l.Max <= l.Min {
+		return l.Min
+	}
+	return l.Min + time.Duration(rng.Int64N(int64(l.Max-l.Min)+1))
+}
+
+// NormalLatency delivers messages after a normally distributed delay. Negative
+// samples are truncated to 0.
+type NormalLatency struct {
+	Mean   time.Duration
+	StdDev time.Duration
+}
+
+func (l *NormalLatency) Sample(rng *rand.Rand) time.Duration {
+	sample := float64(l.Mean) + rng.NormFloat64()*float64(l.StdDev)
+	return time.Duration(max(sample, 0))
+}
+
+// ExponentialLatency delivers messages after a delay of [Min] plus an
+// exponentially distributed delay with mean [Mean]. The long tail models
+// occasionally slow peers.
+type ExponentialLatency struct {
+	Min  time.Duration
+	Mean time.Duration
+}
+
+func (l *ExponentialLatency) Sample(rng *rand.Rand) time.Duration {
+	return l.Min + time.Duration(rng.ExpFloat64()*float64(l.Mean))
+}
+
+// Config describes a simulated network deciding between [NumColors] values.
+type Config struct {
+	// Params are the snowball parameters every honest node runs with.
+	// [snowball.Parameters.ConcurrentRepolls] is the number of polls each
+	// honest node keeps outstanding.
+	Params snowball.Parameters
+	// NumNodes is the number of nodes in the network, including byzantine
+	// nodes.
+	NumNodes int
+	// ByzantineFraction is the fraction of nodes that respond to queries with
+	// [Strategy] rather than their preference.
+	ByzantineFraction float64
+	Strategy          Strategy
+	// NumColors is the number of values the honest nodes initially prefer
+	// uniformly at random.
+	NumColors int
+	// Latency of every query and response.
+	Latency Latency
+	// MaxDuration is the simulated time after which nodes that haven't
+	// finalized are reported as unfinalized.
+	MaxDuration time.Duration
+}
+
+// NumByzantine returns the number of byzantine nodes in the network.
+func (c *Config) NumByzantine() int {
+	return int(c.ByzantineFraction * float64(c.NumNodes))
+}
+
+func (c *Config) Verify() error {
+	if err := c.Params.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case c.NumNodes < c.Params.K:
+		return fmt.Errorf("%w: %d nodes < k = %d", ErrTooFewNodes, c.NumNodes, c.Params.K)
+	case c.ByzantineFraction < 0 || c.ByzantineFraction >= 1:
+		return fmt.Errorf("%w: %f", ErrInvalidByzantine, c.ByzantineFraction)
+	case c.Strategy != Equivocate && c.Strategy != Split:
+		return fmt.Errorf("%w: %q", ErrUnknownByzantineMode, c.Strategy)
+	case c.NumColors < 1:
+		return fmt.Errorf("%w: %d", ErrTooFewColors, c.NumColors)
+	case c.Latency == nil:
+		return ErrMissingLatency
+	case c.MaxDuration <= 0:
+		return ErrNonPositiveMaxDuration
+	default:
+		return nil
+	}
+}
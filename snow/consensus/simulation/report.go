@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"slices"
+	"time"
+)
+
+// Report summarizes many runs of the same network.
+type Report struct {
+	Runs int `json:"runs"`
+	// SafetyViolations is the number of runs where two honest nodes finalized
+	// different colors.
+	SafetyViolations int `json:"safetyViolations"`
+	// Unfinalized is the number of honest nodes, across all runs, that didn't
+	// finalize within [Config.MaxDuration].
+	Unfinalized int `json:"unfinalized"`
+	// Latency is the distribution of the time it took honest nodes to
+	// finalize.
+	Latency Distribution[time.Duration] `json:"latency"`
+	// Polls is the distribution of the number of polls honest nodes recorded
+	// before finalizing.
+	Polls Distribution[int] `json:"polls"`
+}
+
+// Distribution of a sample. All fields are zero if the sample is empty.
+type Distribution[T ~int | ~int64] struct {
+	Mean T `json:"mean"`
+	P50  T `json:"p50"`
+	P90  T `json:"p90"`
+	P99  T `json:"p99"`
+	Max  T `json:"max"`
+}
+
+// Simulate runs the network described by [config] [runs] times. Run i is
+// seeded with [seed] + i.
+func Simulate(config Config, runs int, seed uint64) (Report, error) {
+	if err := config.Verify(); err != nil {
+		return Report{}, err
+	}
+
+	var (
+		report = Report{
+			Runs: runs,
+		}
+		latencies []time.Duration
+		polls     []int
+	)
+	for i := range runs {
+		result := Run(config, seed+uint64(i))
+		if result.SafetyViolation {
+			report.SafetyViolations++
+		}
+		report.Unfinalized += result.NumUnfinalized
+		latencies = append(latencies, result.FinalizationTimes...)
+		polls = append(polls, result.FinalizationPolls...)
+	}
+	report.Latency = newDistribution(latencies)
+	report.Polls = newDistribution(polls)
+	return report, nil
+}
+
+func newDistribution[T ~int | ~int64](sample []T) Distribution[T] {
+	if len(sample) == 0 {
+		return Distribution[T]{}
+	}
+
+	slices.Sort(sample)
+	var sum float64
+	for _, v := range sample {
+		sum += float64(v)
+	}
+	return Distribution[T]{
+		Mean: T(sum / float64(len(sample))),
+		P50:  percentile(sample, 50),
+		P90:  percentile(sample, 90),
+		P99:  percentile(sample, 99),
+		Max:  sample[len(sample)-1],
+	}
+}
+
+// percentile returns the nearest-rank [p]th percentile of [sorted].
+func percentile[T any](sorted []T, p int) T {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
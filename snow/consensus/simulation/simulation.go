@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+	"github.com/MetalBlockchain/metalgo/utils/bag"
+	"github.com/MetalBlockchain/metalgo/utils/heap"
+	"github.com/MetalBlockchain/metalgo/utils/sampler"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+)
+
+// Result of simulating a single network until every honest node finalized or
+// [Config.MaxDuration] elapsed.
+type Result struct {
+	// FinalizationTimes of the honest nodes that finalized, in simulated time
+	// since the start of the run.
+	FinalizationTimes []time.Duration
+	// FinalizationPolls are the number of polls each honest node that
+	// finalized recorded before finalizing.
+	FinalizationPolls []int
+	// NumUnfinalized is the number of honest nodes that didn't finalize
+	// within [Config.MaxDuration].
+	NumUnfinalized int
+	// SafetyViolation is true if two honest nodes finalized different colors.
+	SafetyViolation bool
+}
+
+type node struct {
+	// consensus is nil for byzantine nodes.
+	consensus   snowball.Consensus
+	numPolls    int
+	finalizedAt time.Duration
+}
+
+type poll struct {
+	node      int
+	votes     bag.Bag[ids.ID]
+	remaining int
+}
+
+type eventKind bool
+
+const (
+	// query is delivered to [event.peer].
+	query eventKind = false
+	// response is delivered to the node that issued [event.poll].
+	response eventKind = true
+)
+
+type event struct {
+	time time.Duration
+	// seq breaks ties between events at the same time so that runs are
+	// deterministic.
+	seq  uint64
+	kind eventKind
+	poll *poll
+	peer int
+	vote ids.ID
+}
+
+type simulator struct {
+	config  Config
+	rng     *rand.Rand
+	sampler sampler.Uniform
+	colors  []ids.ID
+	nodes   []*node
+	events  heap.Queue[*event]
+	seq     uint64
+}
+
+// Run simulates a network described by [config]. Runs with the same [config]
+// and [seed] produce the same result.
+//
+// Invariant: [config] has been verified.
+func Run(config Config, seed uint64) Result {
+	rng := rand.New(rand.NewPCG(seed, 0)) //#nosec G404
+	s := &simulator{
+		config:  config,
+		rng:     rng,
+		sampler: sampler.NewDeterministicUniform(rng),
+		colors:  make([]ids.ID, config.NumColors),
+		nodes:   make([]*node, config.NumNodes),
+		events: heap.NewQueue(func(a, b *event) bool {
+			if a.time != b.time {
+				return a.time < b.time
+			}
+			return a.seq < b.seq
+		}),
+	}
+	s.sampler.Initialize(uint64(config.NumNodes))
+	for i := range s.colors {
+		s.colors[i] = ids.Empty.Prefix(uint64(i))
+	}
+
+	numByzantine := config.NumByzantine()
+	for i := range s.nodes {
+		s.nodes[i] = &node{}
+		if i < numByzantine {
+			continue
+		}
+
+		initialColor := s.colors[rng.IntN(len(s.colors))]
+		consensus := snowball.NewTree(snowball.SnowballFactory, config.Params, initialColor)
+		for _, color := range s.colors {
+			if color != initialColor {
+				consensus.Add(color)
+			}
+		}
+		s.nodes[i].consensus = consensus
+	}
+
+	for i := numByzantine; i < len(s.nodes); i++ {
+		for range config.Params.ConcurrentRepolls {
+			s.startPoll(0, i)
+		}
+	}
+
+	numUnfinalized := len(s.nodes) - numByzantine
+	for numUnfinalized > 0 {
+		e, ok := s.events.Pop()
+		if !ok || e.time > config.MaxDuration {
+			break
+		}
+		if s.handle(e) {
+			numUnfinalized--
+		}
+	}
+	return s.result()
+}
+
+// startPoll queries [K] nodes, sampled uniformly from the whole network, for
+// their preference on behalf of [nodeIndex].
+func (s *simulator) startPoll(now time.Duration, nodeIndex int) {
+	peers, _ := s.sampler.Sample(s.config.Params.K)
+	p := &poll{
+		node:      nodeIndex,
+		remaining: len(peers),
+	}
+	for _, peer := range peers {
+		s.push(&event{
+			time: now + s.config.Latency.Sample(s.rng),
+			kind: query,
+			poll: p,
+			peer: int(peer),
+		})
+	}
+}
+
+func (s *simulator) push(e *event) {
+	e.seq = s.seq
+	s.seq++
+	s.events.Push(e)
+}
+
+// handle processes [e] and returns true if it caused a node to finalize.
+func (s *simulator) handle(e *event) bool {
+	if e.kind == query {
+		s.push(&event{
+			time: e.time + s.config.Latency.Sample(s.rng),
+			kind: response,
+			poll: e.poll,
+			vote: s.respond(e.peer, e.poll.node),
+		})
+		return false
+	}
+
+	p := e.poll
+	p.votes.Add(e.vote)
+	p.remaining--
+	if p.remaining > 0 {
+		return false
+	}
+
+	n := s.nodes[p.node]
+	if n.consensus.Finalized() {
+		// Finalized nodes keep answering queries but stop polling.
+		return false
+	}
+
+	n.consensus.RecordPoll(p.votes)
+	n.numPolls++
+	if n.consensus.Finalized() {
+		n.finalizedAt = e.time
+		return true
+	}
+	s.startPoll(e.time, p.node)
+	return false
+}
+
+// respond returns the vote of [peer] when queried by [querier].
+func (s *simulator) respond(peer int, querier int) ids.ID {
+	if consensus := s.nodes[peer].consensus; consensus != nil {
+		return consensus.Preference()
+	}
+
+	querierPreference := s.nodes[querier].consensus.Preference()
+	if s.config.Strategy == Equivocate {
+		return querierPreference
+	}
+	for i, color := range s.colors {
+		if color == querierPreference {
+			return s.colors[(i+1)%len(s.colors)]
+		}
+	}
+	return querierPreference
+}
+
+func (s *simulator) result() Result {
+	var (
+		result    Result
+		finalized set.Set[ids.ID]
+	)
+	for _, n := range s.nodes {
+		if n.consensus == nil {
+			continue
+		}
+		if !n.consensus.Finalized() {
+			result.NumUnfinalized++
+			continue
+		}
+		result.FinalizationTimes = append(result.FinalizationTimes, n.finalizedAt)
+		result.FinalizationPolls = append(result.FinalizationPolls, n.numPolls)
+		finalized.Add(n.consensus.Preference())
+	}
+	result.SafetyViolation = finalized.Len() > 1
+	return result
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+)
+
+var testConfig = Config{
+	Params: snowball.Parameters{
+		K:                     20,
+		AlphaPreference:       15,
+		AlphaConfidence:       15,
+		Beta:                  20,
+		ConcurrentRepolls:     4,
+		OptimalProcessing:     10,
+		MaxOutstandingItems:   256,
+		MaxItemProcessingTime: 30 * time.Second,
+	},
+	NumNodes:  100,
+	Strategy:  Equivocate,
+	NumColors: 2,
+	Latency: &UniformLatency{
+		Min: 10 * time.Millisecond,
+		Max: 100 * time.Millisecond,
+	},
+	MaxDuration: time.Minute,
+}
+
+func TestConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*Config) {},
+		},
+		{
+			name: "invalid params",
+			modify: func(c *Config) {
+				c.Params.K = 0
+			},
+			expectedErr: snowball.ErrParametersInvalid,
+		},
+		{
+			name: "fewer nodes than k",
+			modify: func(c *Config) {
+				c.NumNodes = c.Params.K - 1
+			},
+			expectedErr: ErrTooFewNodes,
+		},
+		{
+			name: "byzantine fraction of 1",
+			modify: func(c *Config) {
+				c.ByzantineFraction = 1
+			},
+			expectedErr: ErrInvalidByzantine,
+		},
+		{
+			name: "unknown strategy",
+			modify: func(c *Config) {
+				c.Strategy = "unknown"
+			},
+			expectedErr: ErrUnknownByzantineMode,
+		},
+		{
+			name: "no colors",
+			modify: func(c *Config) {
+				c.NumColors = 0
+			},
+			expectedErr: ErrTooFewColors,
+		},
+		{
+			name: "no latency",
+			modify: func(c *Config) {
+				c.Latency = nil
+			},
+			expectedErr: ErrMissingLatency,
+		},
+		{
+			name: "no max duration",
+			modify: func(c *Config) {
+				c.MaxDuration = 0
+			},
+			expectedErr: ErrNonPositiveMaxDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := testConfig
+			test.modify(&config)
+			require.ErrorIs(t, config.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestSimulateHonestNetwork(t *testing.T) {
+	require := require.New(t)
+
+	report, err := Simulate(testConfig, 5, 0)
+	require.NoError(err)
+	require.Equal(5, report.Runs)
+	require.Zero(report.SafetyViolations)
+	require.Zero(report.Unfinalized)
+	require.GreaterOrEqual(report.Polls.P50, testConfig.Params.Beta)
+	require.LessOrEqual(report.Latency.P50, report.Latency.P90)
+	require.LessOrEqual(report.Latency.P99, report.Latency.Max)
+	require.Positive(report.Latency.Mean)
+}
+
+func TestSimulateDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	config := testConfig
+	config.ByzantineFraction = .2
+	config.Latency = &ExponentialLatency{
+		Min:  10 * time.Millisecond,
+		Mean: 50 * time.Millisecond,
+	}
+	config.MaxDuration = 10 * time.Second
+	require.Equal(Run(config, 1), Run(config, 1))
+}
+
+func TestSimulateByzantine(t *testing.T) {
+	tests := []struct {
+		name              string
+		byzantineFraction float64
+		strategy          Strategy
+		check             func(*require.Assertions, Report)
+	}{
+		{
+			// Byzantine nodes that reinforce every preference let honest nodes
+			// finalize their initial, conflicting, preferences.
+			name:              "equivocate",
+			byzantineFraction: .7,
+			strategy:          Equivocate,
+			check: func(require *require.Assertions, report Report) {
+				require.Positive(report.SafetyViolations)
+			},
+		},
+		{
+			// Byzantine nodes that always vote against the querier's
+			// preference prevent alpha confidence from being reached.
+			name:              "split",
+			byzantineFraction: .5,
+			strategy:          Split,
+			check: func(require *require.Assertions, report Report) {
+				require.Zero(report.SafetyViolations)
+				require.Positive(report.Unfinalized)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := testConfig
+			config.ByzantineFraction = test.byzantineFraction
+			config.Strategy = test.strategy
+			config.MaxDuration = 10 * time.Second
+
+			report, err := Simulate(config, 3, 0)
+			require.NoError(err)
+			test.check(require, report)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, 1, percentile(sorted, 0))
+	require.Equal(t, 5, percentile(sorted, 50))
+	require.Equal(t, 9, percentile(sorted, 90))
+	require.Equal(t, 10, percentile(sorted, 99))
+}
@@ -17,6 +17,13 @@ const (
 	// 1 means MinPercentConnected = 1 (fully connected).
 	MinPercentConnectedBuffer = .2
 
+	// MinAdaptiveWeightFactor is the smallest allowed
+	// [AdaptiveSamplingParameters.MinWeightFactor]. It bounds how far the
+	// sampling weight of an unresponsive validator can be reduced, so that an
+	// adversary that degrades the responsiveness of honest validators can at
+	// most double its share of every sample.
+	MinAdaptiveWeightFactor = .5
+
 	errMsg = `__________                    .___
 \______   \____________     __| _/__.__.
  |    |  _/\_  __ \__  \   / __ <   |  |
@@ -46,6 +53,11 @@ var (
 		MaxItemProcessingTime: 30 * time.Second,
 	}
 
+	DefaultAdaptiveSamplingParameters = AdaptiveSamplingParameters{
+		MinWeightFactor: .75,
+		HalfLife:        5 * time.Minute,
+	}
+
 	ErrParametersInvalid = errors.New("parameters invalid")
 )
 
@@ -76,6 +88,42 @@ type Parameters struct {
 	// Reports unhealthy if there is an item processing for longer than this
 	// duration.
 	MaxItemProcessingTime time.Duration `json:"maxItemProcessingTime" yaml:"maxItemProcessingTime"`
+
+	// AdaptiveSampling, if provided, biases the validators sampled for each
+	// poll toward validators that respond quickly and reliably.
+	AdaptiveSampling *AdaptiveSamplingParameters `json:"adaptiveSampling,omitempty" yaml:"adaptiveSampling,omitempty"`
+}
+
+// AdaptiveSamplingParameters configure how validators are sampled based on
+// their observed responsiveness.
+//
+// Every validator is sampled with a weight of its stake multiplied by a factor
+// in [MinWeightFactor, 1]. The factor is the fraction of queries the validator
+// responded to, scaled down further if its average response latency is above
+// the median of the validators.
+type AdaptiveSamplingParameters struct {
+	// MinWeightFactor is the smallest fraction of a validator's stake that is
+	// used as its sampling weight.
+	MinWeightFactor float64 `json:"minWeightFactor" yaml:"minWeightFactor"`
+	// HalfLife of the response latency and reliability averages.
+	HalfLife time.Duration `json:"halfLife" yaml:"halfLife"`
+}
+
+// Verify returns nil if the parameters describe a valid initialization.
+//
+// An initialization is valid if the following conditions are met:
+//
+// - MinAdaptiveWeightFactor <= MinWeightFactor <= 1
+// - 0 < HalfLife
+func (p AdaptiveSamplingParameters) Verify() error {
+	switch {
+	case p.MinWeightFactor < MinAdaptiveWeightFactor || p.MinWeightFactor > 1:
+		return fmt.Errorf("%w: minWeightFactor = %f: fails the condition that: %f <= minWeightFactor <= 1", ErrParametersInvalid, p.MinWeightFactor, MinAdaptiveWeightFactor)
+	case p.HalfLife <= 0:
+		return fmt.Errorf("%w: halfLife = %d: fails the condition that: 0 < halfLife", ErrParametersInvalid, p.HalfLife)
+	default:
+		return nil
+	}
 }
 
 // Verify returns nil if the parameters describe a valid initialization.
@@ -87,6 +135,7 @@ type Parameters struct {
 // - 0 < OptimalProcessing
 // - 0 < MaxOutstandingItems
 // - 0 < MaxItemProcessingTime
+// - AdaptiveSampling, if provided, is valid
 //
 // Note: K/2 < K implies that 0 <= K/2, so we don't need an explicit check that
 // AlphaPreference is positive.
@@ -110,6 +159,8 @@ func (p Parameters) Verify() error {
 		return fmt.Errorf("%w: maxOutstandingItems = %d: fails the condition that: 0 < maxOutstandingItems", ErrParametersInvalid, p.MaxOutstandingItems)
 	case p.MaxItemProcessingTime <= 0:
 		return fmt.Errorf("%w: maxItemProcessingTime = %d: fails the condition that: 0 < maxItemProcessingTime", ErrParametersInvalid, p.MaxItemProcessingTime)
+	case p.AdaptiveSampling != nil:
+		return p.AdaptiveSampling.Verify()
 	default:
 		return nil
 	}
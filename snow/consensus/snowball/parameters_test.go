@@ -211,6 +211,78 @@ func TestParametersVerify(t *testing.T) {
 			},
 			expectedError: ErrParametersInvalid,
 		},
+		{
+			name: "valid AdaptiveSampling",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  1,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				AdaptiveSampling: &AdaptiveSamplingParameters{
+					MinWeightFactor: MinAdaptiveWeightFactor,
+					HalfLife:        1,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "invalid AdaptiveSampling MinWeightFactor too small",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  1,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				AdaptiveSampling: &AdaptiveSamplingParameters{
+					MinWeightFactor: MinAdaptiveWeightFactor / 2,
+					HalfLife:        1,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid AdaptiveSampling MinWeightFactor too large",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  1,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				AdaptiveSampling: &AdaptiveSamplingParameters{
+					MinWeightFactor: 1.5,
+					HalfLife:        1,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid AdaptiveSampling HalfLife",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  1,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				AdaptiveSampling: &AdaptiveSamplingParameters{
+					MinWeightFactor: 1,
+					HalfLife:        0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
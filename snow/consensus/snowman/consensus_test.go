@@ -19,6 +19,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
 	"github.com/MetalBlockchain/metalgo/snow/consensus/snowman/snowmantest"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/snow/snowtest"
 	"github.com/MetalBlockchain/metalgo/utils/bag"
 )
@@ -46,6 +47,7 @@ var (
 		RecordPollTransitiveVotingTest,
 		RecordPollDivergedVotingWithNoConflictingBitTest,
 		RecordPollChangePreferredChainTest,
+		RecordPollRecordsEventsTest,
 		LastAcceptedTest,
 		MetricsProcessingErrorTest,
 		MetricsAcceptedErrorTest,
@@ -550,6 +552,83 @@ func RecordPollSplitVoteNoChangeTest(t *testing.T, factory Factory) {
 	require.InDelta(float64(1), metrics["polls_successful"], 0)
 }
 
+func RecordPollRecordsEventsTest(t *testing.T, factory Factory) {
+	require := require.New(t)
+
+	sm := factory.New()
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	events, err := recorder.New(10)
+	require.NoError(err)
+	ctx.Recorder = events
+
+	params := snowball.Parameters{
+		K:                     1,
+		AlphaPreference:       1,
+		AlphaConfidence:       1,
+		Beta:                  1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	require.NoError(sm.Initialize(
+		ctx,
+		params,
+		snowmantest.GenesisID,
+		snowmantest.GenesisHeight,
+		snowmantest.GenesisTimestamp,
+	))
+
+	firstBlock := snowmantest.BuildChild(snowmantest.Genesis)
+	secondBlock := snowmantest.BuildChild(snowmantest.Genesis)
+	require.NoError(sm.Add(firstBlock))
+	require.NoError(sm.Add(secondBlock))
+
+	votes := bag.Of(secondBlock.ID())
+	require.NoError(sm.RecordPoll(context.Background(), votes))
+	require.Equal(secondBlock.ID(), sm.Preference())
+
+	type event struct {
+		Type    recorder.Type
+		BlockID ids.ID
+		Height  uint64
+	}
+	expectedEvents := []event{
+		{
+			Type:    recorder.PreferenceChanged,
+			BlockID: firstBlock.ID(),
+			Height:  firstBlock.Height(),
+		},
+		{
+			Type:    recorder.BlockAccepted,
+			BlockID: secondBlock.ID(),
+			Height:  secondBlock.Height(),
+		},
+		{
+			Type:    recorder.BlockRejected,
+			BlockID: firstBlock.ID(),
+			Height:  firstBlock.Height(),
+		},
+		{
+			Type:    recorder.PreferenceChanged,
+			BlockID: secondBlock.ID(),
+			Height:  secondBlock.Height(),
+		},
+	}
+	recordedEvents := events.Events()
+	require.Len(recordedEvents, len(expectedEvents))
+	for i, expected := range expectedEvents {
+		recorded := recordedEvents[i]
+		require.Equal(expected, event{
+			Type:    recorded.Type,
+			BlockID: recorded.BlockID,
+			Height:  recorded.Height,
+		})
+	}
+}
+
 func RecordPollWhenFinalizedTest(t *testing.T, factory Factory) {
 	require := require.New(t)
 
@@ -0,0 +1,285 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package poll
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/utils/sampler"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+
+	safemath "github.com/MetalBlockchain/metalgo/utils/math"
+)
+
+var (
+	errAdaptiveSamplingMetrics = errors.New("failed to register adaptive sampling metrics")
+	errInsufficientWeight      = errors.New("insufficient weight to sample")
+)
+
+// weightsRefreshPeriod is how often the sampling weights are recalculated
+// while the validator set doesn't change. The responsiveness of the
+// validators changes gradually, so the weights don't need to be recalculated
+// for every sample.
+const weightsRefreshPeriod = time.Second
+
+type outstandingQuery struct {
+	nodeID    ids.NodeID
+	requestID uint32
+}
+
+// responsiveness of a validator to queries.
+type responsiveness struct {
+	// latency is only initialized once the validator has responded.
+	latency     safemath.Averager
+	reliability safemath.Averager
+}
+
+// AdaptiveSampler samples validators by stake, biased toward validators that
+// respond to queries quickly and reliably.
+//
+// AdaptiveSampler is not thread safe.
+type AdaptiveSampler struct {
+	params  snowball.AdaptiveSamplingParameters
+	clock   mockable.Clock
+	sampler sampler.WeightedWithoutReplacement
+
+	// nodeIDs and weights are the validator set the sampler was last
+	// initialized with.
+	nodeIDs []ids.NodeID
+	weights []uint64
+	// initialized is false if the sampler must be initialized before the next
+	// sample.
+	initialized bool
+	lastRefresh time.Time
+
+	validators  map[ids.NodeID]*responsiveness
+	outstanding map[outstandingQuery]time.Time
+
+	weightFactor  prometheus.Gauge
+	medianLatency prometheus.Gauge
+}
+
+func NewAdaptiveSampler(
+	params snowball.AdaptiveSamplingParameters,
+	reg prometheus.Registerer,
+) (*AdaptiveSampler, error) {
+	s := &AdaptiveSampler{
+		params:      params,
+		sampler:     sampler.NewWeightedWithoutReplacement(),
+		validators:  make(map[ids.NodeID]*responsiveness),
+		outstanding: make(map[outstandingQuery]time.Time),
+		weightFactor: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_sampling_weight_factor",
+			Help: "fraction of the sampled stake weight that was kept after adjusting for responsiveness",
+		}),
+		medianLatency: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_sampling_median_latency",
+			Help: "median of the average query response latencies (in ns) of the validators",
+		}),
+	}
+	err := errors.Join(
+		reg.Register(s.weightFactor),
+		reg.Register(s.medianLatency),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errAdaptiveSamplingMetrics, err)
+	}
+	return s, nil
+}
+
+// Sample returns [size] validators from [vdrs], potentially with duplicates.
+//
+// The sampler is only reinitialized when [vdrs] differs from the previously
+// sampled validator set or when the weights are due to be refreshed.
+func (s *AdaptiveSampler) Sample(
+	vdrs map[ids.NodeID]*validators.GetValidatorOutput,
+	size int,
+) ([]ids.NodeID, error) {
+	if !s.isValidatorSet(vdrs) {
+		s.setValidators(vdrs)
+	}
+
+	now := s.clock.Time()
+	if !s.initialized || now.Sub(s.lastRefresh) >= weightsRefreshPeriod {
+		if err := s.initialize(); err != nil {
+			return nil, err
+		}
+		s.initialized = true
+		s.lastRefresh = now
+	}
+
+	indices, ok := s.sampler.Sample(size)
+	if !ok {
+		return nil, errInsufficientWeight
+	}
+
+	sampled := make([]ids.NodeID, size)
+	for i, index := range indices {
+		sampled[i] = s.nodeIDs[index]
+	}
+	return sampled, nil
+}
+
+// isValidatorSet returns true if [vdrs] is the validator set the sampler was
+// last initialized with.
+func (s *AdaptiveSampler) isValidatorSet(vdrs map[ids.NodeID]*validators.GetValidatorOutput) bool {
+	if len(vdrs) != len(s.nodeIDs) {
+		return false
+	}
+	for i, nodeID := range s.nodeIDs {
+		vdr, ok := vdrs[nodeID]
+		if !ok || vdr.Weight != s.weights[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// setValidators replaces the validator set to sample from with [vdrs] and
+// forgets the responsiveness of the validators that are no longer in it.
+func (s *AdaptiveSampler) setValidators(vdrs map[ids.NodeID]*validators.GetValidatorOutput) {
+	s.nodeIDs = s.nodeIDs[:0]
+	s.weights = s.weights[:0]
+	for nodeID, vdr := range vdrs {
+		s.nodeIDs = append(s.nodeIDs, nodeID)
+		s.weights = append(s.weights, vdr.Weight)
+	}
+	s.initialized = false
+
+	for nodeID := range s.validators {
+		if _, ok := vdrs[nodeID]; !ok {
+			delete(s.validators, nodeID)
+		}
+	}
+	for query := range s.outstanding {
+		if _, ok := vdrs[query.nodeID]; !ok {
+			delete(s.outstanding, query)
+		}
+	}
+}
+
+// initialize the sampler with the weights of the validators adjusted for their
+// current responsiveness.
+func (s *AdaptiveSampler) initialize() error {
+	latencies := make([]float64, 0, len(s.nodeIDs))
+	for _, nodeID := range s.nodeIDs {
+		if r, ok := s.validators[nodeID]; ok && r.latency != nil {
+			latencies = append(latencies, r.latency.Read())
+		}
+	}
+
+	var medianLatency float64
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		medianLatency = latencies[len(latencies)/2]
+	}
+	s.medianLatency.Set(medianLatency)
+
+	var (
+		weights                          = make([]uint64, len(s.weights))
+		totalWeight, totalAdjustedWeight float64
+	)
+	for i, nodeID := range s.nodeIDs {
+		if s.weights[i] == 0 {
+			continue
+		}
+
+		factor := s.factor(nodeID, medianLatency)
+		totalWeight += float64(s.weights[i])
+		adjustedWeight := max(uint64(float64(s.weights[i])*factor), 1)
+		totalAdjustedWeight += float64(adjustedWeight)
+		weights[i] = adjustedWeight
+	}
+	if totalWeight > 0 {
+		s.weightFactor.Set(totalAdjustedWeight / totalWeight)
+	}
+	return s.sampler.Initialize(weights)
+}
+
+// factor returns the fraction, in [MinWeightFactor, 1], of the stake of
+// [nodeID] to sample it with.
+func (s *AdaptiveSampler) factor(nodeID ids.NodeID, medianLatency float64) float64 {
+	r, ok := s.validators[nodeID]
+	if !ok {
+		return 1
+	}
+
+	factor := r.reliability.Read()
+	if r.latency != nil {
+		if latency := r.latency.Read(); latency > medianLatency {
+			factor *= medianLatency / latency
+		}
+	}
+	return min(max(factor, s.params.MinWeightFactor), 1)
+}
+
+// Sent registers that the query [requestID] was sent to [nodeIDs].
+func (s *AdaptiveSampler) Sent(requestID uint32, nodeIDs []ids.NodeID) {
+	now := s.clock.Time()
+	for _, nodeID := range nodeIDs {
+		s.outstanding[outstandingQuery{
+			nodeID:    nodeID,
+			requestID: requestID,
+		}] = now
+	}
+}
+
+// Responded registers that [nodeID] responded to the query [requestID].
+func (s *AdaptiveSampler) Responded(nodeID ids.NodeID, requestID uint32) {
+	query := outstandingQuery{
+		nodeID:    nodeID,
+		requestID: requestID,
+	}
+	sent, ok := s.outstanding[query]
+	if !ok {
+		return
+	}
+	delete(s.outstanding, query)
+
+	var (
+		now     = s.clock.Time()
+		latency = float64(now.Sub(sent))
+		r       = s.getResponsiveness(nodeID, now)
+	)
+	if r.latency == nil {
+		r.latency = safemath.NewAverager(latency, s.params.HalfLife, now)
+	} else {
+		r.latency.Observe(latency, now)
+	}
+	r.reliability.Observe(1, now)
+}
+
+// Failed registers that [nodeID] failed to respond to the query [requestID].
+func (s *AdaptiveSampler) Failed(nodeID ids.NodeID, requestID uint32) {
+	query := outstandingQuery{
+		nodeID:    nodeID,
+		requestID: requestID,
+	}
+	if _, ok := s.outstanding[query]; !ok {
+		return
+	}
+	delete(s.outstanding, query)
+
+	now := s.clock.Time()
+	s.getResponsiveness(nodeID, now).reliability.Observe(0, now)
+}
+
+func (s *AdaptiveSampler) getResponsiveness(nodeID ids.NodeID, now time.Time) *responsiveness {
+	r, ok := s.validators[nodeID]
+	if !ok {
+		r = &responsiveness{
+			reliability: safemath.NewAverager(1, s.params.HalfLife, now),
+		}
+		s.validators[nodeID] = r
+	}
+	return r
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+)
+
+func newTestAdaptiveSampler(require *require.Assertions) *AdaptiveSampler {
+	s, err := NewAdaptiveSampler(
+		snowball.AdaptiveSamplingParameters{
+			MinWeightFactor: snowball.MinAdaptiveWeightFactor,
+			HalfLife:        time.Minute,
+		},
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	return s
+}
+
+func TestAdaptiveSamplerFactor(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestAdaptiveSampler(require)
+	start := time.Unix(0, 0)
+	s.clock.Set(start)
+	s.Sent(1, []ids.NodeID{vdr1, vdr2, vdr3, vdr4})
+
+	s.clock.Set(start.Add(100 * time.Millisecond))
+	s.Responded(vdr1, 1)
+	s.Responded(vdr2, 1)
+
+	s.clock.Set(start.Add(150 * time.Millisecond))
+	s.Responded(vdr3, 1)
+	s.Failed(vdr4, 1)
+
+	vdrs := map[ids.NodeID]*validators.GetValidatorOutput{
+		vdr1: {NodeID: vdr1, Weight: 1},
+		vdr2: {NodeID: vdr2, Weight: 1},
+		vdr3: {NodeID: vdr3, Weight: 1},
+		vdr4: {NodeID: vdr4, Weight: 1},
+		vdr5: {NodeID: vdr5, Weight: 1},
+	}
+	_, err := s.Sample(vdrs, 1)
+	require.NoError(err)
+	medianLatency := float64(100 * time.Millisecond)
+	require.Equal(medianLatency, testutil.ToFloat64(s.medianLatency))
+
+	tests := []struct {
+		name           string
+		nodeID         ids.NodeID
+		expectedFactor float64
+	}{
+		{
+			name:           "median latency",
+			nodeID:         vdr1,
+			expectedFactor: 1,
+		},
+		{
+			name:           "above median latency",
+			nodeID:         vdr3,
+			expectedFactor: 100. / 150.,
+		},
+		{
+			// The failure halves the reliability, which is bounded by the
+			// minimum weight factor.
+			name:           "failed",
+			nodeID:         vdr4,
+			expectedFactor: snowball.MinAdaptiveWeightFactor,
+		},
+		{
+			name:           "never queried",
+			nodeID:         vdr5,
+			expectedFactor: 1,
+		},
+	}
+	for _, test := range tests {
+		require.InDelta(test.expectedFactor, s.factor(test.nodeID, medianLatency), 1e-9, test.name)
+	}
+}
+
+func TestAdaptiveSamplerIgnoresUnexpectedResponses(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestAdaptiveSampler(require)
+	s.Sent(1, []ids.NodeID{vdr1})
+
+	// Responses to unknown requests and duplicate responses are ignored.
+	s.Responded(vdr1, 2)
+	s.Failed(vdr2, 1)
+	require.Empty(s.validators)
+
+	s.Responded(vdr1, 1)
+	s.Failed(vdr1, 1)
+	require.Empty(s.outstanding)
+	require.Len(s.validators, 1)
+	require.Equal(1., s.validators[vdr1].reliability.Read())
+}
+
+func TestAdaptiveSamplerSample(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestAdaptiveSampler(require)
+	s.Sent(1, []ids.NodeID{vdr1})
+	s.Failed(vdr1, 1)
+
+	vdrs := map[ids.NodeID]*validators.GetValidatorOutput{
+		vdr1: {NodeID: vdr1, Weight: 10},
+		vdr2: {NodeID: vdr2, Weight: 10},
+		vdr3: {NodeID: vdr3, Weight: 0},
+	}
+	sampled, err := s.Sample(vdrs, 15)
+	require.NoError(err)
+	require.Len(sampled, 15)
+	require.NotContains(sampled, vdr3)
+
+	// The weight of vdr1 is halved.
+	require.Equal(15./20., testutil.ToFloat64(s.weightFactor))
+
+	_, err = s.Sample(vdrs, 16)
+	require.ErrorIs(err, errInsufficientWeight)
+}
+
+func TestAdaptiveSamplerRefreshesWeights(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestAdaptiveSampler(require)
+	start := time.Unix(0, 0)
+	s.clock.Set(start)
+
+	vdrs := map[ids.NodeID]*validators.GetValidatorOutput{
+		vdr1: {NodeID: vdr1, Weight: 10},
+		vdr2: {NodeID: vdr2, Weight: 10},
+	}
+	_, err := s.Sample(vdrs, 1)
+	require.NoError(err)
+	require.Equal(1., testutil.ToFloat64(s.weightFactor))
+
+	s.Sent(1, []ids.NodeID{vdr1})
+	s.Failed(vdr1, 1)
+
+	// The weights aren't recalculated while the validator set is unchanged.
+	_, err = s.Sample(vdrs, 1)
+	require.NoError(err)
+	require.Equal(1., testutil.ToFloat64(s.weightFactor))
+
+	// The weights are recalculated once they are due to be refreshed.
+	s.clock.Set(start.Add(weightsRefreshPeriod))
+	_, err = s.Sample(vdrs, 1)
+	require.NoError(err)
+	require.Equal(15./20., testutil.ToFloat64(s.weightFactor))
+
+	s.Sent(2, []ids.NodeID{vdr2})
+	s.Failed(vdr2, 2)
+
+	// The weights are recalculated when the validator set changes.
+	vdrs[vdr2] = &validators.GetValidatorOutput{NodeID: vdr2, Weight: 20}
+	_, err = s.Sample(vdrs, 1)
+	require.NoError(err)
+	require.Equal(15./30., testutil.ToFloat64(s.weightFactor))
+}
+
+func TestAdaptiveSamplerPrunesRemovedValidators(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestAdaptiveSampler(require)
+	s.Sent(1, []ids.NodeID{vdr1, vdr2})
+	s.Failed(vdr1, 1)
+	s.Sent(2, []ids.NodeID{vdr1, vdr2})
+
+	vdrs := map[ids.NodeID]*validators.GetValidatorOutput{
+		vdr2: {NodeID: vdr2, Weight: 10},
+	}
+	sampled, err := s.Sample(vdrs, 1)
+	require.NoError(err)
+	require.Equal([]ids.NodeID{vdr2}, sampled)
+	require.NotContains(s.validators, vdr1)
+	require.Len(s.outstanding, 2)
+
+	// Responses from removed validators are ignored.
+	s.Responded(vdr1, 2)
+	require.NotContains(s.validators, vdr1)
+}
@@ -17,6 +17,7 @@ import (
 var (
 	errPollDurationVectorMetrics = errors.New("failed to register poll_duration vector metrics")
 	errPollCountVectorMetrics    = errors.New("failed to register poll_count vector metrics")
+	errSkippedResponsesMetric    = errors.New("failed to register poll_skipped_responses metric")
 
 	terminationReason = "reason"
 	exhaustedReason   = "exhausted"
@@ -42,6 +43,10 @@ type earlyTermMetrics struct {
 	countExhaustedPolls  prometheus.Counter
 	countEarlyFailPolls  prometheus.Counter
 	countEarlyAlphaPolls prometheus.Counter
+
+	// countSkippedResponses is the number of responses that polls didn't wait
+	// for because they terminated early.
+	countSkippedResponses prometheus.Counter
 }
 
 func newEarlyTermMetrics(reg prometheus.Registerer) (*earlyTermMetrics, error) {
@@ -59,14 +64,22 @@ func newEarlyTermMetrics(reg prometheus.Registerer) (*earlyTermMetrics, error) {
 	if err := reg.Register(durPollsVec); err != nil {
 		return nil, fmt.Errorf("%w: %w", errPollDurationVectorMetrics, err)
 	}
+	countSkippedResponses := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "poll_skipped_responses",
+		Help: "Total # of responses that polls didn't wait for because they terminated early",
+	})
+	if err := reg.Register(countSkippedResponses); err != nil {
+		return nil, fmt.Errorf("%w: %w", errSkippedResponsesMetric, err)
+	}
 
 	return &earlyTermMetrics{
-		durExhaustedPolls:    durPollsVec.With(exhaustedLabel),
-		durEarlyFailPolls:    durPollsVec.With(earlyFailLabel),
-		durEarlyAlphaPolls:   durPollsVec.With(earlyAlphaLabel),
-		countExhaustedPolls:  pollCountVec.With(exhaustedLabel),
-		countEarlyFailPolls:  pollCountVec.With(earlyFailLabel),
-		countEarlyAlphaPolls: pollCountVec.With(earlyAlphaLabel),
+		durExhaustedPolls:     durPollsVec.With(exhaustedLabel),
+		durEarlyFailPolls:     durPollsVec.With(earlyFailLabel),
+		durEarlyAlphaPolls:    durPollsVec.With(earlyAlphaLabel),
+		countExhaustedPolls:   pollCountVec.With(exhaustedLabel),
+		countEarlyFailPolls:   pollCountVec.With(earlyFailLabel),
+		countEarlyAlphaPolls:  pollCountVec.With(earlyAlphaLabel),
+		countSkippedResponses: countSkippedResponses,
 	}, nil
 }
 
@@ -75,14 +88,16 @@ func (m *earlyTermMetrics) observeExhausted(duration time.Duration) {
 	m.countExhaustedPolls.Inc()
 }
 
-func (m *earlyTermMetrics) observeEarlyFail(duration time.Duration) {
+func (m *earlyTermMetrics) observeEarlyFail(duration time.Duration, remaining int) {
 	m.durEarlyFailPolls.Add(float64(duration.Nanoseconds()))
 	m.countEarlyFailPolls.Inc()
+	m.countSkippedResponses.Add(float64(remaining))
 }
 
-func (m *earlyTermMetrics) observeEarlyAlpha(duration time.Duration) {
+func (m *earlyTermMetrics) observeEarlyAlpha(duration time.Duration, remaining int) {
 	m.durEarlyAlphaPolls.Add(float64(duration.Nanoseconds()))
 	m.countEarlyAlphaPolls.Inc()
+	m.countSkippedResponses.Add(float64(remaining))
 }
 
 type earlyTermTraversalFactory struct {
@@ -180,7 +195,7 @@ func (p *earlyTermPoll) Finished() bool {
 	maxPossibleVotes := received + remaining
 	if maxPossibleVotes < p.alphaPreference {
 		p.finished = true
-		p.metrics.observeEarlyFail(time.Since(p.start))
+		p.metrics.observeEarlyFail(time.Since(p.start), remaining)
 		return true // Case 2
 	}
 
@@ -231,7 +246,7 @@ func (p *earlyTermPoll) Finished() bool {
 	// We should terminate the poll only when votes for all IDs or prefixes cannot be improved.
 	if weCantImproveVoteForSomeIDOrPrefix {
 		p.finished = true
-		p.metrics.observeEarlyAlpha(time.Since(p.start))
+		p.metrics.observeEarlyAlpha(time.Since(p.start), remaining)
 	}
 
 	return p.finished
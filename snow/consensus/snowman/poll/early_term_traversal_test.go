@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/MetalBlockchain/metalgo/ids"
@@ -428,3 +429,23 @@ func TestEarlyTermTraversalTransitiveTree(t *testing.T) {
 	poll.Vote(vdr4, blkID6)
 	require.True(poll.Finished())
 }
+
+func TestEarlyTermSkippedResponsesMetric(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := bag.Of(vdr1, vdr2, vdr3, vdr4, vdr5) // k = 5
+	alpha := 3
+
+	factory := newEarlyTermNoTraversalTestFactory(require, alpha)
+	poll := factory.New(vdrs)
+
+	poll.Vote(vdr1, blkID1)
+	poll.Vote(vdr2, blkID1)
+	require.False(poll.Finished())
+	poll.Vote(vdr3, blkID1)
+	require.True(poll.Finished())
+
+	metrics := factory.(*earlyTermTraversalFactory).metrics
+	require.Equal(1., testutil.ToFloat64(metrics.countEarlyAlphaPolls))
+	require.Equal(2., testutil.ToFloat64(metrics.countSkippedResponses))
+}
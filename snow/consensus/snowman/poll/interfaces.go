@@ -16,11 +16,17 @@ type Set interface {
 	fmt.Stringer
 
 	Add(requestID uint32, vdrs bag.Bag[ids.NodeID]) bool
-	Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []bag.Bag[ids.ID]
-	Drop(requestID uint32, vdr ids.NodeID) []bag.Bag[ids.ID]
+	Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []Result
+	Drop(requestID uint32, vdr ids.NodeID) []Result
 	Len() int
 }
 
+// Result is the outcome of a finished poll
+type Result struct {
+	RequestID uint32
+	Votes     bag.Bag[ids.ID]
+}
+
 // Poll is an outstanding poll
 type Poll interface {
 	formatting.PrefixedStringer
@@ -110,7 +110,7 @@ func (s *set) Add(requestID uint32, vdrs bag.Bag[ids.NodeID]) bool {
 
 // Vote registers the connections response to a query for [id]. If there was no
 // query, or the response has already be registered, nothing is performed.
-func (s *set) Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []bag.Bag[ids.ID] {
+func (s *set) Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []Result {
 	holder, exists := s.polls.Get(requestID)
 	if !exists {
 		s.log.Verbo("dropping vote",
@@ -138,8 +138,8 @@ func (s *set) Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []bag.Bag[ids.
 }
 
 // processFinishedPolls checks for other dependent finished polls and returns them all if finished
-func (s *set) processFinishedPolls() []bag.Bag[ids.ID] {
-	var results []bag.Bag[ids.ID]
+func (s *set) processFinishedPolls() []Result {
+	var results []Result
 
 	// iterate from oldest to newest
 	iter := s.polls.NewIterator()
@@ -159,7 +159,10 @@ func (s *set) processFinishedPolls() []bag.Bag[ids.ID] {
 		s.durPolls.Observe(float64(time.Since(holder.StartTime())))
 		s.numPolls.Dec() // decrease the metrics
 
-		results = append(results, p.Result())
+		results = append(results, Result{
+			RequestID: iter.Key(),
+			Votes:     p.Result(),
+		})
 		s.polls.Delete(iter.Key())
 	}
 
@@ -170,7 +173,7 @@ func (s *set) processFinishedPolls() []bag.Bag[ids.ID] {
 
 // Drop registers the connections response to a query for [id]. If there was no
 // query, or the response has already be registered, nothing is performed.
-func (s *set) Drop(requestID uint32, vdr ids.NodeID) []bag.Bag[ids.ID] {
+func (s *set) Drop(requestID uint32, vdr ids.NodeID) []Result {
 	holder, exists := s.polls.Get(requestID)
 	if !exists {
 		s.log.Verbo("dropping vote",
@@ -91,8 +91,10 @@ func TestCreateAndFinishPollOutOfOrder_NewerFinishesFirst(t *testing.T) {
 
 	results := s.Vote(1, vdr3, blkID1) // poll 1 finished, poll 2 should be finished as well
 	require.Len(results, 2)
-	require.Equal(blkID1, results[0].List()[0])
-	require.Equal(blkID2, results[1].List()[0])
+	require.Equal(uint32(1), results[0].RequestID)
+	require.Equal(blkID1, results[0].Votes.List()[0])
+	require.Equal(uint32(2), results[1].RequestID)
+	require.Equal(blkID2, results[1].Votes.List()[0])
 }
 
 func TestCreateAndFinishPollOutOfOrder_OlderFinishesFirst(t *testing.T) {
@@ -124,11 +126,11 @@ func TestCreateAndFinishPollOutOfOrder_OlderFinishesFirst(t *testing.T) {
 
 	results := s.Vote(1, vdr3, blkID1) // poll 1 finished, poll 2 still remaining
 	require.Len(results, 1)            // because 1 is the oldest
-	require.Equal(blkID1, results[0].List()[0])
+	require.Equal(blkID1, results[0].Votes.List()[0])
 
 	results = s.Vote(2, vdr1, blkID2) // poll 2 finished
 	require.Len(results, 1)           // because 2 is the oldest now
-	require.Equal(blkID2, results[0].List()[0])
+	require.Equal(blkID2, results[0].Votes.List()[0])
 }
 
 func TestCreateAndFinishPollOutOfOrder_UnfinishedPollsGaps(t *testing.T) {
@@ -170,9 +172,9 @@ func TestCreateAndFinishPollOutOfOrder_UnfinishedPollsGaps(t *testing.T) {
 	require.Empty(s.Vote(1, vdr2, blkID1))
 	results := s.Vote(1, vdr3, blkID1)
 	require.Len(results, 3)
-	require.Equal(blkID1, results[0].List()[0])
-	require.Equal(blkID2, results[1].List()[0])
-	require.Equal(blkID3, results[2].List()[0])
+	require.Equal(blkID1, results[0].Votes.List()[0])
+	require.Equal(blkID2, results[1].Votes.List()[0])
+	require.Equal(blkID3, results[2].Votes.List()[0])
 }
 
 func TestCreateAndFinishSuccessfulPoll(t *testing.T) {
@@ -201,10 +203,10 @@ func TestCreateAndFinishSuccessfulPoll(t *testing.T) {
 
 	results := s.Vote(0, vdr2, blkID1)
 	require.Len(results, 1)
-	list := results[0].List()
+	list := results[0].Votes.List()
 	require.Len(list, 1)
 	require.Equal(blkID1, list[0])
-	require.Equal(2, results[0].Count(blkID1))
+	require.Equal(2, results[0].Votes.Count(blkID1))
 }
 
 func TestCreateAndFinishFailedPoll(t *testing.T) {
@@ -233,7 +235,7 @@ func TestCreateAndFinishFailedPoll(t *testing.T) {
 
 	results := s.Drop(0, vdr2)
 	require.Len(results, 1)
-	require.Empty(results[0].List())
+	require.Empty(results[0].Votes.List())
 }
 
 func TestSetString(t *testing.T) {
@@ -14,6 +14,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow"
 	"github.com/MetalBlockchain/metalgo/snow/consensus/snowball"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/utils/bag"
 	"github.com/MetalBlockchain/metalgo/utils/set"
 )
@@ -175,6 +176,11 @@ func (ts *Topological) Add(blk Block) error {
 		ts.preference = blkID
 		ts.preferredIDs.Add(blkID)
 		ts.preferredHeights[height] = blkID
+		ts.ctx.Recorder.Record(recorder.Event{
+			Type:    recorder.PreferenceChanged,
+			BlockID: blkID,
+			Height:  height,
+		})
 	}
 
 	ts.ctx.Log.Verbo("added block",
@@ -274,6 +280,7 @@ func (ts *Topological) RecordPoll(ctx context.Context, voteBag bag.Bag[ids.ID])
 	}
 
 	// Runtime = 2 * |live set| ; Space = Constant
+	defer ts.recordPreference(ts.preference)
 	ts.preferredIDs.Clear()
 	clear(ts.preferredHeights)
 
@@ -307,6 +314,24 @@ func (ts *Topological) RecordPoll(ctx context.Context, voteBag bag.Bag[ids.ID])
 	return nil
 }
 
+// recordPreference records a preference change if the preference is no longer
+// [previousPreference].
+func (ts *Topological) recordPreference(previousPreference ids.ID) {
+	if ts.preference == previousPreference {
+		return
+	}
+
+	height := ts.lastAcceptedHeight
+	if ts.preference != ts.lastAcceptedID {
+		height = ts.blocks[ts.preference].blk.Height()
+	}
+	ts.ctx.Recorder.Record(recorder.Event{
+		Type:    recorder.PreferenceChanged,
+		BlockID: ts.preference,
+		Height:  height,
+	})
+}
+
 // HealthCheck returns information about the consensus health.
 func (ts *Topological) HealthCheck(context.Context) (interface{}, error) {
 	var errs []error
@@ -619,6 +644,11 @@ func (ts *Topological) acceptPreferredChild(ctx context.Context, n *snowmanBlock
 		ts.pollNumber,
 		len(bytes),
 	)
+	ts.ctx.Recorder.Record(recorder.Event{
+		Type:    recorder.BlockAccepted,
+		BlockID: pref,
+		Height:  height,
+	})
 
 	// Because ts.blocks contains the last accepted block, we don't delete the
 	// block from the blocks map here.
@@ -640,6 +670,7 @@ func (ts *Topological) acceptPreferredChild(ctx context.Context, n *snowmanBlock
 			return err
 		}
 		ts.metrics.Rejected(childID, ts.pollNumber, len(child.Bytes()))
+		ts.recordRejected(childID, child)
 
 		// Track which blocks have been directly rejected
 		rejects = append(rejects, childID)
@@ -674,6 +705,7 @@ func (ts *Topological) rejectTransitively(ctx context.Context, rejected []ids.ID
 				return err
 			}
 			ts.metrics.Rejected(childID, ts.pollNumber, len(child.Bytes()))
+			ts.recordRejected(childID, child)
 
 			// add the newly rejected block to the end of the stack
 			rejected = append(rejected, childID)
@@ -682,6 +714,14 @@ func (ts *Topological) rejectTransitively(ctx context.Context, rejected []ids.ID
 	return nil
 }
 
+func (ts *Topological) recordRejected(blkID ids.ID, blk Block) {
+	ts.ctx.Recorder.Record(recorder.Event{
+		Type:    recorder.BlockRejected,
+		BlockID: blkID,
+		Height:  blk.Height(),
+	})
+}
+
 func (ts *Topological) GetParent(id ids.ID) (ids.ID, bool) {
 	block, ok := ts.blocks[id]
 	if !ok || block == nil || block.blk == nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/api/metrics"
 	"github.com/MetalBlockchain/metalgo/chains/atomic"
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/upgrade"
 	"github.com/MetalBlockchain/metalgo/utils"
@@ -89,6 +90,9 @@ type ConsensusContext struct {
 	// accepted.
 	VertexAcceptor Acceptor
 
+	// Recorder keeps the recent consensus events of this chain.
+	Recorder recorder.Recorder
+
 	// State indicates the current state of this consensus instance.
 	State utils.Atomic[EngineState]
 
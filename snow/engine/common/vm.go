@@ -5,6 +5,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/MetalBlockchain/metalgo/api/health"
@@ -13,6 +14,8 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 )
 
+var ErrReconfigurableVMNotImplemented = errors.New("vm does not implement ReconfigurableVM interface")
+
 // VM describes the interface that all consensus VMs must implement
 type VM interface {
 	AppHandler
@@ -84,3 +87,15 @@ type VM interface {
 	// WaitForEvent blocks until either the given context is cancelled, or a message is returned.
 	WaitForEvent(ctx context.Context) (Message, error)
 }
+
+// ReconfigurableVM defines the interface a VM can optionally implement to
+// allow its chain config to be updated without restarting the node.
+type ReconfigurableVM interface {
+	// Reconfigure is called with the new chain config bytes when the node's
+	// config is reloaded and the chain config has changed. Reconfigure is
+	// called while the chain's context lock is held.
+	//
+	// If Reconfigure returns an error, the VM should continue to use its
+	// previous config.
+	Reconfigure(ctx context.Context, configBytes []byte) error
+}
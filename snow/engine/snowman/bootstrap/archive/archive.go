@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MetalBlockchain/metalgo/database"
+)
+
+// Archive provides the bytes of accepted blocks by height.
+//
+// An archive is not trusted. Blocks returned by an archive are only used if
+// they are ancestors of a block that was accepted by the network.
+type Archive interface {
+	// GetBlocks returns the block at [height] followed by up to [maxBlocks]-1
+	// of its ancestors, ordered by decreasing height.
+	//
+	// If the block at [height] is not in the archive, [database.ErrNotFound]
+	// is returned.
+	GetBlocks(ctx context.Context, height uint64, maxBlocks int) ([][]byte, error)
+}
+
+// getBlocks reads the block at [height] and its ancestors, one at a time,
+// using [getBlock] until [maxBlocks] blocks are read or an ancestor is not
+// found.
+func getBlocks(
+	height uint64,
+	maxBlocks int,
+	getBlock func(height uint64) ([]byte, error),
+) ([][]byte, error) {
+	var blocks [][]byte
+	for len(blocks) < maxBlocks {
+		blkBytes, err := getBlock(height)
+		if errors.Is(err, database.ErrNotFound) && len(blocks) > 0 {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, blkBytes)
+		if height == 0 {
+			break
+		}
+		height--
+	}
+	return blocks, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/x/blockdb"
+)
+
+func newBlockDB(t *testing.T, minHeight uint64, blocks map[uint64][]byte) *blockdb.Database {
+	require := require.New(t)
+
+	config := blockdb.DefaultConfig().
+		WithDir(t.TempDir()).
+		WithMinimumHeight(minHeight).
+		WithSyncToDisk(false)
+	db, err := blockdb.New(config, nil)
+	require.NoError(err)
+	t.Cleanup(func() {
+		require.NoError(db.Close())
+	})
+
+	for height, blkBytes := range blocks {
+		require.NoError(db.WriteBlock(height, blkBytes, 0))
+	}
+	return db
+}
+
+func TestGetBlocks(t *testing.T) {
+	db := newBlockDB(t, 1, map[uint64][]byte{
+		1: {1},
+		2: {2},
+		3: {3},
+		5: {5},
+	})
+	blockDBArchive := NewBlockDB(db)
+
+	server := httptest.NewServer(NewHandler(blockDBArchive))
+	t.Cleanup(server.Close)
+	httpArchive := NewClient(server.URL)
+
+	tests := []struct {
+		name           string
+		height         uint64
+		maxBlocks      int
+		expectedBlocks [][]byte
+		expectedErr    error
+	}{
+		{
+			name:           "single block",
+			height:         3,
+			maxBlocks:      1,
+			expectedBlocks: [][]byte{{3}},
+		},
+		{
+			name:           "stops at max blocks",
+			height:         3,
+			maxBlocks:      2,
+			expectedBlocks: [][]byte{{3}, {2}},
+		},
+		{
+			name:           "stops at minimum height",
+			height:         3,
+			maxBlocks:      10,
+			expectedBlocks: [][]byte{{3}, {2}, {1}},
+		},
+		{
+			name:           "stops at missing block",
+			height:         5,
+			maxBlocks:      10,
+			expectedBlocks: [][]byte{{5}},
+		},
+		{
+			name:        "missing block",
+			height:      4,
+			maxBlocks:   10,
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name:        "below minimum height",
+			height:      0,
+			maxBlocks:   10,
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name:        "above maximum height",
+			height:      6,
+			maxBlocks:   10,
+			expectedErr: database.ErrNotFound,
+		},
+	}
+	archives := map[string]Archive{
+		"blockdb": blockDBArchive,
+		"http":    httpArchive,
+	}
+	for archiveName, archive := range archives {
+		for _, test := range tests {
+			t.Run(archiveName+"/"+test.name, func(t *testing.T) {
+				require := require.New(t)
+
+				blocks, err := archive.GetBlocks(context.Background(), test.height, test.maxBlocks)
+				require.ErrorIs(err, test.expectedErr)
+				require.Equal(test.expectedBlocks, blocks)
+			})
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/x/blockdb"
+)
+
+var _ Archive = (*blockDB)(nil)
+
+type blockDB struct {
+	db *blockdb.Database
+}
+
+// NewBlockDB returns an archive that reads blocks from [db].
+func NewBlockDB(db *blockdb.Database) Archive {
+	return &blockDB{
+		db: db,
+	}
+}
+
+func (b *blockDB) GetBlocks(_ context.Context, height uint64, maxBlocks int) ([][]byte, error) {
+	return getBlocks(height, maxBlocks, func(height uint64) ([]byte, error) {
+		blkBytes, err := b.db.ReadBlock(height)
+		if errors.Is(err, blockdb.ErrBlockNotFound) || errors.Is(err, blockdb.ErrInvalidBlockHeight) {
+			return nil, database.ErrNotFound
+		}
+		return blkBytes, err
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/utils/units"
+	"github.com/MetalBlockchain/metalgo/utils/wrappers"
+)
+
+const (
+	maxBlocksKey = "max"
+
+	// maxResponseSize is the maximum number of block bytes read by the client
+	// in a single response, in addition to the block at the requested height.
+	maxResponseSize = 64 * units.MiB
+	// maxBlockSize bounds the size of a single block read by the client.
+	maxBlockSize = 64 * units.MiB
+	// requestTimeout bounds the time spent on a single request, including
+	// reading the response body.
+	requestTimeout = 30 * time.Second
+)
+
+var (
+	_ Archive      = (*client)(nil)
+	_ http.Handler = (*handler)(nil)
+
+	errUnexpectedStatus = errors.New("unexpected status code")
+)
+
+type client struct {
+	uri    string
+	client *http.Client
+}
+
+// NewClient returns an archive that fetches blocks from an HTTP server at
+// [uri].
+//
+// Blocks are requested with GET <uri>/<height>?max=<maxBlocks>. The response
+// body is the concatenation of the blocks, each prefixed by its length as a
+// big-endian uint32. A 404 status code is returned if the block at <height>
+// isn't in the archive.
+func NewClient(uri string) Archive {
+	return &client{
+		uri: strings.TrimSuffix(uri, "/"),
+		client: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+func (c *client) GetBlocks(ctx context.Context, height uint64, maxBlocks int) ([][]byte, error) {
+	url := fmt.Sprintf("%s/%d?%s=%d", c.uri, height, maxBlocksKey, maxBlocks)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, database.ErrNotFound
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	limit := int64(maxResponseSize + maxBlockSize + maxBlocks*wrappers.IntLen)
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		p      = wrappers.Packer{Bytes: body}
+		blocks [][]byte
+	)
+	for p.Offset < len(body) && len(blocks) < maxBlocks {
+		blkBytes := p.UnpackLimitedBytes(maxBlockSize)
+		if p.Err != nil {
+			return nil, fmt.Errorf("failed to parse blocks: %w", p.Err)
+		}
+		blocks = append(blocks, blkBytes)
+	}
+	if len(blocks) == 0 {
+		return nil, database.ErrNotFound
+	}
+	return blocks, nil
+}
+
+type handler struct {
+	archive Archive
+}
+
+// NewHandler returns an HTTP handler that serves the blocks of [archive] in the
+// format expected by [NewClient].
+func NewHandler(archive Archive) http.Handler {
+	return &handler{
+		archive: archive,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	heightStr := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid height %q", heightStr), http.StatusBadRequest)
+		return
+	}
+
+	maxBlocks := 1
+	if maxBlocksStr := r.URL.Query().Get(maxBlocksKey); maxBlocksStr != "" {
+		maxBlocks, err = strconv.Atoi(maxBlocksStr)
+		if err != nil || maxBlocks < 1 {
+			http.Error(w, fmt.Sprintf("invalid %s %q", maxBlocksKey, maxBlocksStr), http.StatusBadRequest)
+			return
+		}
+	}
+
+	blocks, err := h.archive.GetBlocks(r.Context(), height, maxBlocks)
+	if errors.Is(err, database.ErrNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		p    = wrappers.Packer{MaxSize: maxResponseSize + maxBlockSize + len(blocks)*wrappers.IntLen}
+		size int
+	)
+	for i, blkBytes := range blocks {
+		size += len(blkBytes)
+		if i > 0 && size > maxResponseSize {
+			break
+		}
+		p.PackBytes(blkBytes)
+	}
+	if p.Err != nil {
+		http.Error(w, p.Err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(p.Bytes)
+}
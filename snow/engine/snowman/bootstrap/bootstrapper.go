@@ -41,13 +41,24 @@ const (
 	// outstanding when broadcasting.
 	maxOutstandingBroadcastRequests = 50
 
+	// maxArchiveProcessingTime is the maximum amount of time to spend
+	// processing blocks read from the archive before releasing the context
+	// lock.
+	maxArchiveProcessingTime = time.Second
+	// maxArchiveReadTime is the maximum amount of time to wait for a single
+	// read from the archive. Blocks that can't be read in time are requested
+	// from peers.
+	maxArchiveReadTime = 5 * time.Second
+
 	epsilon = 1e-6 // small amount to add to time to avoid division by 0
 )
 
 var (
 	_ common.BootstrapableEngine = (*Bootstrapper)(nil)
 
-	errUnexpectedTimeout = errors.New("unexpected timeout fired")
+	errUnexpectedTimeout      = errors.New("unexpected timeout fired")
+	errEmptyArchiveResponse   = errors.New("archive returned no blocks")
+	errUnexpectedArchiveBlock = errors.New("archive returned an unexpected block")
 )
 
 // bootstrapper repeatedly performs the bootstrapping protocol.
@@ -107,6 +118,13 @@ type Bootstrapper struct {
 	tree            *interval.Tree
 	missingBlockIDs set.Set[ids.ID]
 
+	// missing blocks that should be read from the archive before being
+	// requested from peers.
+	archiveRequests []archiveRequest
+	// archiveTimeoutPending is true if a timeout was registered to read from
+	// the archive and hasn't fired yet.
+	archiveTimeoutPending bool
+
 	// bootstrappedOnce ensures that the [Bootstrapped] callback is only invoked
 	// once, even if bootstrapping is retried.
 	bootstrappedOnce sync.Once
@@ -583,7 +601,7 @@ func (b *Bootstrapper) process(
 	numPreviouslyFetched := b.tree.Len()
 
 	batch := b.DB.NewBatch()
-	missingBlockID, foundNewMissingID, err := process(
+	missingBlockID, missingBlockHeight, foundNewMissingID, err := process(
 		batch,
 		b.tree,
 		b.missingBlockIDs,
@@ -632,17 +650,111 @@ func (b *Bootstrapper) process(
 	}
 
 	b.missingBlockIDs.Add(missingBlockID)
+	if b.Archive != nil {
+		// The block will be read from the archive once the current message has
+		// been handled. See tryStartExecuting.
+		b.archiveRequests = append(b.archiveRequests, archiveRequest{
+			blkID:  missingBlockID,
+			height: missingBlockHeight,
+		})
+		return nil
+	}
+
 	// Attempt to fetch the newly discovered block
 	return b.fetch(ctx, missingBlockID)
 }
 
+// fetchFromArchive processes the blocks requested from the archive until there
+// are no more requests or [maxArchiveProcessingTime] has elapsed. Blocks that
+// can't be read from the archive are requested from peers.
+func (b *Bootstrapper) fetchFromArchive(ctx context.Context) error {
+	deadline := time.Now().Add(maxArchiveProcessingTime)
+	for len(b.archiveRequests) > 0 && time.Now().Before(deadline) && !b.Halted() {
+		request := b.archiveRequests[0]
+		b.archiveRequests = b.archiveRequests[1:]
+
+		// The block may have been provided by a peer in the meantime.
+		if !b.missingBlockIDs.Contains(request.blkID) {
+			continue
+		}
+
+		blk, ancestors, err := b.readArchive(ctx, request)
+		if err != nil {
+			b.Ctx.Log.Debug("failed to read block from archive",
+				zap.Stringer("blkID", request.blkID),
+				zap.Uint64("height", request.height),
+				zap.Error(err),
+			)
+			if err := b.fetch(ctx, request.blkID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := b.process(ctx, blk, ancestors); err != nil {
+			return err
+		}
+	}
+	return b.tryStartExecuting(ctx)
+}
+
+// readArchive reads the requested block and its ancestors from the archive.
+// The ancestors are only used by process if they are actually ancestors of the
+// requested block, so the archive does not need to be trusted.
+//
+// As the context lock is held while reading, the read is cancelled after
+// [maxArchiveReadTime].
+func (b *Bootstrapper) readArchive(
+	ctx context.Context,
+	request archiveRequest,
+) (snowman.Block, map[ids.ID]snowman.Block, error) {
+	readCtx, cancel := context.WithTimeout(ctx, maxArchiveReadTime)
+	blks, err := b.Archive.GetBlocks(readCtx, request.height, b.Config.AncestorsMaxContainersReceived)
+	cancel()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks, err := block.BatchedParseBlock(ctx, b.VM, blks)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, nil, errEmptyArchiveResponse
+	}
+
+	requestedBlock := blocks[0]
+	if actualID := requestedBlock.ID(); actualID != request.blkID {
+		return nil, nil, fmt.Errorf("%w: expected %s but got %s",
+			errUnexpectedArchiveBlock,
+			request.blkID,
+			actualID,
+		)
+	}
+
+	ancestors := make(map[ids.ID]snowman.Block, len(blocks)-1)
+	for _, blk := range blocks[1:] {
+		ancestors[blk.ID()] = blk
+	}
+	return requestedBlock, ancestors, nil
+}
+
 // tryStartExecuting executes all pending blocks if there are no more blocks
 // being fetched. After executing all pending blocks it will either restart
 // bootstrapping, or transition into normal operations.
 func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 	if numMissingBlockIDs := b.missingBlockIDs.Len(); numMissingBlockIDs != 0 {
+		if len(b.archiveRequests) != 0 {
+			// Release the context lock before reading from the archive so that
+			// other messages can be handled.
+			b.archiveTimeoutPending = true
+			b.TimeoutRegistrar.RegisterTimeout(0)
+		}
 		return nil
 	}
+	// All the blocks have been fetched, so any remaining archive requests are
+	// stale.
+	b.archiveRequests = nil
 
 	if b.Ctx.State.Get().State == snow.NormalOp || b.awaitingTimeout {
 		return nil
@@ -734,6 +846,21 @@ func (b *Bootstrapper) getLastAccepted(ctx context.Context) (snowman.Block, erro
 }
 
 func (b *Bootstrapper) Timeout() error {
+	if b.archiveTimeoutPending {
+		b.archiveTimeoutPending = false
+		if len(b.archiveRequests) != 0 {
+			return b.fetchFromArchive(context.Background())
+		}
+		// The archive requests may have been resolved by peers before the
+		// timeout fired.
+		if b.awaitingTimeout {
+			// Only a single timeout can be pending, so the timeout registered
+			// to wait before restarting bootstrapping was merged into this
+			// one. Register it again to wait for the full delay.
+			b.TimeoutRegistrar.RegisterTimeout(bootstrappingDelay)
+		}
+		return nil
+	}
 	if !b.awaitingTimeout {
 		return errUnexpectedTimeout
 	}
@@ -753,6 +880,11 @@ func (b *Bootstrapper) restartBootstrapping(ctx context.Context) error {
 	return b.startBootstrapping(ctx)
 }
 
+type archiveRequest struct {
+	blkID  ids.ID
+	height uint64
+}
+
 func (b *Bootstrapper) Notify(_ context.Context, msg common.Message) error {
 	if msg != common.StateSyncDone {
 		b.Ctx.Log.Info("received an unexpected message from the VM",
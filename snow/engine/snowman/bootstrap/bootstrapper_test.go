@@ -338,6 +338,76 @@ func TestBootstrapperPartialFetch(t *testing.T) {
 	require.Equal(snow.NormalOp, config.Ctx.State.Get().State)
 }
 
+// Blocks are read from the archive when available and requested from peers
+// otherwise.
+func TestBootstrapperArchive(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+
+	blks := snowmantest.BuildChain(5)
+	initializeVMWithBlockchain(vm, blks)
+
+	// The archive is missing the block at height 1.
+	config.Archive = &testArchive{
+		blocks: map[uint64][]byte{
+			2: blks[2].Bytes(),
+			3: blks[3].Bytes(),
+		},
+	}
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+
+	var numTimeouts int
+	bs.TimeoutRegistrar = &enginetest.Timer{
+		RegisterTimeoutF: func(time.Duration) {
+			numTimeouts++
+		},
+	}
+
+	require.NoError(bs.Start(context.Background(), 0))
+
+	var (
+		requestID uint32
+		requested ids.ID
+	)
+	sender.SendGetAncestorsF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, blkID ids.ID) {
+		require.Equal(peerID, nodeID)
+		requestID = reqID
+		requested = blkID
+	}
+
+	require.NoError(bs.startSyncing(context.Background(), blocksToIDs(blks[4:5]))) // should request blk4
+	require.Equal(blks[4].ID(), requested)
+
+	// blk3 should be read from the archive once the timeout fires
+	require.NoError(bs.Ancestors(context.Background(), peerID, requestID, blocksToBytes(blks[4:5])))
+	require.Equal(blks[4].ID(), requested)
+	require.Equal(1, numTimeouts)
+
+	// blk3 and blk2 are read from the archive and blk1 is requested from the
+	// peer
+	require.NoError(bs.Timeout())
+	require.Equal(blks[1].ID(), requested)
+	require.Equal(snow.Bootstrapping, config.Ctx.State.Get().State)
+
+	require.NoError(bs.Ancestors(context.Background(), peerID, requestID, blocksToBytes(blks[1:2])))
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+
+	// Timeouts that weren't registered by the bootstrapper aren't ignored.
+	require.ErrorIs(bs.Timeout(), errUnexpectedTimeout)
+}
+
 // There are multiple needed blocks and some validators do not have all the
 // blocks.
 func TestBootstrapperEmptyResponse(t *testing.T) {
@@ -788,6 +858,26 @@ func TestBootstrapperRollbackOnSetState(t *testing.T) {
 	require.Equal(blks[0].HeightV, bs.startingHeight)
 }
 
+type testArchive struct {
+	blocks map[uint64][]byte
+}
+
+func (a *testArchive) GetBlocks(_ context.Context, height uint64, maxBlocks int) ([][]byte, error) {
+	var blocks [][]byte
+	for len(blocks) < maxBlocks {
+		blkBytes, ok := a.blocks[height]
+		if !ok {
+			break
+		}
+		blocks = append(blocks, blkBytes)
+		height--
+	}
+	if len(blocks) == 0 {
+		return nil, database.ErrNotFound
+	}
+	return blocks, nil
+}
+
 func initializeVMWithBlockchain(vm *blocktest.VM, blocks []*snowmantest.Block) {
 	vm.CantSetState = false
 	vm.LastAcceptedF = snowmantest.MakeLastAcceptedBlockF(
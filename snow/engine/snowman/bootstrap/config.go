@@ -10,6 +10,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/engine/common"
 	"github.com/MetalBlockchain/metalgo/snow/engine/common/tracker"
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
+	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/bootstrap/archive"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 )
 
@@ -31,6 +32,12 @@ type Config struct {
 	// containers in an ancestors message it receives.
 	AncestorsMaxContainersReceived int
 
+	// Archive, if non-nil, is used to fetch the ancestors of blocks before
+	// falling back to requesting them from peers. At most
+	// [AncestorsMaxContainersReceived] blocks are read from the archive at a
+	// time.
+	Archive archive.Archive
+
 	// Database used to track the fetched, but not yet executed, blocks during
 	// bootstrapping.
 	DB database.Database
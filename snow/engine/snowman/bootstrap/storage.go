@@ -76,7 +76,8 @@ func getMissingBlockIDs(
 // If [blk]'s height is <= the last accepted height, then it will be removed
 // from the missingIDs set.
 //
-// Returns a newly discovered blockID that should be fetched.
+// Returns a newly discovered blockID, along with its height, that should be
+// fetched.
 func process(
 	db database.KeyValueWriterDeleter,
 	tree *interval.Tree,
@@ -84,7 +85,7 @@ func process(
 	lastAcceptedHeight uint64,
 	blk snowman.Block,
 	ancestors map[ids.ID]snowman.Block,
-) (ids.ID, bool, error) {
+) (ids.ID, uint64, bool, error) {
 	for {
 		// It's possible that missingBlockIDs contain values contained inside of
 		// ancestors. So, it's important to remove IDs from the set for each
@@ -102,7 +103,7 @@ func process(
 			blkBytes,
 		)
 		if err != nil || !wantsParent {
-			return ids.Empty, false, err
+			return ids.Empty, 0, false, err
 		}
 
 		// If the parent was provided in the ancestors set, we can immediately
@@ -110,7 +111,8 @@ func process(
 		parentID := blk.Parent()
 		parent, ok := ancestors[parentID]
 		if !ok {
-			return parentID, true, nil
+			// wantsParent guarantees that height-1 doesn't underflow.
+			return parentID, height - 1, true, nil
 		}
 
 		blk = parent
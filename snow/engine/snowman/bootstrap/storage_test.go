@@ -114,6 +114,7 @@ func TestProcess(t *testing.T) {
 		blk                         snowman.Block
 		ancestors                   map[ids.ID]snowman.Block
 		expectedParentID            ids.ID
+		expectedParentHeight        uint64
 		expectedShouldFetchParentID bool
 		expectedMissingBlockIDs     set.Set[ids.ID]
 		expectedTrackedHeights      []uint64
@@ -126,6 +127,7 @@ func TestProcess(t *testing.T) {
 			blk:                         blocks[5],
 			ancestors:                   nil,
 			expectedParentID:            blocks[4].ID(),
+			expectedParentHeight:        4,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Set[ids.ID]{},
 			expectedTrackedHeights:      []uint64{5},
@@ -140,6 +142,7 @@ func TestProcess(t *testing.T) {
 				blocks[4].ID(): blocks[4],
 			},
 			expectedParentID:            blocks[3].ID(),
+			expectedParentHeight:        3,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Set[ids.ID]{},
 			expectedTrackedHeights:      []uint64{4, 5},
@@ -154,6 +157,7 @@ func TestProcess(t *testing.T) {
 				blocks[3].ID(): blocks[3],
 			},
 			expectedParentID:            blocks[4].ID(),
+			expectedParentHeight:        4,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Of(blocks[3].ID()),
 			expectedTrackedHeights:      []uint64{5},
@@ -195,7 +199,7 @@ func TestProcess(t *testing.T) {
 				require.NoError(err)
 			}
 
-			parentID, shouldFetchParentID, err := process(
+			parentID, parentHeight, shouldFetchParentID, err := process(
 				db,
 				tree,
 				test.missingBlockIDs,
@@ -206,6 +210,7 @@ func TestProcess(t *testing.T) {
 			require.NoError(err)
 			require.Equal(test.expectedShouldFetchParentID, shouldFetchParentID)
 			require.Equal(test.expectedParentID, parentID)
+			require.Equal(test.expectedParentHeight, parentHeight)
 			require.Equal(test.expectedMissingBlockIDs, test.missingBlockIDs)
 
 			require.Equal(uint64(len(test.expectedTrackedHeights)), tree.Len())
@@ -23,6 +23,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/engine/common/tracker"
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/ancestor"
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/job"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/utils/bag"
 	"github.com/MetalBlockchain/metalgo/utils/bimap"
@@ -36,6 +37,14 @@ import (
 const (
 	nonVerifiedCacheSize = 64 * units.MiB
 	errInsufficientStake = "insufficient connected stake"
+
+	// Names of the messages that are recorded when they are received.
+	putOp         = "put"
+	getFailedOp   = "get_failed"
+	pullQueryOp   = "pull_query"
+	pushQueryOp   = "push_query"
+	chitsOp       = "chits"
+	queryFailedOp = "query_failed"
 )
 
 var _ common.Engine = (*Engine)(nil)
@@ -64,6 +73,10 @@ type Engine struct {
 	// track outstanding preference requests
 	polls poll.Set
 
+	// adaptiveSampler samples the validators to query, if adaptive sampling
+	// is enabled.
+	adaptiveSampler *poll.AdaptiveSampler
+
 	// blocks that have we have sent get requests for but haven't yet received
 	blkReqs            *bimap.BiMap[common.Request, ids.ID]
 	blkReqSourceMetric map[common.Request]prometheus.Counter
@@ -127,6 +140,17 @@ func New(config Config) (*Engine, error) {
 		return nil, err
 	}
 
+	var adaptiveSampler *poll.AdaptiveSampler
+	if config.Params.AdaptiveSampling != nil {
+		adaptiveSampler, err = poll.NewAdaptiveSampler(
+			*config.Params.AdaptiveSampling,
+			config.Ctx.Registerer,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	metrics, err := newMetrics(config.Ctx.Registerer)
 	if err != nil {
 		return nil, err
@@ -148,6 +172,7 @@ func New(config Config) (*Engine, error) {
 		acceptedFrontiers:           acceptedFrontiers,
 		blocked:                     job.NewScheduler[ids.ID](),
 		polls:                       polls,
+		adaptiveSampler:             adaptiveSampler,
 		blkReqs:                     bimap.New[common.Request, ids.ID](),
 		blkReqSourceMetric:          make(map[common.Request]prometheus.Counter),
 	}, nil
@@ -205,6 +230,13 @@ func (e *Engine) Gossip(ctx context.Context) error {
 }
 
 func (e *Engine) Put(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkBytes []byte) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        putOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+	})
+
 	blk, err := e.VM.ParseBlock(ctx, blkBytes)
 	if err != nil {
 		if e.Ctx.Log.Enabled(logging.Verbo) {
@@ -276,6 +308,13 @@ func (e *Engine) Put(ctx context.Context, nodeID ids.NodeID, requestID uint32, b
 }
 
 func (e *Engine) GetFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        getFailedOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+	})
+
 	// We don't assume that this function is called after a failed Get message.
 	// Check to see if we have an outstanding request and also get what the
 	// request was for if it exists.
@@ -302,6 +341,15 @@ func (e *Engine) GetFailed(ctx context.Context, nodeID ids.NodeID, requestID uin
 }
 
 func (e *Engine) PullQuery(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID, requestedHeight uint64) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        pullQueryOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+		BlockID:   blkID,
+		Height:    requestedHeight,
+	})
+
 	e.sendChits(ctx, nodeID, requestID, requestedHeight)
 
 	issuedMetric := e.metrics.issued.WithLabelValues(pushGossipSource)
@@ -316,6 +364,14 @@ func (e *Engine) PullQuery(ctx context.Context, nodeID ids.NodeID, requestID uin
 }
 
 func (e *Engine) PushQuery(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkBytes []byte, requestedHeight uint64) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        pushQueryOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+		Height:    requestedHeight,
+	})
+
 	e.sendChits(ctx, nodeID, requestID, requestedHeight)
 
 	blk, err := e.VM.ParseBlock(ctx, blkBytes)
@@ -357,7 +413,18 @@ func (e *Engine) PushQuery(ctx context.Context, nodeID ids.NodeID, requestID uin
 }
 
 func (e *Engine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, preferredIDAtHeight ids.ID, acceptedID ids.ID, acceptedHeight uint64) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        chitsOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+		BlockID:   preferredID,
+	})
+
 	e.acceptedFrontiers.SetLastAccepted(nodeID, acceptedID, acceptedHeight)
+	if e.adaptiveSampler != nil {
+		e.adaptiveSampler.Responded(nodeID, requestID)
+	}
 
 	e.Ctx.Log.Verbo("called Chits for the block",
 		zap.Stringer("nodeID", nodeID),
@@ -415,6 +482,17 @@ func (e *Engine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32,
 }
 
 func (e *Engine) QueryFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.MessageReceived,
+		Op:        queryFailedOp,
+		NodeID:    nodeID,
+		RequestID: requestID,
+	})
+
+	if e.adaptiveSampler != nil {
+		e.adaptiveSampler.Failed(nodeID, requestID)
+	}
+
 	lastAcceptedID, lastAcceptedHeight, ok := e.acceptedFrontiers.LastAccepted(nodeID)
 	if ok {
 		return e.Chits(ctx, nodeID, requestID, lastAcceptedID, lastAcceptedID, lastAcceptedID, lastAcceptedHeight)
@@ -434,6 +512,8 @@ func (e *Engine) QueryFailed(ctx context.Context, nodeID ids.NodeID, requestID u
 func (e *Engine) Shutdown(ctx context.Context) error {
 	e.Ctx.Log.Info("shutting down consensus engine")
 
+	e.Validators.UnregisterSetCallbackListener(e.Ctx.SubnetID, e.acceptedFrontiers)
+
 	e.Ctx.Lock.Lock()
 	defer e.Ctx.Lock.Unlock()
 
@@ -880,7 +960,7 @@ func (e *Engine) sendQuery(
 		zap.Stringer("validators", e.Validators),
 	)
 
-	vdrIDs, err := e.Validators.Sample(e.Ctx.SubnetID, e.Params.K)
+	vdrIDs, err := e.sample()
 	if err != nil {
 		e.Ctx.Log.Warn("dropped query for block",
 			zap.String("reason", "insufficient number of validators"),
@@ -913,6 +993,18 @@ func (e *Engine) sendQuery(
 		return
 	}
 
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:      recorder.PollStarted,
+		RequestID: e.requestID,
+		BlockID:   blkID,
+		Height:    nextHeightToAccept,
+		NodeIDs:   vdrIDs,
+	})
+
+	if e.adaptiveSampler != nil {
+		e.adaptiveSampler.Sent(e.requestID, vdrIDs)
+	}
+
 	vdrSet := set.Of(vdrIDs...)
 	if push {
 		e.Sender.SendPushQuery(ctx, vdrSet, e.requestID, blkBytes, nextHeightToAccept)
@@ -921,6 +1013,17 @@ func (e *Engine) sendQuery(
 	}
 }
 
+// sample returns the [K] validators to query, potentially with duplicates.
+func (e *Engine) sample() ([]ids.NodeID, error) {
+	if e.adaptiveSampler == nil {
+		return e.Validators.Sample(e.Ctx.SubnetID, e.Params.K)
+	}
+	return e.adaptiveSampler.Sample(
+		e.Validators.GetMap(e.Ctx.SubnetID),
+		e.Params.K,
+	)
+}
+
 func (e *Engine) abortDueToInsufficientConnectedStake(blkID ids.ID) bool {
 	stakeConnectedRatio := e.Config.ConnectedValidators.ConnectedPercent()
 	minConnectedStakeToQuery := float64(e.Params.AlphaConfidence) / float64(e.Params.K)
@@ -1100,6 +1203,13 @@ func (e *Engine) addUnverifiedBlockToConsensus(
 		zap.Stringer("blkID", blkID),
 		zap.Uint64("height", blkHeight),
 	)
+	e.Ctx.Recorder.Record(recorder.Event{
+		Type:     recorder.BlockIssued,
+		NodeID:   nodeID,
+		BlockID:  blkID,
+		ParentID: blk.Parent(),
+		Height:   blkHeight,
+	})
 	return true, e.Consensus.Add(&memoryBlock{
 		Block:   blk,
 		metrics: e.metrics,
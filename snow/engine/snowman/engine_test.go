@@ -34,6 +34,8 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/set"
 	"github.com/MetalBlockchain/metalgo/version"
+
+	dto "github.com/prometheus/client_model/go"
 )
 
 var (
@@ -574,6 +576,60 @@ func TestEnginePushQuery(t *testing.T) {
 	require.True(*queried)
 }
 
+func TestEngineAdaptiveSampling(t *testing.T) {
+	require := require.New(t)
+
+	config := DefaultConfig(t)
+	config.Params.AdaptiveSampling = &snowball.DefaultAdaptiveSamplingParameters
+	vdr, vdrs, sender, vm, te := setup(t, config)
+	require.NoError(vdrs.AddWeight(config.Ctx.SubnetID, vdr, 98))
+
+	blk := snowmantest.BuildChild(snowmantest.Genesis)
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		require.Equal(blk.Bytes(), b)
+		return blk, nil
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		case blk.ID():
+			return blk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	sender.SendChitsF = func(context.Context, ids.NodeID, uint32, ids.ID, ids.ID, ids.ID, uint64) {}
+
+	var queryRequestIDs []uint32
+	sender.SendPullQueryF = func(_ context.Context, inVdrs set.Set[ids.NodeID], requestID uint32, _ ids.ID, _ uint64) {
+		require.Equal(set.Of(vdr), inVdrs)
+		queryRequestIDs = append(queryRequestIDs, requestID)
+	}
+
+	require.NoError(te.PushQuery(context.Background(), vdr, 0, blk.Bytes(), 1))
+	require.Len(queryRequestIDs, 1)
+
+	// The failed query reduces the sampling weight of [vdr] when the block is
+	// repolled. Changing the validator set makes the sampling weights be
+	// recalculated immediately.
+	require.NoError(vdrs.AddWeight(config.Ctx.SubnetID, vdr, 1))
+	require.NoError(te.QueryFailed(context.Background(), vdr, queryRequestIDs[0]))
+	require.Len(queryRequestIDs, 2)
+
+	metricFamilies, err := config.Ctx.Registerer.(prometheus.Gatherer).Gather()
+	require.NoError(err)
+	idx := slices.IndexFunc(metricFamilies, func(mf *dto.MetricFamily) bool {
+		return mf.GetName() == "adaptive_sampling_weight_factor"
+	})
+	require.NotEqual(-1, idx)
+	require.Equal(
+		snowball.DefaultAdaptiveSamplingParameters.MinWeightFactor,
+		metricFamilies[idx].GetMetric()[0].GetGauge().GetValue(),
+	)
+}
+
 func TestEngineBuildBlock(t *testing.T) {
 	require := require.New(t)
 
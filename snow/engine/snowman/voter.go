@@ -9,8 +9,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/consensus/snowman/poll"
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/job"
-	"github.com/MetalBlockchain/metalgo/utils/bag"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 )
 
 var _ job.Job[ids.ID] = (*voter)(nil)
@@ -43,7 +44,7 @@ func (v *voter) Execute(ctx context.Context, _ []ids.ID, _ []ids.ID) error {
 		}
 	}
 
-	var results []bag.Bag[ids.ID]
+	var results []poll.Result
 	if shouldVote {
 		v.e.selectedVoteIndex.Observe(float64(voteIndex))
 		results = v.e.polls.Vote(v.requestID, v.nodeID, vote)
@@ -57,9 +58,15 @@ func (v *voter) Execute(ctx context.Context, _ []ids.ID, _ []ids.ID) error {
 
 	for _, result := range results {
 		v.e.Ctx.Log.Debug("finishing poll",
-			zap.Stringer("result", &result),
+			zap.Uint32("requestID", result.RequestID),
+			zap.Stringer("result", &result.Votes),
 		)
-		if err := v.e.Consensus.RecordPoll(ctx, result); err != nil {
+		v.e.Ctx.Recorder.Record(recorder.Event{
+			Type:      recorder.PollFinished,
+			RequestID: result.RequestID,
+			Votes:     recorder.Votes(result.Votes),
+		})
+		if err := v.e.Consensus.RecordPoll(ctx, result.Votes); err != nil {
 			return err
 		}
 	}
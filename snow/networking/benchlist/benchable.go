@@ -5,6 +5,8 @@ package benchlist
 
 import "github.com/MetalBlockchain/metalgo/ids"
 
+var _ Benchable = Benchables(nil)
+
 // Benchable is notified when a validator is benched or unbenched from a given chain
 type Benchable interface {
 	// Mark that [validatorID] has been benched on the given chain
@@ -12,3 +14,19 @@ type Benchable interface {
 	// Mark that [validatorID] has been unbenched from the given chain
 	Unbenched(chainID ids.ID, validatorID ids.NodeID)
 }
+
+// Benchables notifies each of its elements when a validator is benched or
+// unbenched.
+type Benchables []Benchable
+
+func (b Benchables) Benched(chainID ids.ID, validatorID ids.NodeID) {
+	for _, benchable := range b {
+		benchable.Benched(chainID, validatorID)
+	}
+}
+
+func (b Benchables) Unbenched(chainID ids.ID, validatorID ids.NodeID) {
+	for _, benchable := range b {
+		benchable.Unbenched(chainID, validatorID)
+	}
+}
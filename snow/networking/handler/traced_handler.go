@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/snow"
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ Handler                = (*tracedHandler)(nil)
+	_ message.InboundMessage = (*tracedMessage)(nil)
+)
+
+type tracedHandler struct {
+	handler Handler
+	tracer  trace.Tracer
+}
+
+// Trace records a span for every message pushed to [handler]. The span ends
+// once the message has been handled, so it includes the time the message
+// spent in the queue.
+func Trace(handler Handler, tracer trace.Tracer) Handler {
+	return &tracedHandler{
+		handler: handler,
+		tracer:  tracer,
+	}
+}
+
+func (h *tracedHandler) HealthCheck(ctx context.Context) (interface{}, error) {
+	return h.handler.HealthCheck(ctx)
+}
+
+func (h *tracedHandler) Context() *snow.ConsensusContext {
+	return h.handler.Context()
+}
+
+func (h *tracedHandler) ShouldHandle(nodeID ids.NodeID) bool {
+	return h.handler.ShouldHandle(nodeID)
+}
+
+func (h *tracedHandler) SetEngineManager(engineManager *EngineManager) {
+	h.handler.SetEngineManager(engineManager)
+}
+
+func (h *tracedHandler) GetEngineManager() *EngineManager {
+	return h.handler.GetEngineManager()
+}
+
+func (h *tracedHandler) SetOnStopped(onStopped func()) {
+	h.handler.SetOnStopped(onStopped)
+}
+
+func (h *tracedHandler) Start(ctx context.Context, recoverPanic bool) {
+	h.handler.Start(ctx, recoverPanic)
+}
+
+func (h *tracedHandler) Push(ctx context.Context, msg Message) {
+	ctx, span := h.tracer.Start(ctx, "tracedHandler.Push", oteltrace.WithAttributes(
+		attribute.Stringer("nodeID", msg.NodeID()),
+		attribute.Stringer("messageOp", msg.Op()),
+		attribute.Stringer("chainID", h.handler.Context().ChainID),
+		attribute.Stringer("engineType", msg.EngineType),
+	))
+	msg.InboundMessage = &tracedMessage{
+		InboundMessage: msg.InboundMessage,
+		span:           span,
+	}
+	h.handler.Push(ctx, msg)
+}
+
+func (h *tracedHandler) Len() int {
+	return h.handler.Len()
+}
+
+func (h *tracedHandler) Stop(ctx context.Context) {
+	h.handler.Stop(ctx)
+}
+
+func (h *tracedHandler) StopWithError(ctx context.Context, err error) {
+	h.handler.StopWithError(ctx, err)
+}
+
+func (h *tracedHandler) AwaitStopped(ctx context.Context) (time.Duration, error) {
+	return h.handler.AwaitStopped(ctx)
+}
+
+// tracedMessage ends its span once it has been handled, whether it was
+// executed or dropped.
+type tracedMessage struct {
+	message.InboundMessage
+	span oteltrace.Span
+}
+
+func (m *tracedMessage) OnFinishedHandling() {
+	m.span.End()
+	m.InboundMessage.OnFinishedHandling()
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/snow"
+	"github.com/MetalBlockchain/metalgo/snow/snowtest"
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	p2ppb "github.com/MetalBlockchain/metalgo/proto/pb/p2p"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// recordingTracer records the spans it starts.
+type recordingTracer struct {
+	trace.Tracer
+	tracer oteltrace.Tracer
+}
+
+func (t recordingTracer) Start(ctx context.Context, spanName string, opts ...oteltrace.SpanStartOption) (context.Context, oteltrace.Span) {
+	return t.tracer.Start(ctx, spanName, opts...)
+}
+
+// pushRecorder records the messages pushed to it.
+type pushRecorder struct {
+	Handler
+	ctx      *snow.ConsensusContext
+	pushCtx  context.Context
+	messages []Message
+}
+
+func (h *pushRecorder) Context() *snow.ConsensusContext {
+	return h.ctx
+}
+
+func (h *pushRecorder) Push(ctx context.Context, msg Message) {
+	h.pushCtx = ctx
+	h.messages = append(h.messages, msg)
+}
+
+func TestTracedHandlerSpanIncludesQueueing(t *testing.T) {
+	require := require.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := recordingTracer{
+		Tracer: trace.Noop,
+		tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test"),
+	}
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	inner := &pushRecorder{
+		ctx: snowtest.ConsensusContext(snowCtx),
+	}
+	h := Trace(inner, tracer)
+
+	h.Push(context.Background(), Message{
+		InboundMessage: message.InboundGetAcceptedFrontier(ids.Empty, 1, time.Second, ids.EmptyNodeID),
+		EngineType:     p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+	})
+	require.Len(inner.messages, 1)
+
+	// The span must still be open while the message is queued.
+	started := recorder.Started()
+	require.Len(started, 1)
+	require.Equal("tracedHandler.Push", started[0].Name())
+	require.Equal(started[0].SpanContext(), oteltrace.SpanContextFromContext(inner.pushCtx))
+	require.Empty(recorder.Ended())
+
+	inner.messages[0].OnFinishedHandling()
+	ended := recorder.Ended()
+	require.Len(ended, 1)
+	require.Equal("tracedHandler.Push", ended[0].Name())
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package performance
+
+import (
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/json"
+)
+
+// Validator is the performance of a validator as observed by this node.
+type Validator struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Chains is the performance of the validator on each chain this node sent
+	// it requests for, sorted by chainID.
+	Chains []Chain `json:"chains"`
+	// UptimeSamples are the daily samples of the validator's uptime, from
+	// oldest to newest.
+	UptimeSamples []UptimeSample `json:"uptimeSamples"`
+}
+
+// Chain is the performance of a validator on a chain.
+type Chain struct {
+	ChainID ids.ID `json:"chainID"`
+	// PollResponses is the number of queries the validator responded to.
+	PollResponses json.Uint64 `json:"pollResponses"`
+	// PollTimeouts is the number of queries the validator didn't respond to
+	// in time.
+	PollTimeouts json.Uint64 `json:"pollTimeouts"`
+	// PollResponseRate is the fraction of queries the validator responded to.
+	PollResponseRate json.Float64 `json:"pollResponseRate"`
+	// MedianLatency and P99Latency are taken over the latencies of the most
+	// recent responses of the validator.
+	MedianLatency time.Duration `json:"medianLatency"`
+	P99Latency    time.Duration `json:"p99Latency"`
+	// Benchings are the most recent times the validator was benched on the
+	// chain, from oldest to newest.
+	Benchings []Benching `json:"benchings"`
+}
+
+// Benching is a period during which requests to a validator were failed
+// immediately because it repeatedly failed to respond.
+type Benching struct {
+	Benched time.Time `json:"benched"`
+	// Unbenched is nil if the validator is still benched.
+	Unbenched *time.Time `json:"unbenched,omitempty"`
+}
+
+// UptimeSample is the uptime of a validator, as recorded in the uptime state,
+// at a point in time.
+type UptimeSample struct {
+	Time time.Time `json:"time"`
+	// Uptime is the total time the validator has been observed online.
+	Uptime time.Duration `json:"uptime"`
+	// UptimePercentage is the percentage of the time since the previous sample
+	// that the validator was observed online. It is nil for the first sample.
+	UptimePercentage *json.Float64 `json:"uptimePercentage,omitempty"`
+}
@@ -0,0 +1,462 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package performance
+
+import (
+	"encoding/binary"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
+	"github.com/MetalBlockchain/metalgo/snow/uptime"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
+	"github.com/MetalBlockchain/metalgo/utils/json"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+)
+
+const (
+	// UptimeSamplePeriod is how often the uptime of the validators is sampled.
+	UptimeSamplePeriod = 24 * time.Hour
+
+	maxLatencySamples = 1024
+	maxBenchings      = 64
+	maxUptimeSamples  = 30
+
+	// uptimeSampleLen is the length of a persisted uptime sample: the time of
+	// the sample, in seconds since the unix epoch, followed by the uptime.
+	uptimeSampleLen = 2 * database.Uint64Size
+)
+
+var (
+	_ Tracker                            = (*tracker)(nil)
+	_ Tracker                            = noTracker{}
+	_ validators.ManagerCallbackListener = (*tracker)(nil)
+
+	errInvalidUptimeSamples = errors.New("invalid uptime samples")
+)
+
+// Tracker records how the validators respond to the requests this node sends
+// them, when they are benched and how their uptime evolves.
+//
+// Only the performance of the current validators of any subnet is recorded.
+// The performance of a validator is dropped once it stops validating every
+// subnet. The uptime samples are persisted so that the uptime history survives
+// restarts of the node.
+type Tracker interface {
+	benchlist.Benchable
+
+	// RegisterResponse records that [nodeID] responded to a request regarding
+	// [chainID] with a message of type [op], [latency] after it was sent.
+	RegisterResponse(chainID ids.ID, nodeID ids.NodeID, op message.Op, latency time.Duration)
+	// RegisterTimeout records that [nodeID] didn't respond in time to a
+	// request regarding [chainID] that expected a message of type [op].
+	RegisterTimeout(chainID ids.ID, nodeID ids.NodeID, op message.Op)
+	// SampleUptimes records the current uptime of every primary network
+	// validator.
+	SampleUptimes()
+
+	// NodeIDs returns the sorted IDs of the validators with recorded
+	// performance.
+	NodeIDs() []ids.NodeID
+	// Performance returns the recorded performance of [nodeID]. Returns false
+	// if nothing was recorded about [nodeID].
+	Performance(nodeID ids.NodeID) (Validator, bool)
+
+	// Dispatch samples the uptimes every [UptimeSamplePeriod] until Stop is
+	// called. Should be called in a goroutine.
+	Dispatch()
+	Stop()
+}
+
+type tracker struct {
+	log     logging.Logger
+	clock   mockable.Clock
+	vdrs    validators.Manager
+	uptimes uptime.Calculator
+	// db maps the nodeIDs of the validators to their uptime samples.
+	db database.Database
+
+	lock sync.Mutex
+	// numSubnets is the number of subnets validated by each current validator.
+	numSubnets map[ids.NodeID]int
+	validators map[ids.NodeID]*validator
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+type validator struct {
+	chains        map[ids.ID]*chain
+	uptimeSamples []UptimeSample
+}
+
+type chain struct {
+	pollResponses uint64
+	pollTimeouts  uint64
+	// latencies is a ring buffer of the most recent response latencies.
+	latencies   []time.Duration
+	nextLatency int
+	benchings   []*Benching
+}
+
+// NewTracker returns a tracker that samples the uptime of the primary network
+// validators in [vdrs] from [uptimes] and persists the samples in [db].
+func NewTracker(
+	log logging.Logger,
+	vdrs validators.Manager,
+	uptimes uptime.Calculator,
+	db database.Database,
+) Tracker {
+	t := &tracker{
+		log:        log,
+		vdrs:       vdrs,
+		uptimes:    uptimes,
+		db:         db,
+		numSubnets: make(map[ids.NodeID]int),
+		validators: make(map[ids.NodeID]*validator),
+		stopped:    make(chan struct{}),
+	}
+	vdrs.RegisterCallbackListener(t)
+	return t
+}
+
+func (t *tracker) OnValidatorAdded(_ ids.ID, nodeID ids.NodeID, _ *bls.PublicKey, _ ids.ID, _ uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.numSubnets[nodeID]++
+}
+
+func (t *tracker) OnValidatorRemoved(_ ids.ID, nodeID ids.NodeID, _ uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.numSubnets[nodeID]--
+	if t.numSubnets[nodeID] > 0 {
+		return
+	}
+	delete(t.numSubnets, nodeID)
+	delete(t.validators, nodeID)
+	if err := t.db.Delete(nodeID.Bytes()); err != nil {
+		t.log.Warn("failed to delete uptime samples",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (*tracker) OnValidatorWeightChanged(ids.ID, ids.NodeID, uint64, uint64) {}
+
+func (t *tracker) RegisterResponse(chainID ids.ID, nodeID ids.NodeID, op message.Op, latency time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	c, ok := t.getChain(chainID, nodeID)
+	if !ok {
+		return
+	}
+	if op == message.ChitsOp {
+		c.pollResponses++
+	}
+	if len(c.latencies) < maxLatencySamples {
+		c.latencies = append(c.latencies, latency)
+		return
+	}
+	c.latencies[c.nextLatency] = latency
+	c.nextLatency = (c.nextLatency + 1) % maxLatencySamples
+}
+
+func (t *tracker) RegisterTimeout(chainID ids.ID, nodeID ids.NodeID, op message.Op) {
+	if op != message.ChitsOp {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if c, ok := t.getChain(chainID, nodeID); ok {
+		c.pollTimeouts++
+	}
+}
+
+func (t *tracker) Benched(chainID ids.ID, nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	c, ok := t.getChain(chainID, nodeID)
+	if !ok {
+		return
+	}
+	c.benchings = append(c.benchings, &Benching{
+		Benched: t.clock.Time(),
+	})
+	if len(c.benchings) > maxBenchings {
+		c.benchings = c.benchings[1:]
+	}
+}
+
+func (t *tracker) Unbenched(chainID ids.ID, nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	c, ok := t.getChain(chainID, nodeID)
+	if !ok || len(c.benchings) == 0 {
+		return
+	}
+	if lastBenching := c.benchings[len(c.benchings)-1]; lastBenching.Unbenched == nil {
+		now := t.clock.Time()
+		lastBenching.Unbenched = &now
+	}
+}
+
+func (t *tracker) SampleUptimes() {
+	nodeIDs := t.vdrs.GetValidatorIDs(constants.PrimaryNetworkID)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, nodeID := range nodeIDs {
+		upDuration, lastUpdated, err := t.uptimes.CalculateUptime(nodeID)
+		if err != nil {
+			// The uptime may not be known yet, for example while the P-chain
+			// is bootstrapping.
+			continue
+		}
+
+		v, ok := t.getValidator(nodeID)
+		if !ok {
+			continue
+		}
+		v.uptimeSamples = appendUptimeSample(v.uptimeSamples, lastUpdated, upDuration)
+		if len(v.uptimeSamples) > maxUptimeSamples {
+			v.uptimeSamples = v.uptimeSamples[1:]
+		}
+		if err := t.db.Put(nodeID.Bytes(), marshalUptimeSamples(v.uptimeSamples)); err != nil {
+			t.log.Warn("failed to persist uptime samples",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (t *tracker) NodeIDs() []ids.NodeID {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	nodeIDs := make([]ids.NodeID, 0, len(t.validators))
+	for nodeID := range t.validators {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	slices.SortFunc(nodeIDs, ids.NodeID.Compare)
+	return nodeIDs
+}
+
+func (t *tracker) Performance(nodeID ids.NodeID) (Validator, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	v, ok := t.validators[nodeID]
+	if !ok {
+		return Validator{}, false
+	}
+
+	performance := Validator{
+		NodeID:        nodeID,
+		Chains:        make([]Chain, 0, len(v.chains)),
+		UptimeSamples: slices.Clone(v.uptimeSamples),
+	}
+	for chainID, c := range v.chains {
+		performance.Chains = append(performance.Chains, c.performance(chainID))
+	}
+	slices.SortFunc(performance.Chains, func(a, b Chain) int {
+		return a.ChainID.Compare(b.ChainID)
+	})
+	return performance, true
+}
+
+func (t *tracker) Dispatch() {
+	ticker := time.NewTicker(UptimeSamplePeriod)
+	defer ticker.Stop()
+
+	t.SampleUptimes()
+	for {
+		select {
+		case <-ticker.C:
+			t.SampleUptimes()
+		case <-t.stopped:
+			return
+		}
+	}
+}
+
+func (t *tracker) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.stopped)
+	})
+}
+
+// getValidator returns the recorded performance of [nodeID], creating it if
+// [nodeID] is a current validator. Returns false if [nodeID] isn't a current
+// validator.
+//
+// Assumes [t.lock] is held.
+func (t *tracker) getValidator(nodeID ids.NodeID) (*validator, bool) {
+	if v, ok := t.validators[nodeID]; ok {
+		return v, true
+	}
+	if t.numSubnets[nodeID] == 0 {
+		return nil, false
+	}
+
+	uptimeSamples, err := t.getUptimeSamples(nodeID)
+	if err != nil {
+		t.log.Warn("failed to load uptime samples",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+	}
+	v := &validator{
+		chains:        make(map[ids.ID]*chain),
+		uptimeSamples: uptimeSamples,
+	}
+	t.validators[nodeID] = v
+	return v, true
+}
+
+// getUptimeSamples returns the persisted uptime samples of [nodeID].
+func (t *tracker) getUptimeSamples(nodeID ids.NodeID) ([]UptimeSample, error) {
+	samplesBytes, err := t.db.Get(nodeID.Bytes())
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseUptimeSamples(samplesBytes)
+}
+
+// Assumes [t.lock] is held.
+func (t *tracker) getChain(chainID ids.ID, nodeID ids.NodeID) (*chain, bool) {
+	v, ok := t.getValidator(nodeID)
+	if !ok {
+		return nil, false
+	}
+	c, ok := v.chains[chainID]
+	if !ok {
+		c = &chain{}
+		v.chains[chainID] = c
+	}
+	return c, true
+}
+
+func (c *chain) performance(chainID ids.ID) Chain {
+	performance := Chain{
+		ChainID:       chainID,
+		PollResponses: json.Uint64(c.pollResponses),
+		PollTimeouts:  json.Uint64(c.pollTimeouts),
+		Benchings:     make([]Benching, len(c.benchings)),
+	}
+	if numPolls := c.pollResponses + c.pollTimeouts; numPolls > 0 {
+		performance.PollResponseRate = json.Float64(float64(c.pollResponses) / float64(numPolls))
+	}
+	if len(c.latencies) > 0 {
+		latencies := slices.Clone(c.latencies)
+		slices.Sort(latencies)
+		performance.MedianLatency = percentile(latencies, 50)
+		performance.P99Latency = percentile(latencies, 99)
+	}
+	for i, benching := range c.benchings {
+		performance.Benchings[i] = *benching
+	}
+	return performance
+}
+
+// appendUptimeSample appends the sample of [upDuration] at [sampleTime] to
+// [samples], along with the uptime percentage since the previous sample.
+func appendUptimeSample(samples []UptimeSample, sampleTime time.Time, upDuration time.Duration) []UptimeSample {
+	sample := UptimeSample{
+		Time:   sampleTime,
+		Uptime: upDuration,
+	}
+	if numSamples := len(samples); numSamples > 0 {
+		prevSample := samples[numSamples-1]
+		if elapsed := sample.Time.Sub(prevSample.Time); elapsed > 0 {
+			percentage := json.Float64(100 * float64(sample.Uptime-prevSample.Uptime) / float64(elapsed))
+			sample.UptimePercentage = &percentage
+		}
+	}
+	return append(samples, sample)
+}
+
+// marshalUptimeSamples encodes the time and uptime of [samples]. The uptime
+// percentages are derived from consecutive samples when they are parsed.
+func marshalUptimeSamples(samples []UptimeSample) []byte {
+	b := make([]byte, 0, len(samples)*uptimeSampleLen)
+	for _, sample := range samples {
+		b = binary.BigEndian.AppendUint64(b, uint64(sample.Time.Unix()))
+		b = binary.BigEndian.AppendUint64(b, uint64(sample.Uptime))
+	}
+	return b
+}
+
+func parseUptimeSamples(b []byte) ([]UptimeSample, error) {
+	if len(b)%uptimeSampleLen != 0 {
+		return nil, errInvalidUptimeSamples
+	}
+
+	samples := make([]UptimeSample, 0, len(b)/uptimeSampleLen)
+	for ; len(b) > 0; b = b[uptimeSampleLen:] {
+		sampleTime := time.Unix(int64(binary.BigEndian.Uint64(b)), 0)
+		upDuration := time.Duration(binary.BigEndian.Uint64(b[database.Uint64Size:]))
+		samples = appendUptimeSample(samples, sampleTime, upDuration)
+	}
+	return samples, nil
+}
+
+// percentile returns the smallest element of the sorted [values] that is
+// greater than or equal to [p] percent of [values].
+func percentile(values []time.Duration, p int) time.Duration {
+	i := (len(values)*p + 99) / 100
+	return values[max(i-1, 0)]
+}
+
+type noTracker struct{}
+
+// NewNoTracker returns a tracker that doesn't record anything.
+func NewNoTracker() Tracker {
+	return noTracker{}
+}
+
+func (noTracker) Benched(ids.ID, ids.NodeID) {}
+
+func (noTracker) Unbenched(ids.ID, ids.NodeID) {}
+
+func (noTracker) RegisterResponse(ids.ID, ids.NodeID, message.Op, time.Duration) {}
+
+func (noTracker) RegisterTimeout(ids.ID, ids.NodeID, message.Op) {}
+
+func (noTracker) SampleUptimes() {}
+
+func (noTracker) NodeIDs() []ids.NodeID {
+	return nil
+}
+
+func (noTracker) Performance(ids.NodeID) (Validator, bool) {
+	return Validator{}, false
+}
+
+func (noTracker) Dispatch() {}
+
+func (noTracker) Stop() {}
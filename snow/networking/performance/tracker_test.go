@@ -0,0 +1,285 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package performance
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MetalBlockchain/metalgo/database/memdb"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/snow/uptime"
+	"github.com/MetalBlockchain/metalgo/snow/uptime/uptimemock"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/json"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+)
+
+var errTest = errors.New("non-nil error")
+
+// newValidators returns a validator manager with [nodeID] as a primary network
+// validator.
+func newValidators(t *testing.T, nodeID ids.NodeID) validators.Manager {
+	vdrs := validators.NewManager()
+	require.NoError(t, vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.Empty, 1))
+	return vdrs
+}
+
+func TestTrackerPolls(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodeID   = ids.GenerateTestNodeID()
+		chainID0 = ids.ID{0}
+		chainID1 = ids.ID{1}
+	)
+	tracker := NewTracker(logging.NoLog{}, newValidators(t, nodeID), uptime.NoOpCalculator, memdb.New())
+	for i := 1; i <= 100; i++ {
+		tracker.RegisterResponse(chainID0, nodeID, message.ChitsOp, time.Duration(i)*time.Millisecond)
+	}
+	tracker.RegisterTimeout(chainID0, nodeID, message.ChitsOp)
+	// Only queries are counted as polls
+	tracker.RegisterResponse(chainID1, nodeID, message.AcceptedOp, time.Second)
+	tracker.RegisterTimeout(chainID1, nodeID, message.AcceptedOp)
+
+	require.Equal([]ids.NodeID{nodeID}, tracker.NodeIDs())
+
+	performance, ok := tracker.Performance(nodeID)
+	require.True(ok)
+	require.Equal(
+		Validator{
+			NodeID: nodeID,
+			Chains: []Chain{
+				{
+					ChainID:          chainID0,
+					PollResponses:    100,
+					PollTimeouts:     1,
+					PollResponseRate: json.Float64(100.0 / 101.0),
+					MedianLatency:    50 * time.Millisecond,
+					P99Latency:       99 * time.Millisecond,
+					Benchings:        []Benching{},
+				},
+				{
+					ChainID:       chainID1,
+					MedianLatency: time.Second,
+					P99Latency:    time.Second,
+					Benchings:     []Benching{},
+				},
+			},
+			UptimeSamples: nil,
+		},
+		performance,
+	)
+
+	_, ok = tracker.Performance(ids.GenerateTestNodeID())
+	require.False(ok)
+}
+
+func TestTrackerLatencySamplesBounded(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodeID  = ids.GenerateTestNodeID()
+		chainID = ids.GenerateTestID()
+	)
+	tracker := NewTracker(logging.NoLog{}, newValidators(t, nodeID), uptime.NoOpCalculator, memdb.New())
+	for range maxLatencySamples {
+		tracker.RegisterResponse(chainID, nodeID, message.ChitsOp, time.Hour)
+	}
+	// Overwrite all of the slow responses
+	for range maxLatencySamples {
+		tracker.RegisterResponse(chainID, nodeID, message.ChitsOp, time.Millisecond)
+	}
+
+	performance, ok := tracker.Performance(nodeID)
+	require.True(ok)
+	require.Len(performance.Chains, 1)
+	require.Equal(json.Uint64(2*maxLatencySamples), performance.Chains[0].PollResponses)
+	require.Equal(time.Millisecond, performance.Chains[0].P99Latency)
+}
+
+func TestTrackerBenchings(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodeID  = ids.GenerateTestNodeID()
+		chainID = ids.GenerateTestID()
+		now     = time.Unix(1_700_000_000, 0)
+	)
+	tracker := NewTracker(logging.NoLog{}, newValidators(t, nodeID), uptime.NoOpCalculator, memdb.New()).(*tracker)
+	// Unbenching a node that was never benched is ignored
+	tracker.clock.Set(now)
+	tracker.Unbenched(chainID, nodeID)
+
+	tracker.Benched(chainID, nodeID)
+	unbenched := now.Add(time.Minute)
+	tracker.clock.Set(unbenched)
+	tracker.Unbenched(chainID, nodeID)
+
+	benchedAgain := now.Add(time.Hour)
+	tracker.clock.Set(benchedAgain)
+	tracker.Benched(chainID, nodeID)
+
+	performance, ok := tracker.Performance(nodeID)
+	require.True(ok)
+	require.Len(performance.Chains, 1)
+	require.Equal(
+		[]Benching{
+			{
+				Benched:   now,
+				Unbenched: &unbenched,
+			},
+			{
+				Benched: benchedAgain,
+			},
+		},
+		performance.Chains[0].Benchings,
+	)
+
+	for range maxBenchings {
+		tracker.Unbenched(chainID, nodeID)
+		tracker.Benched(chainID, nodeID)
+	}
+	performance, ok = tracker.Performance(nodeID)
+	require.True(ok)
+	require.Len(performance.Chains[0].Benchings, maxBenchings)
+}
+
+func TestTrackerSampleUptimes(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		vdrs           = validators.NewManager()
+		uptimes        = uptimemock.NewCalculator(ctrl)
+		db             = memdb.New()
+		nodeID         = ids.GenerateTestNodeID()
+		unknownNodeID  = ids.GenerateTestNodeID()
+		subnetOnlyNode = ids.GenerateTestNodeID()
+		start          = time.Unix(1_700_000_000, 0)
+		end            = start.Add(UptimeSamplePeriod)
+	)
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.Empty, 1))
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, unknownNodeID, nil, ids.Empty, 1))
+	require.NoError(vdrs.AddStaker(ids.GenerateTestID(), subnetOnlyNode, nil, ids.Empty, 1))
+
+	tracker := NewTracker(logging.NoLog{}, vdrs, uptimes, db)
+
+	uptimes.EXPECT().CalculateUptime(nodeID).Return(time.Hour, start, nil)
+	uptimes.EXPECT().CalculateUptime(unknownNodeID).Return(time.Duration(0), time.Time{}, errTest).Times(2)
+	tracker.SampleUptimes()
+
+	uptimes.EXPECT().CalculateUptime(nodeID).Return(time.Hour+UptimeSamplePeriod/2, end, nil)
+	tracker.SampleUptimes()
+
+	performance, ok := tracker.Performance(nodeID)
+	require.True(ok)
+	percentage := json.Float64(50)
+	require.Equal(
+		[]UptimeSample{
+			{
+				Time:   start,
+				Uptime: time.Hour,
+			},
+			{
+				Time:             end,
+				Uptime:           time.Hour + UptimeSamplePeriod/2,
+				UptimePercentage: &percentage,
+			},
+		},
+		performance.UptimeSamples,
+	)
+	require.Empty(performance.Chains)
+
+	_, ok = tracker.Performance(unknownNodeID)
+	require.False(ok)
+	_, ok = tracker.Performance(subnetOnlyNode)
+	require.False(ok)
+
+	// The uptime samples are kept across restarts.
+	restarted := NewTracker(logging.NoLog{}, vdrs, uptimes, db)
+
+	uptimes.EXPECT().CalculateUptime(nodeID).Return(time.Hour+UptimeSamplePeriod, end.Add(UptimeSamplePeriod), nil)
+	uptimes.EXPECT().CalculateUptime(unknownNodeID).Return(time.Duration(0), time.Time{}, errTest)
+	restarted.SampleUptimes()
+
+	restartedPerformance, ok := restarted.Performance(nodeID)
+	require.True(ok)
+	require.Len(restartedPerformance.UptimeSamples, 3)
+	require.Equal(performance.UptimeSamples, restartedPerformance.UptimeSamples[:2])
+	require.Equal(json.Float64(50), *restartedPerformance.UptimeSamples[2].UptimePercentage)
+}
+
+func TestTrackerOnlyTracksValidators(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		vdrs         = validators.NewManager()
+		nodeID       = ids.GenerateTestNodeID()
+		nonValidator = ids.GenerateTestNodeID()
+		subnetID     = ids.GenerateTestID()
+		chainID      = ids.GenerateTestID()
+	)
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.Empty, 1))
+
+	tracker := NewTracker(logging.NoLog{}, vdrs, uptime.NoOpCalculator, memdb.New())
+
+	// Validators added after the tracker was created are tracked.
+	require.NoError(vdrs.AddStaker(subnetID, nodeID, nil, ids.Empty, 1))
+
+	tracker.RegisterResponse(chainID, nodeID, message.ChitsOp, time.Second)
+	tracker.RegisterResponse(chainID, nonValidator, message.ChitsOp, time.Second)
+	require.Equal([]ids.NodeID{nodeID}, tracker.NodeIDs())
+
+	// The performance is kept while [nodeID] validates any subnet.
+	require.NoError(vdrs.RemoveWeight(constants.PrimaryNetworkID, nodeID, 1))
+	require.Equal([]ids.NodeID{nodeID}, tracker.NodeIDs())
+
+	require.NoError(vdrs.RemoveWeight(subnetID, nodeID, 1))
+	require.Empty(tracker.NodeIDs())
+	_, ok := tracker.Performance(nodeID)
+	require.False(ok)
+
+	// Responses are ignored once [nodeID] stopped validating.
+	tracker.RegisterResponse(chainID, nodeID, message.ChitsOp, time.Second)
+	require.Empty(tracker.NodeIDs())
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		values   []time.Duration
+		p        int
+		expected time.Duration
+	}{
+		{
+			values:   []time.Duration{1},
+			p:        50,
+			expected: 1,
+		},
+		{
+			values:   []time.Duration{1, 2},
+			p:        50,
+			expected: 1,
+		},
+		{
+			values:   []time.Duration{1, 2, 3},
+			p:        50,
+			expected: 2,
+		},
+		{
+			values:   []time.Duration{1, 2, 3},
+			p:        99,
+			expected: 3,
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, percentile(test.values, test.p))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/MetalBlockchain/metalgo/utils/wrappers"
+)
+
+var errRecordTooLarge = errors.New("record is too large")
+
+// Reader reads the records written by a [Recorder].
+type Reader struct {
+	reader *bufio.Reader
+}
+
+// NewReader returns a reader of the recording in [r].
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := bufio.NewReader(r)
+	var version uint16
+	if err := binary.Read(reader, binary.BigEndian, &version); err != nil {
+		return nil, err
+	}
+	if version != Version {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedVersion, version)
+	}
+	return &Reader{
+		reader: reader,
+	}, nil
+}
+
+// Read returns the next record. If there are no more records, io.EOF is
+// returned.
+func (r *Reader) Read() (Record, error) {
+	return r.read(func() {})
+}
+
+func (r *Reader) read(onFinishedHandling func()) (Record, error) {
+	var lenBytes [wrappers.IntLen]byte
+	// io.ReadFull only returns io.EOF if no bytes were read, which is the
+	// expected end of the recording.
+	if _, err := io.ReadFull(r.reader, lenBytes[:]); err != nil {
+		return Record{}, err
+	}
+
+	recordLen := binary.BigEndian.Uint32(lenBytes[:])
+	if recordLen > maxRecordSize {
+		return Record{}, fmt.Errorf("%w: %d > %d", errRecordTooLarge, recordLen, maxRecordSize)
+	}
+
+	recordBytes := make([]byte, recordLen)
+	if _, err := io.ReadFull(r.reader, recordBytes); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return Record{}, err
+	}
+	return parseRecord(recordBytes, onFinishedHandling)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
+	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/utils/units"
+	"github.com/MetalBlockchain/metalgo/utils/wrappers"
+)
+
+const (
+	// Version is written at the start of every recording.
+	Version uint16 = 0
+
+	maxRecordSize = 64 * units.MiB
+)
+
+var (
+	errUnsupportedVersion = errors.New("unsupported recording version")
+	errTrailingBytes      = errors.New("record has trailing bytes")
+)
+
+// Record is a message that was pushed to a chain's handler.
+type Record struct {
+	// Time is when the message was pushed to the handler.
+	Time time.Time
+	// Message is the pushed message. Messages read from a recording never
+	// expire.
+	Message handler.Message
+}
+
+func (r *Record) marshal() ([]byte, error) {
+	msgBytes, err := message.MarshalInbound(r.Message.InboundMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeID := r.Message.NodeID()
+	p := wrappers.Packer{
+		MaxSize: maxRecordSize,
+	}
+	p.PackLong(uint64(r.Time.UnixNano()))
+	p.PackInt(uint32(r.Message.EngineType))
+	p.PackFixedBytes(nodeID[:])
+	p.PackByte(byte(r.Message.Op()))
+	p.PackBytes(msgBytes)
+	return p.Bytes, p.Err
+}
+
+func parseRecord(b []byte, onFinishedHandling func()) (Record, error) {
+	p := wrappers.Packer{
+		Bytes: b,
+	}
+	var (
+		unixNano    = p.UnpackLong()
+		engineType  = p.UnpackInt()
+		nodeIDBytes = p.UnpackFixedBytes(ids.NodeIDLen)
+		op          = message.Op(p.UnpackByte())
+		msgBytes    = p.UnpackLimitedBytes(maxRecordSize)
+	)
+	if p.Err != nil {
+		return Record{}, p.Err
+	}
+	if p.Offset != len(b) {
+		return Record{}, fmt.Errorf("%w: %d", errTrailingBytes, len(b)-p.Offset)
+	}
+
+	nodeID, err := ids.ToNodeID(nodeIDBytes)
+	if err != nil {
+		return Record{}, err
+	}
+	msg, err := message.UnmarshalInbound(nodeID, op, msgBytes, onFinishedHandling)
+	if err != nil {
+		return Record{}, fmt.Errorf("failed to parse %s message: %w", op, err)
+	}
+	return Record{
+		Time: time.Unix(0, int64(unixNano)),
+		Message: handler.Message{
+			InboundMessage: msg,
+			EngineType:     p2p.EngineType(engineType),
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/utils/wrappers"
+)
+
+var errClosed = errors.New("recorder is closed")
+
+// Recorder persists the messages pushed to a chain's handler so that they can
+// later be replayed with [Replay].
+//
+// A recording is the [Version] followed by a sequence of length prefixed
+// records.
+type Recorder struct {
+	lock   sync.Mutex
+	closed bool
+	writer *bufio.Writer
+	closer io.Closer
+}
+
+// NewRecorder returns a recorder that writes to [w]. Closing the recorder
+// closes [w].
+func NewRecorder(w io.WriteCloser) (*Recorder, error) {
+	writer := bufio.NewWriter(w)
+	if err := binary.Write(writer, binary.BigEndian, Version); err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		writer: writer,
+		closer: w,
+	}, nil
+}
+
+// Record persists that [msg] was pushed to the handler at [now].
+func (r *Recorder) Record(now time.Time, msg handler.Message) error {
+	record := Record{
+		Time:    now,
+		Message: msg,
+	}
+	recordBytes, err := record.marshal()
+	if err != nil {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return errClosed
+	}
+
+	var lenBytes [wrappers.IntLen]byte
+	binary.BigEndian.PutUint32(lenBytes[:], uint32(len(recordBytes)))
+	if _, err := r.writer.Write(lenBytes[:]); err != nil {
+		return err
+	}
+	_, err = r.writer.Write(recordBytes)
+	return err
+}
+
+// Close flushes any buffered records and closes the underlying writer.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	return errors.Join(
+		r.writer.Flush(),
+		r.closer.Close(),
+	)
+}
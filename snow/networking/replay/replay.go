@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package replay records the messages the router pushes to a chain and replays
+// them against a fresh handler, so that consensus issues seen on a live network
+// can be reproduced deterministically in tests.
+package replay
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+)
+
+// Replay pushes every record read from [r] into [h] in the order they were
+// recorded and returns the number of replayed messages.
+//
+// Before a message is pushed, [clock] is set to the time the message was
+// originally pushed at. The next message isn't pushed until [h] has finished
+// handling the previous one, so the replay is deterministic as long as the
+// engine and VM read the time from [clock] and the sender given to the engine
+// doesn't feed messages back into [h].
+//
+// [h] must have been started.
+func Replay(ctx context.Context, h handler.Handler, clock *mockable.Clock, r *Reader) (int, error) {
+	handled := make(chan struct{}, 1)
+	onFinishedHandling := func() {
+		select {
+		case handled <- struct{}{}:
+		default:
+		}
+	}
+
+	numReplayed := 0
+	for {
+		record, err := r.read(onFinishedHandling)
+		if errors.Is(err, io.EOF) {
+			return numReplayed, nil
+		}
+		if err != nil {
+			return numReplayed, err
+		}
+
+		clock.Set(record.Time)
+		h.Push(ctx, record.Message)
+
+		select {
+		case <-handled:
+			numReplayed++
+		case <-ctx.Done():
+			return numReplayed, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/message"
+	"github.com/MetalBlockchain/metalgo/network/p2p"
+	"github.com/MetalBlockchain/metalgo/snow"
+	"github.com/MetalBlockchain/metalgo/snow/engine/common"
+	"github.com/MetalBlockchain/metalgo/snow/engine/enginetest"
+	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
+	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/tracker"
+	"github.com/MetalBlockchain/metalgo/snow/snowtest"
+	"github.com/MetalBlockchain/metalgo/snow/validators"
+	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/math/meter"
+	"github.com/MetalBlockchain/metalgo/utils/resource"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+	"github.com/MetalBlockchain/metalgo/version"
+
+	p2ppb "github.com/MetalBlockchain/metalgo/proto/pb/p2p"
+	commontracker "github.com/MetalBlockchain/metalgo/snow/engine/common/tracker"
+)
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+func record(t *testing.T, records []Record) []byte {
+	require := require.New(t)
+
+	var buf bytes.Buffer
+	recorder, err := NewRecorder(nopCloser{Writer: &buf})
+	require.NoError(err)
+	for _, r := range records {
+		require.NoError(recorder.Record(r.Time, r.Message))
+	}
+	require.NoError(recorder.Close())
+	require.ErrorIs(recorder.Record(time.Now(), records[0].Message), errClosed)
+	return buf.Bytes()
+}
+
+func TestRecorderReader(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodeID  = ids.GenerateTestNodeID()
+		chainID = ids.GenerateTestID()
+		now     = time.Unix(1_700_000_000, 123)
+		records = []Record{
+			{
+				Time: now,
+				Message: handler.Message{
+					InboundMessage: message.InternalConnected(nodeID, version.CurrentApp),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_UNSPECIFIED,
+				},
+			},
+			{
+				Time: now.Add(time.Second),
+				Message: handler.Message{
+					InboundMessage: message.InboundPushQuery(chainID, 1, time.Second, []byte{1, 2, 3}, 2, nodeID),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_UNSPECIFIED,
+				},
+			},
+			{
+				Time: now.Add(2 * time.Second),
+				Message: handler.Message{
+					InboundMessage: message.InternalQueryFailed(nodeID, chainID, 1),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				},
+			},
+		}
+	)
+
+	reader, err := NewReader(bytes.NewReader(record(t, records)))
+	require.NoError(err)
+	for _, expected := range records {
+		r, err := reader.Read()
+		require.NoError(err)
+		require.Equal(expected.Time.UnixNano(), r.Time.UnixNano())
+		require.Equal(expected.Message.EngineType, r.Message.EngineType)
+		require.Equal(expected.Message.NodeID(), r.Message.NodeID())
+		require.Equal(expected.Message.Op(), r.Message.Op())
+		if expectedMsg, ok := expected.Message.Message().(proto.Message); ok {
+			require.True(proto.Equal(expectedMsg, r.Message.Message().(proto.Message)))
+		} else {
+			require.Equal(expected.Message.Message(), r.Message.Message())
+		}
+	}
+	_, err = reader.Read()
+	require.ErrorIs(err, io.EOF)
+}
+
+func TestReaderErrors(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	recording := record(t, []Record{
+		{
+			Time: time.Now(),
+			Message: handler.Message{
+				InboundMessage: message.InternalGossipRequest(nodeID),
+			},
+		},
+	})
+
+	tests := []struct {
+		name          string
+		recording     []byte
+		expectedErr   error
+		readerCreated bool
+	}{
+		{
+			name:        "unsupported version",
+			recording:   []byte{0, 1},
+			expectedErr: errUnsupportedVersion,
+		},
+		{
+			name:        "missing version",
+			recording:   []byte{0},
+			expectedErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:          "truncated record",
+			recording:     recording[:len(recording)-1],
+			expectedErr:   io.ErrUnexpectedEOF,
+			readerCreated: true,
+		},
+		{
+			name:          "truncated length",
+			recording:     recording[:3],
+			expectedErr:   io.ErrUnexpectedEOF,
+			readerCreated: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			reader, err := NewReader(bytes.NewReader(test.recording))
+			if !test.readerCreated {
+				require.ErrorIs(err, test.expectedErr)
+				return
+			}
+			require.NoError(err)
+
+			_, err = reader.Read()
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	vdrs := validators.NewManager()
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(ctx.SubnetID, nodeID, nil, ids.Empty, 1))
+
+	resourceTracker, err := tracker.NewResourceTracker(
+		prometheus.NewRegistry(),
+		resource.NoUsage,
+		meter.ContinuousFactory{},
+		time.Second,
+	)
+	require.NoError(err)
+
+	peerTracker, err := p2p.NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		version.CurrentApp,
+	)
+	require.NoError(err)
+
+	h, err := handler.New(
+		ctx,
+		&block.ChangeNotifier{},
+		func(ctx context.Context) (common.Message, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		},
+		vdrs,
+		time.Second,
+		2,
+		resourceTracker,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+		commontracker.NewPeers(),
+		peerTracker,
+		prometheus.NewRegistry(),
+		func() {},
+	)
+	require.NoError(err)
+
+	var (
+		clock    mockable.Clock
+		handled  []message.Op
+		handleAt []time.Time
+	)
+	bootstrapper := &enginetest.Bootstrapper{
+		Engine: enginetest.Engine{
+			T: t,
+		},
+	}
+	bootstrapper.Default(false)
+
+	engine := &enginetest.Engine{T: t}
+	engine.Default(false)
+	engine.ContextF = func() *snow.ConsensusContext {
+		return ctx
+	}
+	engine.PushQueryF = func(context.Context, ids.NodeID, uint32, []byte, uint64) error {
+		handled = append(handled, message.PushQueryOp)
+		handleAt = append(handleAt, clock.Time())
+		return nil
+	}
+	engine.ChitsF = func(context.Context, ids.NodeID, uint32, ids.ID, ids.ID, ids.ID, uint64) error {
+		handled = append(handled, message.ChitsOp)
+		handleAt = append(handleAt, clock.Time())
+		return nil
+	}
+	engine.QueryFailedF = func(context.Context, ids.NodeID, uint32) error {
+		handled = append(handled, message.QueryFailedOp)
+		handleAt = append(handleAt, clock.Time())
+		return nil
+	}
+	h.SetEngineManager(&handler.EngineManager{
+		Chain: &handler.Engine{
+			Bootstrapper: bootstrapper,
+			Consensus:    engine,
+		},
+	})
+	ctx.State.Set(snow.EngineState{
+		Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+		State: snow.NormalOp,
+	})
+	h.Start(context.Background(), false)
+	defer h.Stop(context.Background())
+
+	var (
+		chainID = ctx.ChainID
+		now     = time.Unix(1_700_000_000, 0)
+		records = []Record{
+			{
+				Time: now,
+				Message: handler.Message{
+					InboundMessage: message.InboundPushQuery(chainID, 1, time.Second, []byte{1}, 1, nodeID),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				},
+			},
+			{
+				Time: now.Add(time.Second),
+				Message: handler.Message{
+					InboundMessage: message.InboundChits(chainID, 1, ids.GenerateTestID(), ids.GenerateTestID(), ids.GenerateTestID(), nodeID),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				},
+			},
+			{
+				Time: now.Add(time.Minute),
+				Message: handler.Message{
+					InboundMessage: message.InternalQueryFailed(nodeID, chainID, 2),
+					EngineType:     p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				},
+			},
+		}
+	)
+
+	reader, err := NewReader(bytes.NewReader(record(t, records)))
+	require.NoError(err)
+
+	numReplayed, err := Replay(context.Background(), h, &clock, reader)
+	require.NoError(err)
+	require.Len(records, numReplayed)
+	require.Equal(
+		[]message.Op{
+			message.PushQueryOp,
+			message.ChitsOp,
+			message.QueryFailedOp,
+		},
+		handled,
+	)
+	for i, r := range records {
+		require.True(r.Time.Equal(handleAt[i]))
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	"github.com/MetalBlockchain/metalgo/utils"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
@@ -64,6 +65,8 @@ type ChainRouter struct {
 	lock          sync.Mutex
 	closing       bool
 	chainHandlers map[ids.ID]handler.Handler
+	// chain ID --> recorder of the messages pushed to the chain
+	recorders map[ids.ID]*replay.Recorder
 
 	// It is only safe to call [RegisterResponse] with the router lock held. Any
 	// other calls to the timeout manager with the router lock held could cause
@@ -105,6 +108,7 @@ func (cr *ChainRouter) Initialize(
 ) error {
 	cr.log = log
 	cr.chainHandlers = make(map[ids.ID]handler.Handler)
+	cr.recorders = make(map[ids.ID]*replay.Recorder)
 	cr.timeoutManager = timeoutManager
 	cr.closeTimeout = closeTimeout
 	cr.benched = make(map[ids.NodeID]set.Set[ids.ID])
@@ -133,6 +137,32 @@ func (cr *ChainRouter) Initialize(
 	return nil
 }
 
+// push records [msg] if [chain] is being recorded and passes it to [chain].
+//
+// Assumes [cr.lock] is held.
+func (cr *ChainRouter) push(ctx context.Context, chain handler.Handler, msg handler.Message) {
+	chainID := chain.Context().ChainID
+	if recorder, ok := cr.recorders[chainID]; ok {
+		if err := recorder.Record(cr.clock.Time(), msg); err != nil {
+			cr.log.Warn("failed to record message",
+				zap.Stringer("chainID", chainID),
+				zap.Stringer("messageOp", msg.Op()),
+				zap.Error(err),
+			)
+		}
+	}
+	chain.Push(ctx, msg)
+}
+
+func (cr *ChainRouter) closeRecorder(chainID ids.ID, recorder *replay.Recorder) {
+	if err := recorder.Close(); err != nil {
+		cr.log.Warn("failed to close message recording",
+			zap.Stringer("chainID", chainID),
+			zap.Error(err),
+		)
+	}
+}
+
 // RegisterRequest marks that we should expect to receive a reply for a request
 // from the given node's [chainID] and
 // the reply should have the given requestID.
@@ -290,8 +320,9 @@ func (cr *ChainRouter) handleMessage(ctx context.Context, msg message.InboundMes
 		// Note: engineType is not guaranteed to be one of the explicitly named
 		// enum values. If it was not specified it defaults to UNSPECIFIED.
 		engineType, _ := message.GetEngineType(m)
-		chain.Push(
+		cr.push(
 			ctx,
+			chain,
 			handler.Message{
 				InboundMessage: msg,
 				EngineType:     engineType,
@@ -326,8 +357,9 @@ func (cr *ChainRouter) handleMessage(ctx context.Context, msg message.InboundMes
 		cr.timeoutManager.RemoveRequest(uniqueRequestID)
 
 		// Pass the failure to the chain
-		chain.Push(
+		cr.push(
 			ctx,
+			chain,
 			handler.Message{
 				InboundMessage: msg,
 				EngineType:     req.engineType,
@@ -360,8 +392,9 @@ func (cr *ChainRouter) handleMessage(ctx context.Context, msg message.InboundMes
 	cr.timeoutManager.RegisterResponse(nodeID, chainID, uniqueRequestID, req.op, latency)
 
 	// Pass the response to the chain
-	chain.Push(
+	cr.push(
 		ctx,
+		chain,
 		handler.Message{
 			InboundMessage: msg,
 			EngineType:     req.engineType,
@@ -375,6 +408,8 @@ func (cr *ChainRouter) Shutdown(ctx context.Context) {
 	cr.lock.Lock()
 	prevChains := cr.chainHandlers
 	cr.chainHandlers = map[ids.ID]handler.Handler{}
+	recorders := cr.recorders
+	cr.recorders = map[ids.ID]*replay.Recorder{}
 	cr.closing = true
 	cr.lock.Unlock()
 
@@ -400,6 +435,10 @@ func (cr *ChainRouter) Shutdown(ctx context.Context) {
 			)
 		}
 	}
+
+	for chainID, recorder := range recorders {
+		cr.closeRecorder(chainID, recorder)
+	}
 }
 
 // AddChain registers the specified chain so that incoming
@@ -420,7 +459,7 @@ func (cr *ChainRouter) AddChain(ctx context.Context, chain handler.Handler) {
 		zap.Stringer("chainID", chainID),
 	)
 	chain.SetOnStopped(func() {
-		cr.removeChain(ctx, chainID)
+		cr.removeChain(ctx, chainID, chain)
 	})
 	cr.chainHandlers[chainID] = chain
 
@@ -441,7 +480,7 @@ func (cr *ChainRouter) AddChain(ctx context.Context, chain handler.Handler) {
 		}
 
 		msg := message.InternalConnected(validatorID, peer.version)
-		chain.Push(ctx,
+		cr.push(ctx, chain,
 			handler.Message{
 				InboundMessage: msg,
 				EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
@@ -491,8 +530,9 @@ func (cr *ChainRouter) Connected(nodeID ids.NodeID, nodeVersion *version.Applica
 			// If sybil protection is disabled, send a Connected message to
 			// every chain when connecting to the primary network.
 			if subnetID == chain.Context().SubnetID || !cr.sybilProtectionEnabled {
-				chain.Push(
+				cr.push(
 					context.TODO(),
+					chain,
 					handler.Message{
 						InboundMessage: msg,
 						EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
@@ -503,21 +543,30 @@ func (cr *ChainRouter) Connected(nodeID ids.NodeID, nodeVersion *version.Applica
 	}
 }
 
-// Disconnected routes an incoming notification that a validator was connected
-func (cr *ChainRouter) Disconnected(nodeID ids.NodeID) {
+// Disconnected routes an incoming notification that a validator was
+// disconnected from [subnetID]
+func (cr *ChainRouter) Disconnected(nodeID ids.NodeID, subnetID ids.ID) {
 	cr.lock.Lock()
 	defer cr.lock.Unlock()
 
 	if cr.closing {
 		cr.log.Debug("dropping disconnected message",
 			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("subnetID", subnetID),
 			zap.Error(errClosing),
 		)
 		return
 	}
 
-	peer := cr.peers[nodeID]
-	delete(cr.peers, nodeID)
+	peer, exists := cr.peers[nodeID]
+	if !exists || !peer.trackedSubnets.Contains(subnetID) {
+		return
+	}
+	peer.trackedSubnets.Remove(subnetID)
+	if peer.trackedSubnets.Len() == 0 {
+		delete(cr.peers, nodeID)
+	}
+
 	if _, benched := cr.benched[nodeID]; benched {
 		return
 	}
@@ -528,14 +577,24 @@ func (cr *ChainRouter) Disconnected(nodeID ids.NodeID) {
 	// set, disconnect. we cannot put an L1 validator check here since
 	// if a validator connects then it leaves validator-set, it would not be
 	// disconnected properly.
-	for _, chain := range cr.chainHandlers {
-		if peer.trackedSubnets.Contains(chain.Context().SubnetID) || !cr.sybilProtectionEnabled {
-			chain.Push(
-				context.TODO(),
-				handler.Message{
-					InboundMessage: msg,
-					EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
-				})
+	//
+	// When sybil protection is disabled, we only want this clause to happen
+	// once. Therefore, we only update the chains during the disconnection of
+	// the primary network, which is guaranteed to happen for every peer.
+	if cr.sybilProtectionEnabled || subnetID == constants.PrimaryNetworkID {
+		for _, chain := range cr.chainHandlers {
+			// If sybil protection is disabled, send a Disconnected message to
+			// every chain when disconnecting from the primary network.
+			if subnetID == chain.Context().SubnetID || !cr.sybilProtectionEnabled {
+				cr.push(
+					context.TODO(),
+					chain,
+					handler.Message{
+						InboundMessage: msg,
+						EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
+					},
+				)
+			}
 		}
 	}
 }
@@ -569,8 +628,9 @@ func (cr *ChainRouter) Benched(chainID ids.ID, nodeID ids.NodeID) {
 
 	for _, chain := range cr.chainHandlers {
 		if peer.trackedSubnets.Contains(chain.Context().SubnetID) || !cr.sybilProtectionEnabled {
-			chain.Push(
+			cr.push(
 				context.TODO(),
+				chain,
 				handler.Message{
 					InboundMessage: msg,
 					EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
@@ -611,8 +671,9 @@ func (cr *ChainRouter) Unbenched(chainID ids.ID, nodeID ids.NodeID) {
 
 	for _, chain := range cr.chainHandlers {
 		if peer.trackedSubnets.Contains(chain.Context().SubnetID) || !cr.sybilProtectionEnabled {
-			chain.Push(
+			cr.push(
 				context.TODO(),
+				chain,
 				handler.Message{
 					InboundMessage: msg,
 					EngineType:     p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
@@ -621,6 +682,36 @@ func (cr *ChainRouter) Unbenched(chainID ids.ID, nodeID ids.NodeID) {
 	}
 }
 
+// RecordChain persists every message pushed to [chainID] into [recorder] so
+// that it can later be replayed. The router closes [recorder] when it is
+// replaced or when the router is shut down.
+func (cr *ChainRouter) RecordChain(chainID ids.ID, recorder *replay.Recorder) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	if cr.closing {
+		cr.log.Debug("dropping record chain request",
+			zap.Stringer("chainID", chainID),
+			zap.Error(errClosing),
+		)
+		cr.closeRecorder(chainID, recorder)
+		return
+	}
+
+	if prevRecorder, ok := cr.recorders[chainID]; ok {
+		cr.closeRecorder(chainID, prevRecorder)
+	}
+	cr.recorders[chainID] = recorder
+}
+
+// SetHealthConfig updates the thresholds used by the health check.
+func (cr *ChainRouter) SetHealthConfig(healthConfig HealthConfig) {
+	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
+	cr.healthConfig = healthConfig
+}
+
 // HealthCheck returns results of router health checks. Returns:
 // 1) Information about health check results
 // 2) An error if the health check reports unhealthy
@@ -661,12 +752,13 @@ func (cr *ChainRouter) HealthCheck(context.Context) (interface{}, error) {
 	return details, nil
 }
 
-// RemoveChain removes the specified chain so that incoming
-// messages can't be routed to it
-func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
+// removeChain removes the specified chain so that incoming messages can't be
+// routed to it. If the chain has since been replaced by a new handler, the new
+// handler is left untouched.
+func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID, stopped handler.Handler) {
 	cr.lock.Lock()
 	chain, exists := cr.chainHandlers[chainID]
-	if !exists {
+	if !exists || chain != stopped {
 		cr.log.Debug("can't remove unknown chain",
 			zap.Stringer("chainID", chainID),
 		)
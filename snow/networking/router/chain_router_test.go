@@ -5,6 +5,9 @@ package router
 
 import (
 	"context"
+	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -23,12 +26,15 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler/handlermock"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
+	"github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	"github.com/MetalBlockchain/metalgo/snow/networking/tracker"
 	"github.com/MetalBlockchain/metalgo/snow/snowtest"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/subnets"
 	"github.com/MetalBlockchain/metalgo/tests"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/math/meter"
 	"github.com/MetalBlockchain/metalgo/utils/resource"
@@ -64,6 +70,7 @@ func TestShutdown(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -307,6 +314,50 @@ func TestConnectedAfterShutdownErrorLogRegression(t *testing.T) {
 	)
 }
 
+func TestDisconnectedSubnet(t *testing.T) {
+	require := require.New(t)
+
+	chainRouter := ChainRouter{}
+	require.NoError(chainRouter.Initialize(
+		ids.EmptyNodeID,
+		logging.NoLog{},
+		nil,
+		time.Millisecond,
+		set.Set[ids.ID]{},
+		true,
+		set.Set[ids.ID]{},
+		nil,
+		HealthConfig{},
+		prometheus.NewRegistry(),
+	))
+	defer chainRouter.Shutdown(context.Background())
+
+	var (
+		nodeID   = ids.GenerateTestNodeID()
+		subnetID = ids.GenerateTestID()
+	)
+	chainRouter.Connected(nodeID, version.CurrentApp, constants.PrimaryNetworkID)
+	chainRouter.Connected(nodeID, version.CurrentApp, subnetID)
+	require.Equal(
+		set.Of(constants.PrimaryNetworkID, subnetID),
+		chainRouter.peers[nodeID].trackedSubnets,
+	)
+
+	// Disconnecting from a subnet keeps the peer connected to the others.
+	chainRouter.Disconnected(nodeID, subnetID)
+	require.Equal(
+		set.Of(constants.PrimaryNetworkID),
+		chainRouter.peers[nodeID].trackedSubnets,
+	)
+
+	// Disconnecting from a subnet the peer isn't connected to is a no-op.
+	chainRouter.Disconnected(nodeID, subnetID)
+	require.Contains(chainRouter.peers, nodeID)
+
+	chainRouter.Disconnected(nodeID, constants.PrimaryNetworkID)
+	require.NotContains(chainRouter.peers, nodeID)
+}
+
 func TestShutdownTimesOut(t *testing.T) {
 	require := require.New(t)
 
@@ -326,6 +377,7 @@ func TestShutdownTimesOut(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -476,6 +528,7 @@ func TestRouterTimeout(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -809,6 +862,7 @@ func TestRouterHonorsRequestedEngine(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -1018,6 +1072,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -1181,6 +1236,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -1433,6 +1489,50 @@ func TestAppRequest(t *testing.T) {
 	}
 }
 
+func TestRecordChain(t *testing.T) {
+	require := require.New(t)
+
+	chainRouter, engine := newChainRouterTest(t)
+
+	path := filepath.Join(t.TempDir(), "recording")
+	f, err := os.Create(path)
+	require.NoError(err)
+	recorder, err := replay.NewRecorder(f)
+	require.NoError(err)
+	chainRouter.RecordChain(snowtest.PChainID, recorder)
+
+	handled := make(chan struct{})
+	engine.PushQueryF = func(context.Context, ids.NodeID, uint32, []byte, uint64) error {
+		close(handled)
+		return nil
+	}
+
+	nodeID := ids.GenerateTestNodeID()
+	chainRouter.HandleInbound(
+		context.Background(),
+		message.InboundPushQuery(snowtest.PChainID, 1, time.Minute, []byte{1}, 2, nodeID),
+	)
+	<-handled
+
+	// Shutting down the router closes the recorder
+	chainRouter.Shutdown(context.Background())
+
+	f, err = os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+
+	reader, err := replay.NewReader(f)
+	require.NoError(err)
+
+	record, err := reader.Read()
+	require.NoError(err)
+	require.Equal(message.PushQueryOp, record.Message.Op())
+	require.Equal(nodeID, record.Message.NodeID())
+
+	_, err = reader.Read()
+	require.ErrorIs(err, io.EOF)
+}
+
 func newChainRouterTest(t *testing.T) (*ChainRouter, *enginetest.Engine) {
 	// Create a timeout manager
 	tm, err := timeout.NewManager(
@@ -1444,6 +1544,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *enginetest.Engine) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -32,5 +32,5 @@ type ExternalHandler interface {
 	InboundHandler
 
 	Connected(nodeID ids.NodeID, nodeVersion *version.Application, subnetID ids.ID)
-	Disconnected(nodeID ids.NodeID)
+	Disconnected(nodeID ids.NodeID, subnetID ids.ID)
 }
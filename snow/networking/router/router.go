@@ -15,6 +15,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/set"
@@ -40,6 +41,11 @@ type Router interface {
 	) error
 	Shutdown(context.Context)
 	AddChain(ctx context.Context, chain handler.Handler)
+	// RecordChain persists every message pushed to the chain so that it can
+	// later be replayed.
+	RecordChain(chainID ids.ID, recorder *replay.Recorder)
+	// SetHealthConfig updates the thresholds used by the health check.
+	SetHealthConfig(healthConfig HealthConfig)
 	health.Checker
 }
 
@@ -18,6 +18,7 @@ import (
 	message "github.com/MetalBlockchain/metalgo/message"
 	p2p "github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	handler "github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	replay "github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	router "github.com/MetalBlockchain/metalgo/snow/networking/router"
 	timeout "github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	logging "github.com/MetalBlockchain/metalgo/utils/logging"
@@ -88,15 +89,15 @@ func (mr *RouterMockRecorder) Connected(nodeID, nodeVersion, subnetID any) *gomo
 }
 
 // Disconnected mocks base method.
-func (m *Router) Disconnected(nodeID ids.NodeID) {
+func (m *Router) Disconnected(nodeID ids.NodeID, subnetID ids.ID) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Disconnected", nodeID)
+	m.ctrl.Call(m, "Disconnected", nodeID, subnetID)
 }
 
 // Disconnected indicates an expected call of Disconnected.
-func (mr *RouterMockRecorder) Disconnected(nodeID any) *gomock.Call {
+func (mr *RouterMockRecorder) Disconnected(nodeID, subnetID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnected", reflect.TypeOf((*Router)(nil).Disconnected), nodeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnected", reflect.TypeOf((*Router)(nil).Disconnected), nodeID, subnetID)
 }
 
 // HandleInbound mocks base method.
@@ -152,6 +153,18 @@ func (mr *RouterMockRecorder) Initialize(nodeID, log, timeouts, shutdownTimeout,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*Router)(nil).Initialize), nodeID, log, timeouts, shutdownTimeout, criticalChains, sybilProtectionEnabled, trackedSubnets, onFatal, healthConfig, reg)
 }
 
+// RecordChain mocks base method.
+func (m *Router) RecordChain(chainID ids.ID, recorder *replay.Recorder) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordChain", chainID, recorder)
+}
+
+// RecordChain indicates an expected call of RecordChain.
+func (mr *RouterMockRecorder) RecordChain(chainID, recorder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChain", reflect.TypeOf((*Router)(nil).RecordChain), chainID, recorder)
+}
+
 // RegisterRequest mocks base method.
 func (m *Router) RegisterRequest(ctx context.Context, nodeID ids.NodeID, chainID ids.ID, requestID uint32, op message.Op, failedMsg message.InboundMessage, engineType p2p.EngineType) {
 	m.ctrl.T.Helper()
@@ -164,6 +177,18 @@ func (mr *RouterMockRecorder) RegisterRequest(ctx, nodeID, chainID, requestID, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRequest", reflect.TypeOf((*Router)(nil).RegisterRequest), ctx, nodeID, chainID, requestID, op, failedMsg, engineType)
 }
 
+// SetHealthConfig mocks base method.
+func (m *Router) SetHealthConfig(healthConfig router.HealthConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHealthConfig", healthConfig)
+}
+
+// SetHealthConfig indicates an expected call of SetHealthConfig.
+func (mr *RouterMockRecorder) SetHealthConfig(healthConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHealthConfig", reflect.TypeOf((*Router)(nil).SetHealthConfig), healthConfig)
+}
+
 // Shutdown mocks base method.
 func (m *Router) Shutdown(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/message"
 	"github.com/MetalBlockchain/metalgo/proto/pb/p2p"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/replay"
 	"github.com/MetalBlockchain/metalgo/snow/networking/timeout"
 	"github.com/MetalBlockchain/metalgo/trace"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
@@ -141,8 +142,8 @@ func (r *tracedRouter) Connected(nodeID ids.NodeID, nodeVersion *version.Applica
 	r.router.Connected(nodeID, nodeVersion, subnetID)
 }
 
-func (r *tracedRouter) Disconnected(nodeID ids.NodeID) {
-	r.router.Disconnected(nodeID)
+func (r *tracedRouter) Disconnected(nodeID ids.NodeID, subnetID ids.ID) {
+	r.router.Disconnected(nodeID, subnetID)
 }
 
 func (r *tracedRouter) Benched(chainID ids.ID, nodeID ids.NodeID) {
@@ -153,6 +154,14 @@ func (r *tracedRouter) Unbenched(chainID ids.ID, nodeID ids.NodeID) {
 	r.router.Unbenched(chainID, nodeID)
 }
 
+func (r *tracedRouter) RecordChain(chainID ids.ID, recorder *replay.Recorder) {
+	r.router.RecordChain(chainID, recorder)
+}
+
+func (r *tracedRouter) SetHealthConfig(healthConfig HealthConfig) {
+	r.router.SetHealthConfig(healthConfig)
+}
+
 func (r *tracedRouter) HealthCheck(ctx context.Context) (interface{}, error) {
 	ctx, span := r.tracer.Start(ctx, "tracedRouter.HealthCheck")
 	defer span.End()
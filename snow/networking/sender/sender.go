@@ -1274,12 +1274,15 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 		}
 	}
 
-	// Create the outbound message.
-	outMsg, err := s.msgCreator.AppRequest(
+	// Create the outbound message. The trace parent is only set if the
+	// sender is traced and trace contexts are propagated.
+	traceParent, _ := ctx.Value(traceParentKey{}).(string)
+	outMsg, err := s.msgCreator.AppRequestWithTraceParent(
 		s.ctx.ChainID,
 		requestID,
 		deadline,
 		appRequestBytes,
+		traceParent,
 	)
 
 	// Send the message over the network.
@@ -25,6 +25,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/snow/networking/router"
 	"github.com/MetalBlockchain/metalgo/snow/networking/router/routermock"
 	"github.com/MetalBlockchain/metalgo/snow/networking/sender/sendermock"
@@ -35,6 +36,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/snowtest"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/subnets"
+	"github.com/MetalBlockchain/metalgo/trace"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/math/meter"
@@ -68,6 +70,7 @@ func TestTimeout(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist,
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -326,6 +329,7 @@ func TestReliableMessages(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist,
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -485,6 +489,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 					TimeoutCoefficient: 1.25,
 				},
 				benchlist,
+				performance.NewNoTracker(),
 				prometheus.NewRegistry(),
 				prometheus.NewRegistry(),
 			)
@@ -1317,3 +1322,78 @@ func noopSubscription(ctx context.Context) (common.Message, error) {
 	<-ctx.Done()
 	return common.Message(0), ctx.Err()
 }
+
+// propagatingTracer propagates the same trace parent for every span.
+type propagatingTracer struct {
+	trace.Tracer
+	traceParent string
+}
+
+func (t propagatingTracer) TraceParent(context.Context) string {
+	return t.traceParent
+}
+
+func TestTracedSenderPropagatesTraceParent(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		nodeID       = ids.GenerateTestNodeID()
+		deadline     = time.Second
+		requestID    = uint32(1337)
+		requestBytes = []byte{1, 2, 3}
+		traceParent  = "00-01000000000000000000000000000000-0200000000000000-01"
+
+		snowCtx        = snowtest.Context(t, snowtest.PChainID)
+		ctx            = snowtest.ConsensusContext(snowCtx)
+		msgCreator     = messagemock.NewOutboundMsgBuilder(ctrl)
+		externalSender = sendermock.NewExternalSender(ctrl)
+		timeoutManager = timeoutmock.NewManager(ctrl)
+		router         = routermock.NewRouter(ctrl)
+	)
+
+	s, err := New(
+		ctx,
+		msgCreator,
+		externalSender,
+		router,
+		timeoutManager,
+		p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	s = Trace(s, propagatingTracer{
+		Tracer:      trace.Noop,
+		traceParent: traceParent,
+	})
+
+	timeoutManager.EXPECT().TimeoutDuration().Return(deadline)
+	timeoutManager.EXPECT().IsBenched(nodeID, ctx.ChainID).Return(false)
+	router.EXPECT().RegisterRequest(
+		gomock.Any(),
+		nodeID,
+		ctx.ChainID,
+		requestID,
+		message.AppResponseOp,
+		gomock.Any(),
+		p2ppb.EngineType_ENGINE_TYPE_UNSPECIFIED,
+	)
+	msgCreator.EXPECT().AppRequestWithTraceParent(
+		ctx.ChainID,
+		requestID,
+		deadline,
+		requestBytes,
+		traceParent,
+	).Return(nil, nil)
+	externalSender.EXPECT().Send(
+		gomock.Any(),
+		common.SendConfig{
+			NodeIDs: set.Of(nodeID),
+		},
+		ctx.SubnetID,
+		gomock.Any(),
+	).Return(set.Of(nodeID))
+
+	require.NoError(s.SendAppRequest(context.Background(), set.Of(nodeID), requestID, requestBytes))
+}
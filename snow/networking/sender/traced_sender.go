@@ -18,6 +18,10 @@ import (
 
 var _ common.Sender = (*tracedSender)(nil)
 
+// traceParentKey is the context key of the W3C traceparent that is sent in
+// AppRequests.
+type traceParentKey struct{}
+
 type tracedSender struct {
 	sender common.Sender
 	tracer trace.Tracer
@@ -199,6 +203,9 @@ func (s *tracedSender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.N
 	))
 	defer span.End()
 
+	if traceParent := s.tracer.TraceParent(ctx); traceParent != "" {
+		ctx = context.WithValue(ctx, traceParentKey{}, traceParent)
+	}
 	return s.sender.SendAppRequest(ctx, nodeIDs, requestID, appRequestBytes)
 }
 
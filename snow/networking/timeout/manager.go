@@ -14,6 +14,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/message"
 	"github.com/MetalBlockchain/metalgo/snow"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/utils/timer"
 )
 
@@ -71,6 +72,7 @@ type Manager interface {
 func NewManager(
 	timeoutConfig *timer.AdaptiveTimeoutConfig,
 	benchlistMgr benchlist.Manager,
+	performanceTracker performance.Tracker,
 	requestReg prometheus.Registerer,
 	responseReg prometheus.Registerer,
 ) (Manager, error) {
@@ -88,17 +90,19 @@ func NewManager(
 	}
 
 	return &manager{
-		tm:           tm,
-		benchlistMgr: benchlistMgr,
-		metrics:      m,
+		tm:                 tm,
+		benchlistMgr:       benchlistMgr,
+		performanceTracker: performanceTracker,
+		metrics:            m,
 	}, nil
 }
 
 type manager struct {
-	tm           timer.AdaptiveTimeoutManager
-	benchlistMgr benchlist.Manager
-	metrics      *timeoutMetrics
-	stopOnce     sync.Once
+	tm                 timer.AdaptiveTimeoutManager
+	benchlistMgr       benchlist.Manager
+	performanceTracker performance.Tracker
+	metrics            *timeoutMetrics
+	stopOnce           sync.Once
 }
 
 func (m *manager) Dispatch() {
@@ -141,6 +145,7 @@ func (m *manager) RegisterRequest(
 			// benchlist manager.
 			m.benchlistMgr.RegisterFailure(chainID, nodeID)
 		}
+		m.performanceTracker.RegisterTimeout(chainID, nodeID, message.Op(requestID.Op))
 		timeoutHandler()
 	}
 	m.tm.Put(requestID, measureLatency, newTimeoutHandler)
@@ -157,6 +162,7 @@ func (m *manager) RegisterResponse(
 ) {
 	m.metrics.Observe(chainID, op, latency)
 	m.benchlistMgr.RegisterResponse(chainID, nodeID)
+	m.performanceTracker.RegisterResponse(chainID, nodeID, op, latency)
 	m.tm.Remove(requestID)
 }
 
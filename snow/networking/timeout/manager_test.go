@@ -13,6 +13,7 @@ import (
 
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/utils/timer"
 )
 
@@ -27,6 +28,7 @@ func TestManagerFire(t *testing.T) {
 			TimeoutHalflife:    5 * time.Minute,
 		},
 		benchlist,
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+)
+
+// Version is the version of the dump format.
+const Version = 1
+
+var errUnsupportedVersion = errors.New("unsupported dump version")
+
+// Dump is the recorded events of a chain along with the information needed to
+// interpret them. The format is documented in README.md.
+type Dump struct {
+	Version  int        `json:"version"`
+	NodeID   ids.NodeID `json:"nodeID"`
+	ChainID  ids.ID     `json:"chainID"`
+	SubnetID ids.ID     `json:"subnetID"`
+	// DumpedAt is the time the events were dumped.
+	DumpedAt time.Time `json:"dumpedAt"`
+	// Events are ordered from oldest to newest.
+	Events []Event `json:"events"`
+}
+
+// WriteDump writes [dump] to [w].
+func WriteDump(w io.Writer, dump *Dump) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(dump)
+}
+
+// ReadDump reads a dump that was written by [WriteDump] from [r].
+func ReadDump(r io.Reader) (*Dump, error) {
+	dump := &Dump{}
+	if err := json.NewDecoder(r).Decode(dump); err != nil {
+		return nil, err
+	}
+	if dump.Version != Version {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedVersion, dump.Version)
+	}
+	return dump, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package recorder
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/bag"
+)
+
+const (
+	// MessageReceived is recorded when the consensus engine handles a
+	// consensus message from a peer.
+	MessageReceived Type = "message_received"
+	// PollStarted is recorded when the consensus engine queries validators
+	// for their preference.
+	PollStarted Type = "poll_started"
+	// PollFinished is recorded when the votes of a poll are applied to
+	// consensus.
+	PollFinished Type = "poll_finished"
+	// BlockIssued is recorded when a verified block is added to consensus.
+	BlockIssued Type = "block_issued"
+	// PreferenceChanged is recorded when the preferred block changes.
+	PreferenceChanged Type = "preference_changed"
+	// BlockAccepted is recorded when a block is accepted.
+	BlockAccepted Type = "block_accepted"
+	// BlockRejected is recorded when a block is rejected.
+	BlockRejected Type = "block_rejected"
+)
+
+// Type is the kind of a consensus event.
+type Type string
+
+// Event is a consensus event. Only the fields that are relevant to the type of
+// the event are set.
+type Event struct {
+	Time time.Time
+	Type Type
+
+	// Op is the name of the received message.
+	Op string
+	// NodeID is the peer that sent the message or the block.
+	NodeID    ids.NodeID
+	RequestID uint32
+
+	BlockID  ids.ID
+	ParentID ids.ID
+	Height   uint64
+
+	// NodeIDs are the validators that were polled.
+	NodeIDs []ids.NodeID
+	// Votes are the blocks that were voted for in the poll.
+	Votes []Vote
+}
+
+// Vote is the number of votes a block received in a poll.
+type Vote struct {
+	BlockID ids.ID `json:"blockID"`
+	Votes   int    `json:"votes"`
+}
+
+// Votes returns the number of votes each block received in [votes], sorted by
+// block ID.
+func Votes(votes bag.Bag[ids.ID]) []Vote {
+	blkIDs := votes.List()
+	slices.SortFunc(blkIDs, ids.ID.Compare)
+
+	counts := make([]Vote, len(blkIDs))
+	for i, blkID := range blkIDs {
+		counts[i] = Vote{
+			BlockID: blkID,
+			Votes:   votes.Count(blkID),
+		}
+	}
+	return counts
+}
+
+// jsonEvent is the JSON representation of an Event. Fields that aren't set are
+// omitted.
+type jsonEvent struct {
+	Time      time.Time    `json:"time"`
+	Type      Type         `json:"type"`
+	Op        string       `json:"op,omitempty"`
+	NodeID    *ids.NodeID  `json:"nodeID,omitempty"`
+	RequestID uint32       `json:"requestID,omitempty"`
+	BlockID   *ids.ID      `json:"blockID,omitempty"`
+	ParentID  *ids.ID      `json:"parentID,omitempty"`
+	Height    uint64       `json:"height,omitempty"`
+	NodeIDs   []ids.NodeID `json:"nodeIDs,omitempty"`
+	Votes     []Vote       `json:"votes,omitempty"`
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	j := jsonEvent{
+		Time:      e.Time,
+		Type:      e.Type,
+		Op:        e.Op,
+		RequestID: e.RequestID,
+		Height:    e.Height,
+		NodeIDs:   e.NodeIDs,
+		Votes:     e.Votes,
+	}
+	if e.NodeID != ids.EmptyNodeID {
+		j.NodeID = &e.NodeID
+	}
+	if e.BlockID != ids.Empty {
+		j.BlockID = &e.BlockID
+	}
+	if e.ParentID != ids.Empty {
+		j.ParentID = &e.ParentID
+	}
+	return json.Marshal(j)
+}
+
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var j jsonEvent
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+
+	*e = Event{
+		Time:      j.Time,
+		Type:      j.Type,
+		Op:        j.Op,
+		RequestID: j.RequestID,
+		Height:    j.Height,
+		NodeIDs:   j.NodeIDs,
+		Votes:     j.Votes,
+	}
+	if j.NodeID != nil {
+		e.NodeID = *j.NodeID
+	}
+	if j.BlockID != nil {
+		e.BlockID = *j.BlockID
+	}
+	if j.ParentID != nil {
+		e.ParentID = *j.ParentID
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package recorder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/utils/buffer"
+)
+
+var (
+	_ Recorder = (*recorder)(nil)
+	_ Recorder = noOp{}
+
+	// NoOp is a Recorder that drops all the events.
+	NoOp Recorder = noOp{}
+)
+
+// Recorder keeps the most recent consensus events of a chain in memory so that
+// they can be inspected after an incident.
+type Recorder interface {
+	// Record adds [event] to the recorder. If the time of the event isn't set,
+	// it is set to the current time. If the recorder is full, the oldest event
+	// is evicted.
+	Record(event Event)
+	// Events returns the recorded events, from oldest to newest.
+	Events() []Event
+}
+
+type recorder struct {
+	lock   sync.Mutex
+	events buffer.Queue[Event]
+}
+
+// New returns a Recorder that keeps the last [size] events. If [size] is 0,
+// events are not recorded.
+func New(size int) (Recorder, error) {
+	if size == 0 {
+		return NoOp, nil
+	}
+
+	events, err := buffer.NewBoundedQueue[Event](size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &recorder{
+		events: events,
+	}, nil
+}
+
+func (r *recorder) Record(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.events.Push(event)
+}
+
+func (r *recorder) Events() []Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.events.List()
+}
+
+type noOp struct{}
+
+func (noOp) Record(Event) {}
+
+func (noOp) Events() []Event {
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/bag"
+)
+
+func TestRecorderEvictsOldestEvents(t *testing.T) {
+	require := require.New(t)
+
+	r, err := New(2)
+	require.NoError(err)
+
+	for height := range uint64(3) {
+		r.Record(Event{
+			Type:   BlockAccepted,
+			Height: height,
+		})
+	}
+
+	events := r.Events()
+	require.Len(events, 2)
+	require.Equal(uint64(1), events[0].Height)
+	require.Equal(uint64(2), events[1].Height)
+	for _, event := range events {
+		require.False(event.Time.IsZero())
+	}
+}
+
+func TestRecorderDisabled(t *testing.T) {
+	require := require.New(t)
+
+	r, err := New(0)
+	require.NoError(err)
+
+	r.Record(Event{Type: BlockAccepted})
+	require.Empty(r.Events())
+}
+
+func TestEventJSONOmitsUnsetFields(t *testing.T) {
+	require := require.New(t)
+
+	event := Event{
+		Time:    time.Unix(0, 0).UTC(),
+		Type:    BlockAccepted,
+		BlockID: ids.GenerateTestID(),
+		Height:  1,
+	}
+	eventBytes, err := json.Marshal(event)
+	require.NoError(err)
+
+	var fields map[string]any
+	require.NoError(json.Unmarshal(eventBytes, &fields))
+	require.Len(fields, 4)
+	require.Contains(fields, "blockID")
+	require.NotContains(fields, "nodeID")
+	require.NotContains(fields, "parentID")
+}
+
+func TestDumpRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	blkID := ids.GenerateTestID()
+	dump := &Dump{
+		Version:  Version,
+		NodeID:   ids.GenerateTestNodeID(),
+		ChainID:  ids.GenerateTestID(),
+		SubnetID: ids.GenerateTestID(),
+		DumpedAt: time.Unix(2, 0).UTC(),
+		Events: []Event{
+			{
+				Time:      time.Unix(0, 1).UTC(),
+				Type:      PollStarted,
+				RequestID: 1,
+				BlockID:   blkID,
+				Height:    1,
+				NodeIDs:   []ids.NodeID{ids.GenerateTestNodeID()},
+			},
+			{
+				Time:      time.Unix(1, 0).UTC(),
+				Type:      PollFinished,
+				RequestID: 1,
+				Votes:     Votes(bag.Of(blkID, blkID)),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(WriteDump(&buf, dump))
+
+	readDump, err := ReadDump(&buf)
+	require.NoError(err)
+	require.Equal(dump, readDump)
+	require.Equal([]Vote{{BlockID: blkID, Votes: 2}}, readDump.Events[1].Votes)
+}
+
+func TestReadDumpUnsupportedVersion(t *testing.T) {
+	_, err := ReadDump(bytes.NewBufferString(`{"version":2}`))
+	require.ErrorIs(t, err, errUnsupportedVersion)
+}
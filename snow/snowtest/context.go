@@ -16,6 +16,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/database/memdb"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow"
+	"github.com/MetalBlockchain/metalgo/snow/recorder"
 	"github.com/MetalBlockchain/metalgo/snow/validators"
 	"github.com/MetalBlockchain/metalgo/snow/validators/validatorstest"
 	"github.com/MetalBlockchain/metalgo/upgrade/upgradetest"
@@ -50,6 +51,7 @@ func ConsensusContext(ctx *snow.Context) *snow.ConsensusContext {
 		BlockAcceptor:  noOpAcceptor{},
 		TxAcceptor:     noOpAcceptor{},
 		VertexAcceptor: noOpAcceptor{},
+		Recorder:       recorder.NoOp,
 	}
 }
 
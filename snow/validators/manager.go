@@ -104,6 +104,10 @@ type Manager interface {
 	// When a validator is added, removed, or its weight changes on [subnetID],
 	// the listener will be notified of the event.
 	RegisterSetCallbackListener(subnetID ids.ID, listener SetCallbackListener)
+
+	// UnregisterSetCallbackListener stops notifying a listener that was
+	// previously registered on [subnetID] of any further events.
+	UnregisterSetCallbackListener(subnetID ids.ID, listener SetCallbackListener)
 }
 
 // NewManager returns a new, empty manager
@@ -308,6 +312,17 @@ func (m *manager) RegisterSetCallbackListener(subnetID ids.ID, listener SetCallb
 	set.RegisterCallbackListener(listener)
 }
 
+func (m *manager) UnregisterSetCallbackListener(subnetID ids.ID, listener SetCallbackListener) {
+	m.lock.RLock()
+	set, exists := m.subnetToVdrs[subnetID]
+	m.lock.RUnlock()
+	if !exists {
+		return
+	}
+
+	set.UnregisterCallbackListener(listener)
+}
+
 func (m *manager) String() string {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
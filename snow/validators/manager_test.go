@@ -510,6 +510,32 @@ func TestAddCallback(t *testing.T) {
 	require.Equal(1, setCallCount)     // should not be called for expectedSubnetID1
 }
 
+func TestUnregisterSetCallback(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		subnetID     = ids.GenerateTestID()
+		m            = NewManager()
+		setCallCount = 0
+	)
+	listener := &setCallbackListener{
+		t: t,
+		onAdd: func(ids.NodeID, *bls.PublicKey, ids.ID, uint64) {
+			setCallCount++
+		},
+	}
+	m.RegisterSetCallbackListener(subnetID, listener)
+	require.NoError(m.AddStaker(subnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+	require.Equal(1, setCallCount)
+
+	m.UnregisterSetCallbackListener(subnetID, listener)
+	require.NoError(m.AddStaker(subnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+	require.Equal(1, setCallCount) // should not be called after unregistering
+
+	// Unregistering from a subnet without validators is a no-op.
+	m.UnregisterSetCallbackListener(ids.GenerateTestID(), listener)
+}
+
 func TestAddWeightCallback(t *testing.T) {
 	require := require.New(t)
 
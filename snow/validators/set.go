@@ -332,6 +332,15 @@ func (s *vdrSet) RegisterCallbackListener(callbackListener SetCallbackListener)
 	}
 }
 
+func (s *vdrSet) UnregisterCallbackListener(callbackListener SetCallbackListener) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.setCallbackListeners = slices.DeleteFunc(s.setCallbackListeners, func(l SetCallbackListener) bool {
+		return l == callbackListener
+	})
+}
+
 // Assumes [s.lock] is held
 func (s *vdrSet) callWeightChangeCallbacks(node ids.NodeID, oldWeight, newWeight uint64) {
 	for _, callbackListener := range s.managerCallbackListeners {
@@ -6,6 +6,7 @@ package subnets
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/MetalBlockchain/metalgo/ids"
@@ -51,6 +52,15 @@ type Config struct {
 	ProposerNumHistoricalBlocks uint64 `json:"proposerNumHistoricalBlocks" yaml:"proposerNumHistoricalBlocks"`
 }
 
+// Equal returns true if [c] and [other] describe the same subnet config.
+func (c *Config) Equal(other *Config) bool {
+	return c.ValidatorOnly == other.ValidatorOnly &&
+		c.AllowedNodes.Equals(other.AllowedNodes) &&
+		reflect.DeepEqual(c.ConsensusParameters, other.ConsensusParameters) &&
+		c.ProposerMinBlockDelay == other.ProposerMinBlockDelay &&
+		c.ProposerNumHistoricalBlocks == other.ProposerNumHistoricalBlocks
+}
+
 func (c *Config) Valid() error {
 	if err := c.ConsensusParameters.Verify(); err != nil {
 		return fmt.Errorf("consensus %w", err)
@@ -3,7 +3,11 @@
 
 package trace
 
-import "go.opentelemetry.io/otel/trace/noop"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace/noop"
+)
 
 var Noop Tracer = noOpTracer{}
 
@@ -15,3 +19,13 @@ type noOpTracer struct {
 func (noOpTracer) Close() error {
 	return nil
 }
+
+func (noOpTracer) SetSampleRate(float64) {}
+
+func (noOpTracer) TraceParent(context.Context) string {
+	return ""
+}
+
+func (noOpTracer) ContextWithTraceParent(ctx context.Context, _ string) context.Context {
+	return ctx
+}
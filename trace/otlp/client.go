@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+)
+
+const (
+	defaultGRPCEndpoint = "localhost:4317"
+	defaultHTTPEndpoint = "localhost:4318"
+
+	metricsPath = "/v1/metrics"
+	logsPath    = "/v1/logs"
+)
+
+var (
+	_ client = (*grpcClient)(nil)
+	_ client = (*httpClient)(nil)
+
+	errUnknownExporterType  = errors.New("unknown exporter type")
+	errUnexpectedStatusCode = errors.New("unexpected status code")
+)
+
+// client sends export requests to a collector.
+type client interface {
+	exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error
+	exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error
+	close() error
+}
+
+func newClient(config trace.ExporterConfig) (client, error) {
+	switch config.Type {
+	case trace.GRPC:
+		return newGRPCClient(config)
+	case trace.HTTP:
+		return newHTTPClient(config), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownExporterType, config.Type)
+	}
+}
+
+type grpcClient struct {
+	conn    *grpc.ClientConn
+	metrics colmetricspb.MetricsServiceClient
+	logs    collogspb.LogsServiceClient
+	headers metadata.MD
+}
+
+func newGRPCClient(config trace.ExporterConfig) (*grpcClient, error) {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultGRPCEndpoint
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+	})
+	if config.Insecure {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &grpcClient{
+		conn:    conn,
+		metrics: colmetricspb.NewMetricsServiceClient(conn),
+		logs:    collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(config.Headers),
+	}, nil
+}
+
+func (c *grpcClient) exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error {
+	_, err := c.metrics.Export(metadata.NewOutgoingContext(ctx, c.headers), request)
+	return err
+}
+
+func (c *grpcClient) exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error {
+	_, err := c.logs.Export(metadata.NewOutgoingContext(ctx, c.headers), request)
+	return err
+}
+
+func (c *grpcClient) close() error {
+	return c.conn.Close()
+}
+
+type httpClient struct {
+	client     *http.Client
+	metricsURL string
+	logsURL    string
+	headers    map[string]string
+}
+
+func newHTTPClient(config trace.ExporterConfig) *httpClient {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultHTTPEndpoint
+	}
+	scheme := "https://"
+	if config.Insecure {
+		scheme = "http://"
+	}
+	return &httpClient{
+		client:     &http.Client{},
+		metricsURL: scheme + endpoint + metricsPath,
+		logsURL:    scheme + endpoint + logsPath,
+		headers:    config.Headers,
+	}
+}
+
+func (c *httpClient) exportMetrics(ctx context.Context, request *colmetricspb.ExportMetricsServiceRequest) error {
+	return c.post(ctx, c.metricsURL, request)
+}
+
+func (c *httpClient) exportLogs(ctx context.Context, request *collogspb.ExportLogsServiceRequest) error {
+	return c.post(ctx, c.logsURL, request)
+}
+
+func (c *httpClient) post(ctx context.Context, url string, request proto.Message) error {
+	body, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-protobuf")
+	for key, value := range c.headers {
+		httpRequest.Header.Set(key, value)
+	}
+
+	response, err := c.client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %d", errUnexpectedStatusCode, response.StatusCode)
+	}
+	return nil
+}
+
+func (c *httpClient) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package otlp pushes the node's metrics and log records to an OpenTelemetry
+// collector, using the same exporter settings as the tracer.
+package otlp
+
+import (
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/trace"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+type Config struct {
+	trace.ExporterConfig `json:"exporterConfig"`
+
+	// If true, the gathered metrics are pushed every [MetricsFrequency].
+	MetricsEnabled   bool          `json:"metricsEnabled"`
+	MetricsFrequency time.Duration `json:"metricsFrequency"`
+
+	// If true, the records written to the log files are also pushed.
+	LogsEnabled bool `json:"logsEnabled"`
+
+	AppName string `json:"appName"`
+	Version string `json:"version"`
+}
+
+// newResource returns the resource the pushed data is attributed to. It
+// matches the resource of the spans exported by the tracer.
+func newResource(config Config) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{
+			stringKeyValue("service.name", config.AppName),
+			stringKeyValue("version", config.Version),
+		},
+	}
+}
+
+func stringKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{
+				StringValue: value,
+			},
+		},
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const (
+	logQueueSize     = 4096
+	logBatchSize     = 512
+	logBatchInterval = time.Second
+)
+
+var _ logging.Exporter = (*LogExporter)(nil)
+
+// LogExporter pushes log records to a collector in batches. If the collector
+// can't keep up, records are dropped.
+//
+// Failures to push records aren't logged, as logging them would produce more
+// records to push.
+type LogExporter struct {
+	client   client
+	resource *resourcepb.Resource
+	records  chan *logspb.LogRecord
+
+	closeOnce sync.Once
+	closer    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewLogExporter returns an exporter that pushes the records it is given
+// until it is closed.
+func NewLogExporter(config Config) (*LogExporter, error) {
+	client, err := newClient(config.ExporterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &LogExporter{
+		client:   client,
+		resource: newResource(config),
+		records:  make(chan *logspb.LogRecord, logQueueSize),
+		closer:   make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.dispatch()
+	return e, nil
+}
+
+func (e *LogExporter) Export(loggerName string, entry zapcore.Entry, fields []zapcore.Field) {
+	select {
+	case e.records <- newLogRecord(loggerName, entry, fields):
+	default:
+	}
+}
+
+// Close pushes the queued records and stops pushing records.
+func (e *LogExporter) Close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.closer)
+		e.wg.Wait()
+		err = e.client.close()
+	})
+	return err
+}
+
+func (e *LogExporter) dispatch() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(logBatchInterval)
+	defer ticker.Stop()
+
+	batch := make([]*logspb.LogRecord, 0, logBatchSize)
+	for {
+		select {
+		case record := <-e.records:
+			batch = append(batch, record)
+			if len(batch) < logBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-e.closer:
+			for {
+				select {
+				case record := <-e.records:
+					batch = append(batch, record)
+				default:
+					e.export(batch)
+					return
+				}
+			}
+		}
+
+		e.export(batch)
+		batch = batch[:0]
+	}
+}
+
+func (e *LogExporter) export(records []*logspb.LogRecord) {
+	if len(records) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	_ = e.client.exportLogs(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: e.resource,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	})
+}
+
+func newLogRecord(loggerName string, entry zapcore.Entry, fields []zapcore.Field) *logspb.LogRecord {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+
+	attributes := make([]*commonpb.KeyValue, 0, len(encoder.Fields)+3)
+	attributes = append(attributes, stringKeyValue("logger", loggerName))
+	if entry.Caller.Defined {
+		attributes = append(attributes, stringKeyValue("caller", entry.Caller.TrimmedPath()))
+	}
+	if entry.Stack != "" {
+		attributes = append(attributes, stringKeyValue("stacktrace", entry.Stack))
+	}
+	for _, key := range slices.Sorted(maps.Keys(encoder.Fields)) {
+		attributes = append(attributes, &commonpb.KeyValue{
+			Key:   key,
+			Value: toAnyValue(encoder.Fields[key]),
+		})
+	}
+
+	level := logging.Level(entry.Level)
+	return &logspb.LogRecord{
+		TimeUnixNano:   uint64(entry.Time.UnixNano()),
+		SeverityNumber: severityNumber(level),
+		SeverityText:   level.String(),
+		Body: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{
+				StringValue: entry.Message,
+			},
+		},
+		Attributes: attributes,
+	}
+}
+
+func severityNumber(level logging.Level) logspb.SeverityNumber {
+	switch {
+	case level <= logging.Verbo:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE
+	case level == logging.Debug:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case level == logging.Trace:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG2
+	case level == logging.Info:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	case level == logging.Warn:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case level == logging.Error:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL
+	}
+}
+
+// toAnyValue converts a value produced by [zapcore.MapObjectEncoder].
+func toAnyValue(value interface{}) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int8:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int16:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint8:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case uint16:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case uint32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case uint, uint64, uintptr:
+		// Unsigned 64-bit values may not fit in an int64.
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	case float32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case []byte:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: v}}
+	case time.Time:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Format(time.RFC3339Nano)}}
+	case time.Duration:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.String()}}
+	case []interface{}:
+		values := make([]*commonpb.AnyValue, len(v))
+		for i, elem := range v {
+			values[i] = toAnyValue(elem)
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}
+	case map[string]interface{}:
+		values := make([]*commonpb.KeyValue, 0, len(v))
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			values = append(values, &commonpb.KeyValue{
+				Key:   key,
+				Value: toAnyValue(v[key]),
+			})
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: values}}}
+	case fmt.Stringer:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.String()}}
+	default:
+		// Values added with [zap.Reflect] are logged as JSON.
+		if b, err := json.Marshal(v); err == nil {
+			return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(b)}}
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package otlp
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/trace"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+func TestNewLogRecord(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1_700_000_000, 0)
+	record := newLogRecord(
+		"main",
+		zapcore.Entry{
+			Level:   zapcore.Level(logging.Warn),
+			Time:    now,
+			Message: "message",
+		},
+		[]zapcore.Field{
+			zap.Stringer("nodeID", ids.EmptyNodeID),
+			zap.Uint32("height", 5),
+			zap.Error(errors.New("error")),
+		},
+	)
+	require.True(proto.Equal(
+		&logspb.LogRecord{
+			TimeUnixNano:   uint64(now.UnixNano()),
+			SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+			SeverityText:   "WARN",
+			Body: &commonpb.AnyValue{
+				Value: &commonpb.AnyValue_StringValue{
+					StringValue: "message",
+				},
+			},
+			Attributes: []*commonpb.KeyValue{
+				stringKeyValue("logger", "main"),
+				stringKeyValue("error", "error"),
+				{
+					Key: "height",
+					Value: &commonpb.AnyValue{
+						Value: &commonpb.AnyValue_IntValue{
+							IntValue: 5,
+						},
+					},
+				},
+				stringKeyValue("nodeID", ids.EmptyNodeID.String()),
+			},
+		},
+		record,
+	))
+}
+
+func TestLogExporterHTTP(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		lock    sync.Mutex
+		records []*logspb.LogRecord
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		require.Equal(logsPath, r.URL.Path)
+
+		request := &collogspb.ExportLogsServiceRequest{}
+		require.NoError(proto.Unmarshal(body, request))
+
+		lock.Lock()
+		defer lock.Unlock()
+		for _, resourceLogs := range request.ResourceLogs {
+			for _, scopeLogs := range resourceLogs.ScopeLogs {
+				records = append(records, scopeLogs.LogRecords...)
+			}
+		}
+	}))
+	defer server.Close()
+
+	exporter, err := NewLogExporter(Config{
+		ExporterConfig: trace.ExporterConfig{
+			Type:     trace.HTTP,
+			Endpoint: strings.TrimPrefix(server.URL, "http://"),
+			Insecure: true,
+		},
+		LogsEnabled: true,
+	})
+	require.NoError(err)
+
+	factory := logging.NewFactory(logging.Config{
+		RotatingWriterConfig: logging.RotatingWriterConfig{
+			Directory: t.TempDir(),
+		},
+		LogLevel:     logging.Info,
+		DisplayLevel: logging.Off,
+		Exporter:     exporter,
+	})
+	log, err := factory.Make("test")
+	require.NoError(err)
+	log.Info("first")
+	log.Debug("not exported")
+	log.Error("second")
+	factory.Close()
+
+	// Closing the exporter pushes the queued records.
+	require.NoError(exporter.Close())
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(records, 2)
+	require.Equal("first", records[0].Body.GetStringValue())
+	require.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_INFO, records[0].SeverityNumber)
+	require.Equal("second", records[1].Body.GetStringValue())
+	require.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, records[1].SeverityNumber)
+}
@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package otlp
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+
+	dto "github.com/prometheus/client_model/go"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const exportTimeout = 10 * time.Second
+
+// MetricExporter periodically pushes the metrics of a gatherer to a
+// collector.
+//
+// Counters, histograms and summaries are pushed with cumulative temporality,
+// starting at the time the exporter was created.
+type MetricExporter struct {
+	log       logging.Logger
+	client    client
+	gatherer  prometheus.Gatherer
+	resource  *resourcepb.Resource
+	startTime time.Time
+
+	closeOnce sync.Once
+	closer    chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewMetricExporter returns an exporter that pushes the metrics of [gatherer]
+// every [config.MetricsFrequency] until it is closed.
+func NewMetricExporter(
+	log logging.Logger,
+	config Config,
+	gatherer prometheus.Gatherer,
+) (*MetricExporter, error) {
+	client, err := newClient(config.ExporterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &MetricExporter{
+		log:       log,
+		client:    client,
+		gatherer:  gatherer,
+		resource:  newResource(config),
+		startTime: time.Now(),
+		closer:    make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.dispatch(config.MetricsFrequency)
+	return e, nil
+}
+
+// Close pushes the metrics one last time and stops pushing them.
+func (e *MetricExporter) Close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.closer)
+		e.wg.Wait()
+		e.export()
+		err = e.client.close()
+	})
+	return err
+}
+
+func (e *MetricExporter) dispatch(frequency time.Duration) {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.export()
+		case <-e.closer:
+			return
+		}
+	}
+}
+
+func (e *MetricExporter) export() {
+	// Gather returns the metrics it could gather along with the error, so the
+	// metrics are pushed even if some of them couldn't be gathered.
+	families, err := e.gatherer.Gather()
+	if err != nil {
+		e.log.Warn("failed to gather metrics to push",
+			zap.Error(err),
+		)
+	}
+	if len(families) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	request := newExportMetricsRequest(e.resource, families, e.startTime, time.Now())
+	if err := e.client.exportMetrics(ctx, request); err != nil {
+		e.log.Warn("failed to push metrics",
+			zap.Error(err),
+		)
+	}
+}
+
+func newExportMetricsRequest(
+	resource *resourcepb.Resource,
+	families []*dto.MetricFamily,
+	startTime time.Time,
+	now time.Time,
+) *colmetricspb.ExportMetricsServiceRequest {
+	var (
+		start   = uint64(startTime.UnixNano())
+		metrics = make([]*metricspb.Metric, 0, len(families))
+	)
+	for _, family := range families {
+		if metric := convertMetricFamily(family, start, now); metric != nil {
+			metrics = append(metrics, metric)
+		}
+	}
+	return &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: resource,
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	}
+}
+
+// convertMetricFamily returns the OTLP representation of [family], or nil if
+// the type of [family] isn't supported.
+func convertMetricFamily(family *dto.MetricFamily, start uint64, now time.Time) *metricspb.Metric {
+	metric := &metricspb.Metric{
+		Name:        family.GetName(),
+		Description: family.GetHelp(),
+	}
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		dataPoints := make([]*metricspb.NumberDataPoint, len(family.Metric))
+		for i, m := range family.Metric {
+			dataPoints[i] = newNumberDataPoint(m, m.GetCounter().GetValue(), start, now)
+		}
+		metric.Data = &metricspb.Metric_Sum{
+			Sum: &metricspb.Sum{
+				DataPoints:             dataPoints,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			},
+		}
+	case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		dataPoints := make([]*metricspb.NumberDataPoint, len(family.Metric))
+		for i, m := range family.Metric {
+			value := m.GetGauge().GetValue()
+			if m.Untyped != nil {
+				value = m.GetUntyped().GetValue()
+			}
+			// Gauges don't have a start time.
+			dataPoints[i] = newNumberDataPoint(m, value, 0, now)
+		}
+		metric.Data = &metricspb.Metric_Gauge{
+			Gauge: &metricspb.Gauge{
+				DataPoints: dataPoints,
+			},
+		}
+	case dto.MetricType_HISTOGRAM:
+		dataPoints := make([]*metricspb.HistogramDataPoint, len(family.Metric))
+		for i, m := range family.Metric {
+			dataPoints[i] = newHistogramDataPoint(m, start, now)
+		}
+		metric.Data = &metricspb.Metric_Histogram{
+			Histogram: &metricspb.Histogram{
+				DataPoints:             dataPoints,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			},
+		}
+	case dto.MetricType_SUMMARY:
+		dataPoints := make([]*metricspb.SummaryDataPoint, len(family.Metric))
+		for i, m := range family.Metric {
+			dataPoints[i] = newSummaryDataPoint(m, start, now)
+		}
+		metric.Data = &metricspb.Metric_Summary{
+			Summary: &metricspb.Summary{
+				DataPoints: dataPoints,
+			},
+		}
+	default:
+		return nil
+	}
+	return metric
+}
+
+func newNumberDataPoint(m *dto.Metric, value float64, start uint64, now time.Time) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		Attributes:        labelsToAttributes(m.Label),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(m, now),
+		Value: &metricspb.NumberDataPoint_AsDouble{
+			AsDouble: value,
+		},
+	}
+}
+
+func newHistogramDataPoint(m *dto.Metric, start uint64, now time.Time) *metricspb.HistogramDataPoint {
+	var (
+		histogram  = m.GetHistogram()
+		numBuckets = len(histogram.Bucket)
+		bounds     = make([]float64, 0, numBuckets)
+		counts     = make([]uint64, 0, numBuckets+1)
+		prevCount  uint64
+	)
+	// Prometheus buckets are cumulative while OTLP buckets aren't. OTLP has an
+	// implicit +Inf bucket, which holds the observations that aren't in any
+	// of the Prometheus buckets with a finite upper bound.
+	for _, bucket := range histogram.Bucket {
+		upperBound := bucket.GetUpperBound()
+		if math.IsInf(upperBound, 1) {
+			continue
+		}
+		count := bucket.GetCumulativeCount()
+		bounds = append(bounds, upperBound)
+		counts = append(counts, count-prevCount)
+		prevCount = count
+	}
+	counts = append(counts, histogram.GetSampleCount()-prevCount)
+
+	sum := histogram.GetSampleSum()
+	return &metricspb.HistogramDataPoint{
+		Attributes:        labelsToAttributes(m.Label),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(m, now),
+		Count:             histogram.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func newSummaryDataPoint(m *dto.Metric, start uint64, now time.Time) *metricspb.SummaryDataPoint {
+	summary := m.GetSummary()
+	quantiles := make([]*metricspb.SummaryDataPoint_ValueAtQuantile, len(summary.Quantile))
+	for i, quantile := range summary.Quantile {
+		quantiles[i] = &metricspb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: quantile.GetQuantile(),
+			Value:    quantile.GetValue(),
+		}
+	}
+	return &metricspb.SummaryDataPoint{
+		Attributes:        labelsToAttributes(m.Label),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      timestamp(m, now),
+		Count:             summary.GetSampleCount(),
+		Sum:               summary.GetSampleSum(),
+		QuantileValues:    quantiles,
+	}
+}
+
+func labelsToAttributes(labels []*dto.LabelPair) []*commonpb.KeyValue {
+	attributes := make([]*commonpb.KeyValue, len(labels))
+	for i, label := range labels {
+		attributes[i] = stringKeyValue(label.GetName(), label.GetValue())
+	}
+	return attributes
+}
+
+// timestamp returns the time [m] was observed at, which defaults to [now].
+func timestamp(m *dto.Metric, now time.Time) uint64 {
+	if m.TimestampMs != nil {
+		return uint64(time.UnixMilli(m.GetTimestampMs()).UnixNano())
+	}
+	return uint64(now.UnixNano())
+}
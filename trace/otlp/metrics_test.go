@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package otlp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlockchain/metalgo/trace"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func TestConvertHistogram(t *testing.T) {
+	require := require.New(t)
+
+	registry := prometheus.NewRegistry()
+	histogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "latency",
+			Help:    "help",
+			Buckets: []float64{1, 2},
+		},
+		[]string{"label"},
+	)
+	require.NoError(registry.Register(histogram))
+	for _, value := range []float64{0.5, 1.5, 1.5, 3} {
+		histogram.WithLabelValues("value").Observe(value)
+	}
+
+	families, err := registry.Gather()
+	require.NoError(err)
+	require.Len(families, 1)
+
+	var (
+		start = uint64(1)
+		now   = time.Unix(0, 2)
+		sum   = 6.5
+	)
+	require.True(proto.Equal(
+		&metricspb.Metric{
+			Name:        "latency",
+			Description: "help",
+			Data: &metricspb.Metric_Histogram{
+				Histogram: &metricspb.Histogram{
+					DataPoints: []*metricspb.HistogramDataPoint{
+						{
+							Attributes:        labelsToAttributes(families[0].Metric[0].Label),
+							StartTimeUnixNano: start,
+							TimeUnixNano:      2,
+							Count:             4,
+							Sum:               &sum,
+							BucketCounts:      []uint64{1, 2, 1},
+							ExplicitBounds:    []float64{1, 2},
+						},
+					},
+					AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				},
+			},
+		},
+		convertMetricFamily(families[0], start, now),
+	))
+}
+
+func TestMetricExporterHTTP(t *testing.T) {
+	require := require.New(t)
+
+	requests := make(chan *colmetricspb.ExportMetricsServiceRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		require.Equal(metricsPath, r.URL.Path)
+		require.Equal("application/x-protobuf", r.Header.Get("Content-Type"))
+		require.Equal("value", r.Header.Get("Key"))
+
+		request := &colmetricspb.ExportMetricsServiceRequest{}
+		require.NoError(proto.Unmarshal(body, request))
+		requests <- request
+	}))
+	defer server.Close()
+
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "counter",
+		Help: "help",
+	})
+	require.NoError(registry.Register(counter))
+	counter.Add(3)
+
+	exporter, err := NewMetricExporter(
+		logging.NoLog{},
+		Config{
+			ExporterConfig: trace.ExporterConfig{
+				Type:     trace.HTTP,
+				Endpoint: strings.TrimPrefix(server.URL, "http://"),
+				Headers: map[string]string{
+					"key": "value",
+				},
+				Insecure: true,
+			},
+			MetricsEnabled:   true,
+			MetricsFrequency: time.Hour,
+			AppName:          "app",
+			Version:          "version",
+		},
+		registry,
+	)
+	require.NoError(err)
+
+	// Closing the exporter pushes the metrics one last time.
+	require.NoError(exporter.Close())
+
+	request := <-requests
+	require.Len(request.ResourceMetrics, 1)
+	resourceMetrics := request.ResourceMetrics[0]
+	require.True(proto.Equal(
+		newResource(Config{AppName: "app", Version: "version"}),
+		resourceMetrics.Resource,
+	))
+	require.Len(resourceMetrics.ScopeMetrics, 1)
+	metrics := resourceMetrics.ScopeMetrics[0].Metrics
+	require.Len(metrics, 1)
+	require.Equal("counter", metrics[0].Name)
+	sum := metrics[0].GetSum()
+	require.NotNil(sum)
+	require.True(sum.IsMonotonic)
+	require.Len(sum.DataPoints, 1)
+	require.Equal(3.0, sum.DataPoints[0].GetAsDouble())
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceParentHeader = "traceparent"
+
+var propagator propagation.TraceContext
+
+// traceParent returns the W3C traceparent of the span in [ctx]. Returns an
+// empty string if [ctx] doesn't contain a sampled span, so that only sampled
+// traces are propagated to other nodes.
+func traceParent(ctx context.Context) string {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return ""
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get(traceParentHeader)
+}
+
+// contextWithTraceParent returns a copy of [ctx] whose span is the remote span
+// described by the W3C [traceParent]. If [traceParent] is empty or invalid,
+// [ctx] is returned.
+func contextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, propagation.MapCarrier{
+		traceParentHeader: traceParent,
+	})
+}
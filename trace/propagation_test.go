@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceParent(t *testing.T) {
+	require := require.New(t)
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	encoded := traceParent(ctx)
+	require.Equal("00-01000000000000000000000000000000-0200000000000000-01", encoded)
+
+	remoteCtx := contextWithTraceParent(context.Background(), encoded)
+	require.Equal(
+		spanContext.WithRemote(true),
+		trace.SpanContextFromContext(remoteCtx),
+	)
+}
+
+func TestTraceParentNotSampled(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+	require.Empty(t, traceParent(ctx))
+	require.Empty(t, traceParent(context.Background()))
+}
+
+func TestContextWithInvalidTraceParent(t *testing.T) {
+	for _, encoded := range []string{"", "invalid"} {
+		ctx := contextWithTraceParent(context.Background(), encoded)
+		require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var _ sdktrace.Sampler = (*ratioSampler)(nil)
+
+// ratioSampler samples a fraction of traces based on their trace ID. The
+// fraction may be changed after the sampler is created.
+type ratioSampler struct {
+	lock    sync.RWMutex
+	sampler sdktrace.Sampler
+}
+
+func newRatioSampler(fraction float64) *ratioSampler {
+	return &ratioSampler{
+		sampler: sdktrace.TraceIDRatioBased(fraction),
+	}
+}
+
+func (s *ratioSampler) setFraction(fraction float64) {
+	sampler := sdktrace.TraceIDRatioBased(fraction)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sampler = sampler
+}
+
+func (s *ratioSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	// Spans whose parent was sampled by another node are always sampled, so
+	// that a trace propagated across nodes isn't missing spans.
+	if parent := trace.SpanContextFromContext(p.ParentContext); parent.IsRemote() && parent.IsSampled() {
+		return sdktrace.SamplingResult{
+			Decision:   sdktrace.RecordAndSample,
+			Tracestate: parent.TraceState(),
+		}
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sampler.ShouldSample(p)
+}
+
+func (s *ratioSampler) Description() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sampler.Description()
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestRatioSamplerSetFraction(t *testing.T) {
+	require := require.New(t)
+
+	params := sdktrace.SamplingParameters{
+		TraceID: trace.TraceID{1},
+	}
+	sampler := newRatioSampler(0)
+	require.Equal(sdktrace.Drop, sampler.ShouldSample(params).Decision)
+
+	sampler.setFraction(1)
+	require.Equal(sdktrace.RecordAndSample, sampler.ShouldSample(params).Decision)
+	require.Equal(sdktrace.TraceIDRatioBased(1).Description(), sampler.Description())
+}
+
+func TestRatioSamplerRemoteParent(t *testing.T) {
+	require := require.New(t)
+
+	sampler := newRatioSampler(0)
+	for _, test := range []struct {
+		flags    trace.TraceFlags
+		remote   bool
+		expected sdktrace.SamplingDecision
+	}{
+		{
+			flags:    trace.FlagsSampled,
+			remote:   true,
+			expected: sdktrace.RecordAndSample,
+		},
+		{
+			remote:   true,
+			expected: sdktrace.Drop,
+		},
+		{
+			flags:    trace.FlagsSampled,
+			expected: sdktrace.Drop,
+		},
+	} {
+		parent := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: test.flags,
+			Remote:     test.remote,
+		})
+		params := sdktrace.SamplingParameters{
+			ParentContext: trace.ContextWithSpanContext(context.Background(), parent),
+			TraceID:       parent.TraceID(),
+		}
+		require.Equal(test.expected, sampler.ShouldSample(params).Decision)
+	}
+}
@@ -31,6 +31,14 @@ type Config struct {
 	// If <= 0 never samples.
 	TraceSampleRate float64 `json:"traceSampleRate"`
 
+	// If true, the trace context of sampled AppRequests is sent to the
+	// requested nodes and the trace context received in AppRequests is used
+	// as the parent of the spans created while handling them.
+	PropagateContext bool `json:"propagateContext"`
+
+	// If true, calls to the node's databases are traced.
+	DatabaseEnabled bool `json:"databaseEnabled"`
+
 	AppName string `json:"appName"`
 	Version string `json:"version"`
 }
@@ -38,12 +46,46 @@ type Config struct {
 type Tracer interface {
 	trace.Tracer
 	io.Closer
+
+	// SetSampleRate updates the fraction of traces to sample.
+	SetSampleRate(sampleRate float64)
+
+	// TraceParent returns the W3C traceparent of the span in [ctx] to send to
+	// other nodes. Returns an empty string if trace contexts aren't
+	// propagated or if the span wasn't sampled.
+	TraceParent(ctx context.Context) string
+
+	// ContextWithTraceParent returns a copy of [ctx] whose span is the remote
+	// span described by the W3C [traceParent] received from another node. If
+	// trace contexts aren't propagated or [traceParent] is invalid, [ctx] is
+	// returned.
+	ContextWithTraceParent(ctx context.Context, traceParent string) context.Context
 }
 
 type tracer struct {
 	trace.Tracer
 
-	tp *sdktrace.TracerProvider
+	tp               *sdktrace.TracerProvider
+	sampler          *ratioSampler
+	propagateContext bool
+}
+
+func (t *tracer) SetSampleRate(sampleRate float64) {
+	t.sampler.setFraction(sampleRate)
+}
+
+func (t *tracer) TraceParent(ctx context.Context) string {
+	if !t.propagateContext {
+		return ""
+	}
+	return traceParent(ctx)
+}
+
+func (t *tracer) ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if !t.propagateContext {
+		return ctx
+	}
+	return contextWithTraceParent(ctx, traceParent)
 }
 
 func (t *tracer) Close() error {
@@ -62,18 +104,21 @@ func New(config Config) (Tracer, error) {
 		return nil, err
 	}
 
+	sampler := newRatioSampler(config.TraceSampleRate)
 	tracerProviderOpts := []sdktrace.TracerProviderOption{
 		sdktrace.WithBatcher(exporter, sdktrace.WithExportTimeout(tracerExportTimeout)),
 		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
 			attribute.String("version", config.Version),
 			semconv.ServiceNameKey.String(config.AppName),
 		)),
-		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(config.TraceSampleRate)),
+		sdktrace.WithSampler(sampler),
 	}
 
 	tracerProvider := sdktrace.NewTracerProvider(tracerProviderOpts...)
 	return &tracer{
-		Tracer: tracerProvider.Tracer(config.AppName),
-		tp:     tracerProvider,
+		Tracer:           tracerProvider.Tracer(config.AppName),
+		tp:               tracerProvider,
+		sampler:          sampler,
+		propagateContext: config.PropagateContext,
 	}, nil
 }
@@ -71,6 +71,7 @@ const (
 	DefaultConsensusAppConcurrency  = 2
 	DefaultConsensusShutdownTimeout = time.Minute
 	DefaultFrontierPollFrequency    = 100 * time.Millisecond
+	DefaultConsensusRecorderSize    = 4096
 
 	// Inbound Throttling
 	DefaultInboundThrottlerAtLargeAllocSize         = 6 * units.MiB
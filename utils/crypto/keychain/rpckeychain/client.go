@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpckeychain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+
+	pb "github.com/MetalBlockchain/metalgo/proto/pb/keychain"
+)
+
+var (
+	_ keychain.Keychain = (*Client)(nil)
+	_ keychain.Signer   = (*signer)(nil)
+
+	ErrInvalidSignature = errors.New("signature was not produced by the requested address")
+)
+
+// Client is a keychain whose keys are held by a remote signing service.
+type Client struct {
+	client pb.KeychainClient
+	addrs  set.Set[ids.ShortID]
+	// grpc.ClientConn handles transient connection errors.
+	connection *grpc.ClientConn
+}
+
+func NewClient(ctx context.Context, url string) (*Client, error) {
+	opts := grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff: backoff.DefaultConfig,
+		// same as grpc default
+		MinConnectTimeout: 20 * time.Second,
+	})
+
+	// the rpc-keychain client should call a proxy server (on the same machine)
+	// that forwards the request to the actual signer instead of relying on
+	// tls-credentials
+	conn, err := grpc.NewClient(url, opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc keychain client: %w", err)
+	}
+
+	client := pb.NewKeychainClient(conn)
+	addrs, err := fetchAddresses(ctx, client)
+	if err != nil {
+		return nil, errors.Join(err, conn.Close())
+	}
+
+	return &Client{
+		client:     client,
+		addrs:      addrs,
+		connection: conn,
+	}, nil
+}
+
+func fetchAddresses(ctx context.Context, client pb.KeychainClient) (set.Set[ids.ShortID], error) {
+	resp, err := client.Addresses(ctx, &pb.AddressesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addresses: %w", err)
+	}
+
+	addrBytes := resp.GetAddresses()
+	addrs := set.NewSet[ids.ShortID](len(addrBytes))
+	for _, b := range addrBytes {
+		addr, err := ids.ToShortID(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse address: %w", err)
+		}
+		addrs.Add(addr)
+	}
+	return addrs, nil
+}
+
+func (c *Client) Addresses() set.Set[ids.ShortID] {
+	return c.addrs
+}
+
+func (c *Client) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	if !c.addrs.Contains(addr) {
+		return nil, false
+	}
+	return &signer{
+		client: c.client,
+		addr:   addr,
+	}, true
+}
+
+func (c *Client) Shutdown() error {
+	if err := c.connection.Close(); err != nil {
+		return fmt.Errorf("failed to close connection: %w", err)
+	}
+
+	return nil
+}
+
+// signer signs with a single address of the remote keychain. Returned
+// signatures are verified to have been produced by the requested address so
+// that a misbehaving service can't cause invalid transactions to be issued.
+type signer struct {
+	client pb.KeychainClient
+	addr   ids.ShortID
+}
+
+// expects to receive a hash of the unsigned tx bytes
+func (s *signer) SignHash(hash []byte) ([]byte, error) {
+	resp, err := s.client.SignHash(context.TODO(), &pb.SignHashRequest{
+		Address: s.addr[:],
+		Hash:    hash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign hash: %w", err)
+	}
+
+	sig := resp.GetSignature()
+	pk, err := secp256k1.RecoverPublicKeyFromHash(hash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if err := s.verify(pk); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// expects to receive the unsigned tx bytes
+func (s *signer) Sign(msg []byte) ([]byte, error) {
+	resp, err := s.client.Sign(context.TODO(), &pb.SignRequest{
+		Address: s.addr[:],
+		Message: msg,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	sig := resp.GetSignature()
+	pk, err := secp256k1.RecoverPublicKey(msg, sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if err := s.verify(pk); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (s *signer) Address() ids.ShortID {
+	return s.addr
+}
+
+func (s *signer) verify(pk *secp256k1.PublicKey) error {
+	if addr := pk.Address(); addr != s.addr {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidSignature, s.addr, addr)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpckeychain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/hashing"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+
+	pb "github.com/MetalBlockchain/metalgo/proto/pb/keychain"
+)
+
+var msg = []byte("hello world")
+
+// newClient returns a client backed by a server holding the keys of [kc], without
+// going over the network.
+func newClient(t *testing.T, kc keychain.Keychain) *Client {
+	client := &stubClient{
+		server: NewServer(kc),
+	}
+	addrs, err := fetchAddresses(context.Background(), client)
+	require.NoError(t, err)
+	return &Client{
+		client: client,
+		addrs:  addrs,
+	}
+}
+
+func TestClientSign(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	client := newClient(t, &testKeychain{key: key})
+	require.Equal(set.Of(key.Address()), client.Addresses())
+
+	_, ok := client.Get(ids.GenerateTestShortID())
+	require.False(ok)
+
+	signer, ok := client.Get(key.Address())
+	require.True(ok)
+	require.Equal(key.Address(), signer.Address())
+
+	sig, err := signer.Sign(msg)
+	require.NoError(err)
+	require.True(key.PublicKey().Verify(msg, sig))
+
+	hash := hashing.ComputeHash256(msg)
+	sig, err = signer.SignHash(hash)
+	require.NoError(err)
+	require.True(key.PublicKey().VerifyHash(hash, sig))
+}
+
+func TestClientRejectsSignatureFromWrongKey(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	otherKey, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	// The server claims to hold [key] but signs with [otherKey].
+	client := newClient(t, &testKeychain{
+		key:    key,
+		signer: otherKey,
+	})
+	signer, ok := client.Get(key.Address())
+	require.True(ok)
+
+	_, err = signer.Sign(msg)
+	require.ErrorIs(err, ErrInvalidSignature)
+
+	_, err = signer.SignHash(hashing.ComputeHash256(msg))
+	require.ErrorIs(err, ErrInvalidSignature)
+}
+
+func TestServerUnknownAddress(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	addr := ids.GenerateTestShortID()
+	server := NewServer(&testKeychain{key: key})
+	_, err = server.Sign(context.Background(), &pb.SignRequest{
+		Address: addr[:],
+		Message: msg,
+	})
+	require.ErrorIs(err, ErrUnknownAddress)
+}
+
+// testKeychain holds [key] and signs with [signer] if provided.
+type testKeychain struct {
+	key    *secp256k1.PrivateKey
+	signer *secp256k1.PrivateKey
+}
+
+func (kc *testKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	if addr != kc.key.Address() {
+		return nil, false
+	}
+	if kc.signer != nil {
+		return &wrongSigner{
+			PrivateKey: kc.signer,
+			addr:       addr,
+		}, true
+	}
+	return kc.key, true
+}
+
+func (kc *testKeychain) Addresses() set.Set[ids.ShortID] {
+	return set.Of(kc.key.Address())
+}
+
+type wrongSigner struct {
+	*secp256k1.PrivateKey
+	addr ids.ShortID
+}
+
+func (s *wrongSigner) Address() ids.ShortID {
+	return s.addr
+}
+
+type stubClient struct {
+	server *Server
+}
+
+func (s *stubClient) Addresses(ctx context.Context, in *pb.AddressesRequest, _ ...grpc.CallOption) (*pb.AddressesResponse, error) {
+	return s.server.Addresses(ctx, in)
+}
+
+func (s *stubClient) Sign(ctx context.Context, in *pb.SignRequest, _ ...grpc.CallOption) (*pb.SignResponse, error) {
+	return s.server.Sign(ctx, in)
+}
+
+func (s *stubClient) SignHash(ctx context.Context, in *pb.SignHashRequest, _ ...grpc.CallOption) (*pb.SignHashResponse, error) {
+	return s.server.SignHash(ctx, in)
+}
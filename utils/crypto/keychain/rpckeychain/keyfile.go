@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpckeychain
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+)
+
+const (
+	saltLen = 16
+
+	// argon2id parameters, matching the ones used to hash passwords in
+	// utils/password.
+	argonTime    = 1
+	argonMemory  = 64 * 1024
+	argonThreads = 4
+)
+
+var ErrIncorrectPassword = errors.New("incorrect password or corrupted key file")
+
+// encryptedKeys is the format of a key file. The plaintext is the JSON
+// encoding of the private keys.
+type encryptedKeys struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptKeys returns the contents of a key file that holds [keys] encrypted
+// with a key derived from [password].
+func EncryptKeys(password string, keys []*secp256k1.PrivateKey) ([]byte, error) {
+	plaintext, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(&encryptedKeys{
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+}
+
+// DecryptKeys returns the keys held by the key file [b], which must have been
+// produced by [EncryptKeys] with the same [password].
+func DecryptKeys(password string, b []byte) ([]*secp256k1.PrivateKey, error) {
+	var encrypted encryptedKeys
+	if err := json.Unmarshal(b, &encrypted); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+
+	aead, err := newAEAD(password, encrypted.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted.Nonce) != aead.NonceSize() {
+		return nil, ErrIncorrectPassword
+	}
+	plaintext, err := aead.Open(nil, encrypted.Nonce, encrypted.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrIncorrectPassword
+	}
+
+	var keys []*secp256k1.PrivateKey
+	if err := json.Unmarshal(plaintext, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse keys: %w", err)
+	}
+	return keys, nil
+}
+
+// ReadKeyFile reads the "PrivateKey-" prefixed keys stored in plaintext, one
+// per line, in [path]. Empty lines and lines starting with "#" are ignored.
+func ReadKeyFile(path string) ([]*secp256k1.PrivateKey, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		keys    []*secp256k1.PrivateKey
+		scanner = bufio.NewScanner(f)
+	)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key := &secp256k1.PrivateKey{}
+		if err := key.UnmarshalText([]byte(`"` + line + `"`)); err != nil {
+			return nil, fmt.Errorf("failed to parse key in %q: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, scanner.Err()
+}
+
+func newAEAD(password string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, chacha20poly1305.KeySize)
+	return chacha20poly1305.NewX(key)
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpckeychain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+)
+
+func TestEncryptDecryptKeys(t *testing.T) {
+	require := require.New(t)
+
+	key0, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	key1, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	keys := []*secp256k1.PrivateKey{key0, key1}
+
+	b, err := EncryptKeys("password", keys)
+	require.NoError(err)
+	require.NotContains(string(b), key0.String())
+
+	decrypted, err := DecryptKeys("password", b)
+	require.NoError(err)
+	require.Equal(keys, decrypted)
+
+	_, err = DecryptKeys("wrong password", b)
+	require.ErrorIs(err, ErrIncorrectPassword)
+}
+
+func TestReadKeyFile(t *testing.T) {
+	require := require.New(t)
+
+	key0, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	key1, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	path := filepath.Join(t.TempDir(), "keys")
+	contents := "# funded keys\n" + key0.String() + "\n\n  " + key1.String() + "  \n"
+	require.NoError(os.WriteFile(path, []byte(contents), 0o600))
+
+	keys, err := ReadKeyFile(path)
+	require.NoError(err)
+	require.Equal([]*secp256k1.PrivateKey{key0, key1}, keys)
+
+	require.NoError(os.WriteFile(path, []byte("not a key\n"), 0o600))
+	_, err = ReadKeyFile(path)
+	require.Error(err) //nolint:forbidigo // the parsing errors are unexported
+}
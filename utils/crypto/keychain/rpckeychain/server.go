@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpckeychain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+
+	pb "github.com/MetalBlockchain/metalgo/proto/pb/keychain"
+)
+
+var (
+	_ pb.KeychainServer = (*Server)(nil)
+
+	ErrUnknownAddress = errors.New("unknown address")
+)
+
+// Server exposes a keychain over gRPC so that it can be used by [Client].
+type Server struct {
+	pb.UnimplementedKeychainServer
+
+	kc keychain.Keychain
+}
+
+func NewServer(kc keychain.Keychain) *Server {
+	return &Server{
+		kc: kc,
+	}
+}
+
+func (s *Server) Addresses(context.Context, *pb.AddressesRequest) (*pb.AddressesResponse, error) {
+	addrs := s.kc.Addresses().List()
+	addrBytes := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		addrBytes[i] = addr.Bytes()
+	}
+	return &pb.AddressesResponse{
+		Addresses: addrBytes,
+	}, nil
+}
+
+func (s *Server) Sign(_ context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	signer, err := s.signer(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(req.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{
+		Signature: sig,
+	}, nil
+}
+
+func (s *Server) SignHash(_ context.Context, req *pb.SignHashRequest) (*pb.SignHashResponse, error) {
+	signer, err := s.signer(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.SignHash(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignHashResponse{
+		Signature: sig,
+	}, nil
+}
+
+func (s *Server) signer(addrBytes []byte) (keychain.Signer, error) {
+	addr, err := ids.ToShortID(addrBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse address: %w", err)
+	}
+	signer, ok := s.kc.Get(addr)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAddress, addr)
+	}
+	return signer, nil
+}
@@ -20,4 +20,6 @@ type Config struct {
 	LogFormat               Format `json:"logFormat"`
 	MsgPrefix               string `json:"-"`
 	LoggerName              string `json:"-"`
+	// Exporter, if non-nil, receives every record written to the log files.
+	Exporter Exporter `json:"-"`
 }
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package logging
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var _ zapcore.Core = (*exporterCore)(nil)
+
+// Exporter receives the records written by the loggers of a factory, in
+// addition to them being written to the log files.
+type Exporter interface {
+	// Export is called with every record written by the logger named
+	// [loggerName]. Export must not block and must not log.
+	Export(loggerName string, entry zapcore.Entry, fields []zapcore.Field)
+}
+
+type exporterCore struct {
+	zapcore.LevelEnabler
+
+	loggerName string
+	exporter   Exporter
+	fields     []zapcore.Field
+}
+
+// newExporterCore returns a core that passes the records at or above [level]
+// to [exporter].
+func newExporterCore(loggerName string, level zap.AtomicLevel, exporter Exporter) WrappedCore {
+	return WrappedCore{
+		Core: &exporterCore{
+			LevelEnabler: level,
+			loggerName:   loggerName,
+			exporter:     exporter,
+		},
+		// The exporter is shared by all the loggers of a factory, so it must
+		// not be closed when a logger is stopped.
+		Writer:         Discard,
+		WriterDisabled: true,
+		AtomicLevel:    level,
+	}
+}
+
+func (c *exporterCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	return &clone
+}
+
+func (c *exporterCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *exporterCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if len(c.fields) > 0 {
+		fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	}
+	c.exporter.Export(c.loggerName, entry, fields)
+	return nil
+}
+
+func (*exporterCore) Sync() error {
+	return nil
+}
@@ -4,15 +4,14 @@
 package logging
 
 import (
+	"errors"
 	"fmt"
 	"os"
-	"path"
 	"sync"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/exp/maps"
-	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 var _ Factory = (*factory)(nil)
@@ -40,6 +39,18 @@ type Factory interface {
 	// GetLoggerNames returns the names of all logs created by this factory
 	GetLoggerNames() []string
 
+	// SetLevels sets the log and display levels of all loggers, including
+	// loggers that are created in the future.
+	SetLevels(logLevel, displayLevel Level)
+
+	// SetRotatingWriterConfig sets the rotation settings of the log files of
+	// all loggers, including loggers that are created in the future. The log
+	// directory can't be changed.
+	SetRotatingWriterConfig(config RotatingWriterConfig) error
+
+	// CloseLogger stops and removes the logger with name [name]
+	CloseLogger(name string) error
+
 	// Close stops and clears all of a Factory's instantiated loggers
 	Close()
 }
@@ -48,6 +59,7 @@ type logWrapper struct {
 	logger       Logger
 	displayLevel zap.AtomicLevel
 	logLevel     zap.AtomicLevel
+	writer       *rotatingWriter
 }
 
 type factory struct {
@@ -79,21 +91,21 @@ func (f *factory) makeLogger(config Config) (Logger, error) {
 	consoleCore := NewWrappedCore(config.DisplayLevel, os.Stdout, consoleEnc)
 	consoleCore.WriterDisabled = config.DisableWriterDisplaying
 
-	rw := &lumberjack.Logger{
-		Filename:   path.Join(config.Directory, config.LoggerName+".log"),
-		MaxSize:    config.MaxSize,  // megabytes
-		MaxAge:     config.MaxAge,   // days
-		MaxBackups: config.MaxFiles, // files
-		Compress:   config.Compress,
-	}
+	rw := newRotatingWriter(config.RotatingWriterConfig, config.LoggerName)
 	fileCore := NewWrappedCore(config.LogLevel, rw, fileEnc)
 	prefix := config.LogFormat.WrapPrefix(config.MsgPrefix)
 
-	l := NewLogger(prefix, consoleCore, fileCore)
+	cores := []WrappedCore{consoleCore, fileCore}
+	if config.Exporter != nil {
+		cores = append(cores, newExporterCore(config.LoggerName, fileCore.AtomicLevel, config.Exporter))
+	}
+
+	l := NewLogger(prefix, cores...)
 	f.loggers[config.LoggerName] = logWrapper{
 		logger:       l,
 		displayLevel: consoleCore.AtomicLevel,
 		logLevel:     fileCore.AtomicLevel,
+		writer:       rw,
 	}
 	return l, nil
 }
@@ -170,6 +182,47 @@ func (f *factory) GetLoggerNames() []string {
 	return maps.Keys(f.loggers)
 }
 
+func (f *factory) SetLevels(logLevel, displayLevel Level) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.config.LogLevel = logLevel
+	f.config.DisplayLevel = displayLevel
+	for _, logger := range f.loggers {
+		logger.logLevel.SetLevel(zapcore.Level(logLevel))
+		logger.displayLevel.SetLevel(zapcore.Level(displayLevel))
+	}
+}
+
+func (f *factory) SetRotatingWriterConfig(config RotatingWriterConfig) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	config.Directory = f.config.Directory
+	f.config.RotatingWriterConfig = config
+
+	var errs []error
+	for name, logger := range f.loggers {
+		if err := logger.writer.setConfig(config); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update rotation of logger %q: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f *factory) CloseLogger(name string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	logger, ok := f.loggers[name]
+	if !ok {
+		return fmt.Errorf("logger with name %q not found", name)
+	}
+	logger.logger.Stop()
+	delete(f.loggers, name)
+	return nil
+}
+
 func (f *factory) Close() {
 	f.lock.Lock()
 	defer f.lock.Unlock()
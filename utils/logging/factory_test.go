@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFactorySetLevels(t *testing.T) {
+	require := require.New(t)
+
+	f := NewFactory(Config{
+		RotatingWriterConfig: RotatingWriterConfig{
+			Directory: t.TempDir(),
+		},
+		LogLevel:     Info,
+		DisplayLevel: Info,
+	})
+	defer f.Close()
+
+	_, err := f.Make("existing")
+	require.NoError(err)
+
+	f.SetLevels(Debug, Warn)
+
+	_, err = f.Make("new")
+	require.NoError(err)
+
+	for _, name := range []string{"existing", "new"} {
+		logLevel, err := f.GetLogLevel(name)
+		require.NoError(err)
+		require.Equal(Debug, logLevel)
+
+		displayLevel, err := f.GetDisplayLevel(name)
+		require.NoError(err)
+		require.Equal(Warn, displayLevel)
+	}
+}
+
+func TestFactorySetRotatingWriterConfig(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	f := NewFactory(Config{
+		RotatingWriterConfig: RotatingWriterConfig{
+			MaxSize:   1,
+			Directory: dir,
+		},
+		LogLevel:     Info,
+		DisplayLevel: Off,
+	})
+	defer f.Close()
+
+	log, err := f.Make("test")
+	require.NoError(err)
+	log.Info("before")
+
+	require.NoError(f.SetRotatingWriterConfig(RotatingWriterConfig{
+		MaxSize:   2,
+		Directory: t.TempDir(),
+	}))
+	log.Info("after")
+
+	// The directory of the log file is not changed and the existing log file
+	// is appended to.
+	contents, err := os.ReadFile(filepath.Join(dir, "test.log"))
+	require.NoError(err)
+	require.Contains(string(contents), "before")
+	require.Contains(string(contents), "after")
+
+	lw := f.(*factory).loggers["test"]
+	require.Equal(2, lw.writer.writer.MaxSize)
+}
+
+type testExporter struct {
+	loggerNames []string
+	entries     []zapcore.Entry
+	fields      [][]zapcore.Field
+}
+
+func (e *testExporter) Export(loggerName string, entry zapcore.Entry, fields []zapcore.Field) {
+	e.loggerNames = append(e.loggerNames, loggerName)
+	e.entries = append(e.entries, entry)
+	e.fields = append(e.fields, fields)
+}
+
+func TestFactoryExporter(t *testing.T) {
+	require := require.New(t)
+
+	exporter := &testExporter{}
+	f := NewFactory(Config{
+		RotatingWriterConfig: RotatingWriterConfig{
+			Directory: t.TempDir(),
+		},
+		LogLevel:     Info,
+		DisplayLevel: Off,
+		Exporter:     exporter,
+	})
+	defer f.Close()
+
+	log, err := f.Make("test")
+	require.NoError(err)
+	log.Debug("ignored")
+	log.With(zap.String("with", "field")).Info("exported", zap.Int("field", 1))
+
+	require.Equal([]string{"test"}, exporter.loggerNames)
+	require.Len(exporter.entries, 1)
+	require.Equal(zapcore.Level(Info), exporter.entries[0].Level)
+	require.Equal("exported", exporter.entries[0].Message)
+	require.Equal(
+		[]zapcore.Field{
+			zap.String("with", "field"),
+			zap.Int("field", 1),
+		},
+		exporter.fields[0],
+	)
+
+	// The exporter uses the log level of the log file
+	require.NoError(f.SetLogLevel("test", Debug))
+	log.Debug("exported")
+	require.Len(exporter.entries, 2)
+}
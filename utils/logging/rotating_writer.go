@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package logging
+
+import (
+	"io"
+	"path"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var _ io.WriteCloser = (*rotatingWriter)(nil)
+
+// rotatingWriter writes to a rotating log file whose rotation settings can be
+// changed while it is being written to.
+type rotatingWriter struct {
+	lock   sync.Mutex
+	writer *lumberjack.Logger
+}
+
+// NewRotatingWriter returns a writer to [name].log in the directory of
+// [config] that is rotated according to [config].
+func NewRotatingWriter(config RotatingWriterConfig, name string) io.WriteCloser {
+	return newRotatingWriter(config, name)
+}
+
+func newRotatingWriter(config RotatingWriterConfig, loggerName string) *rotatingWriter {
+	return &rotatingWriter{
+		writer: newLumberjackLogger(config, path.Join(config.Directory, loggerName+".log")),
+	}
+}
+
+func newLumberjackLogger(config RotatingWriterConfig, filename string) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   filename,
+		MaxSize:    config.MaxSize,  // megabytes
+		MaxAge:     config.MaxAge,   // days
+		MaxBackups: config.MaxFiles, // files
+		Compress:   config.Compress,
+	}
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.writer.Write(p)
+}
+
+func (w *rotatingWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.writer.Close()
+}
+
+// setConfig applies the rotation settings in [config] to the current log file.
+// The directory of the log file is not changed.
+func (w *rotatingWriter) setConfig(config RotatingWriterConfig) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	// The next write re-opens the existing log file.
+	err := w.writer.Close()
+	w.writer = newLumberjackLogger(config, w.writer.Filename)
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package profiler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"sync"
+	"time"
+)
+
+const (
+	CPU       Type = "cpu"
+	Heap      Type = "heap"
+	Allocs    Type = "allocs"
+	Goroutine Type = "goroutine"
+	Block     Type = "block"
+	Mutex     Type = "mutex"
+	Trace     Type = "trace"
+)
+
+var (
+	// Types is the list of all the supported profile types.
+	Types = []Type{
+		CPU,
+		Heap,
+		Allocs,
+		Goroutine,
+		Block,
+		Mutex,
+		Trace,
+	}
+
+	errUnknownType = errors.New("unknown profile type")
+
+	blockProfileRate = &profileRate{
+		enable: func() {
+			runtime.SetBlockProfileRate(1)
+		},
+		disable: func() {
+			runtime.SetBlockProfileRate(0)
+		},
+	}
+	mutexProfileRate = newMutexProfileRate()
+)
+
+// Type is the kind of profile that can be captured.
+type Type string
+
+// ParseType returns the profile type named [s].
+func ParseType(s string) (Type, error) {
+	for _, profileType := range Types {
+		if string(profileType) == s {
+			return profileType, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", errUnknownType, s)
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+// IsRecording returns true if profiles of this type record the process for a
+// duration, rather than being a snapshot of its current state.
+func (t Type) IsRecording() bool {
+	return t == CPU || t == Trace
+}
+
+// Capture writes a profile of [profileType] to [w].
+//
+// CPU profiles and execution traces record the process for [duration]. Block
+// and mutex profiles are cumulative; if [duration] is non-zero, their sampling
+// is enabled for [duration] before the profile is written. All other profiles
+// are snapshots of the current state of the process and ignore [duration].
+//
+// If [ctx] is cancelled before [duration] elapses, the profile is stopped
+// early and the context's error is returned.
+func Capture(ctx context.Context, w io.Writer, profileType Type, duration time.Duration) error {
+	switch profileType {
+	case CPU:
+		if err := pprof.StartCPUProfile(w); err != nil {
+			return err
+		}
+		err := sleep(ctx, duration)
+		pprof.StopCPUProfile()
+		return err
+	case Trace:
+		if err := trace.Start(w); err != nil {
+			return err
+		}
+		err := sleep(ctx, duration)
+		trace.Stop()
+		return err
+	case Block:
+		return captureSampled(ctx, w, profileType, duration, blockProfileRate)
+	case Mutex:
+		return captureSampled(ctx, w, profileType, duration, mutexProfileRate)
+	case Heap, Allocs, Goroutine:
+		return writeProfile(w, profileType)
+	default:
+		return fmt.Errorf("%w: %q", errUnknownType, profileType)
+	}
+}
+
+func captureSampled(
+	ctx context.Context,
+	w io.Writer,
+	profileType Type,
+	duration time.Duration,
+	rate *profileRate,
+) error {
+	if duration > 0 {
+		rate.start()
+		err := sleep(ctx, duration)
+		rate.stop()
+		if err != nil {
+			return err
+		}
+	}
+	return writeProfile(w, profileType)
+}
+
+// writeProfile writes a snapshot of the pprof profile of [profileType] to [w].
+func writeProfile(w io.Writer, profileType Type) error {
+	if profileType == Heap {
+		runtime.GC() // get up-to-date statistics
+	}
+
+	profile := pprof.Lookup(string(profileType))
+	if profile == nil {
+		return fmt.Errorf("%w: %q", errUnknownType, profileType)
+	}
+	return profile.WriteTo(w, 0)
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// profileRate enables the sampling of a profile while there is at least one
+// user of it.
+type profileRate struct {
+	lock    sync.Mutex
+	users   int
+	enable  func()
+	disable func()
+}
+
+func newMutexProfileRate() *profileRate {
+	var previousFraction int
+	return &profileRate{
+		enable: func() {
+			previousFraction = runtime.SetMutexProfileFraction(1)
+		},
+		disable: func() {
+			runtime.SetMutexProfileFraction(previousFraction)
+		},
+	}
+}
+
+func (r *profileRate) start() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.users == 0 {
+		r.enable()
+	}
+	r.users++
+}
+
+func (r *profileRate) stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.users--
+	if r.users == 0 {
+		r.disable()
+	}
+}
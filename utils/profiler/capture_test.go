@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapture(t *testing.T) {
+	for _, profileType := range Types {
+		t.Run(string(profileType), func(t *testing.T) {
+			require := require.New(t)
+
+			var b bytes.Buffer
+			require.NoError(Capture(context.Background(), &b, profileType, 10*time.Millisecond))
+			require.NotZero(b.Len())
+		})
+	}
+}
+
+func TestCaptureCancelled(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var b bytes.Buffer
+	err := Capture(ctx, &b, CPU, time.Minute)
+	require.ErrorIs(err, context.Canceled)
+
+	// The CPU profiler should have been stopped.
+	require.NoError(Capture(context.Background(), &b, CPU, time.Millisecond))
+}
+
+func TestCaptureUnknownType(t *testing.T) {
+	require := require.New(t)
+
+	var b bytes.Buffer
+	err := Capture(context.Background(), &b, Type("unknown"), 0)
+	require.ErrorIs(err, errUnknownType)
+}
+
+func TestParseType(t *testing.T) {
+	require := require.New(t)
+
+	for _, profileType := range Types {
+		parsed, err := ParseType(string(profileType))
+		require.NoError(err)
+		require.Equal(profileType, parsed)
+	}
+
+	_, err := ParseType("unknown")
+	require.ErrorIs(err, errUnknownType)
+}
@@ -18,9 +18,10 @@ type Config struct {
 	Enabled     bool          `json:"enabled"`
 	Freq        time.Duration `json:"freq"`
 	MaxNumFiles int           `json:"maxNumFiles"`
+	Types       []Type        `json:"types"`
 }
 
-// ContinuousProfiler periodically captures CPU, memory, and lock profiles
+// ContinuousProfiler periodically captures the configured profiles
 type ContinuousProfiler interface {
 	Dispatch() error
 	Shutdown()
@@ -30,21 +31,41 @@ type continuousProfiler struct {
 	profiler    *profiler
 	freq        time.Duration
 	maxNumFiles int
+	types       []Type
 
 	// Dispatch returns when closer is closed
 	closer chan struct{}
 }
 
-func NewContinuous(dir string, freq time.Duration, maxNumFiles int) ContinuousProfiler {
+// NewContinuous returns a profiler that captures profiles of [types] every
+// [freq]. Recording profiles, such as CPU profiles, cover the whole period.
+func NewContinuous(dir string, freq time.Duration, maxNumFiles int, types []Type) ContinuousProfiler {
 	return &continuousProfiler{
 		profiler:    newProfiler(dir),
 		freq:        freq,
 		maxNumFiles: maxNumFiles,
+		types:       types,
 		closer:      make(chan struct{}),
 	}
 }
 
 func (p *continuousProfiler) Dispatch() error {
+	for _, profileType := range p.types {
+		if _, err := p.profiler.fileName(profileType); err != nil {
+			return err
+		}
+
+		// Sampled profiles are only populated while sampling is enabled.
+		switch profileType {
+		case Block:
+			blockProfileRate.start()
+			defer blockProfileRate.stop()
+		case Mutex:
+			mutexProfileRate.start()
+			defer mutexProfileRate.stop()
+		}
+	}
+
 	t := time.NewTicker(p.freq)
 	defer t.Stop()
 
@@ -69,28 +90,53 @@ func (p *continuousProfiler) Dispatch() error {
 }
 
 func (p *continuousProfiler) start() error {
-	return p.profiler.StartCPUProfiler()
+	for _, profileType := range p.types {
+		var err error
+		switch profileType {
+		case CPU:
+			err = p.profiler.StartCPUProfiler()
+		case Trace:
+			err = p.profiler.startTrace()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *continuousProfiler) stop() error {
 	g := errgroup.Group{}
-	g.Go(p.profiler.StopCPUProfiler)
-	g.Go(p.profiler.MemoryProfile)
-	g.Go(p.profiler.LockProfile)
+	for _, profileType := range p.types {
+		switch profileType {
+		case CPU:
+			g.Go(p.profiler.StopCPUProfiler)
+		case Trace:
+			g.Go(p.profiler.stopTrace)
+		case Heap:
+			g.Go(p.profiler.MemoryProfile)
+		case Mutex:
+			g.Go(p.profiler.LockProfile)
+		default:
+			g.Go(func() error {
+				return p.profiler.writeProfileFile(profileType)
+			})
+		}
+	}
 	return g.Wait()
 }
 
 func (p *continuousProfiler) rotate() error {
 	g := errgroup.Group{}
-	g.Go(func() error {
-		return rotate(p.profiler.cpuProfileName, p.maxNumFiles)
-	})
-	g.Go(func() error {
-		return rotate(p.profiler.memProfileName, p.maxNumFiles)
-	})
-	g.Go(func() error {
-		return rotate(p.profiler.lockProfileName, p.maxNumFiles)
-	})
+	for _, profileType := range p.types {
+		g.Go(func() error {
+			name, err := p.profiler.fileName(profileType)
+			if err != nil {
+				return err
+			}
+			return rotate(name, p.maxNumFiles)
+		})
+	}
 	return g.Wait()
 }
 
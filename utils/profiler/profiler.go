@@ -5,10 +5,12 @@ package profiler
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"runtime/trace"
 
 	"github.com/MetalBlockchain/metalgo/utils/perms"
 )
@@ -20,6 +22,14 @@ const (
 	memProfileFile = "mem.profile"
 	// Name of file that lock profile is written to
 	lockProfileFile = "lock.profile"
+	// Name of file that allocation profile is written to
+	allocsProfileFile = "allocs.profile"
+	// Name of file that goroutine profile is written to
+	goroutineProfileFile = "goroutine.profile"
+	// Name of file that block profile is written to
+	blockProfileFile = "block.profile"
+	// Name of file that execution trace is written to
+	traceFile = "trace.out"
 )
 
 var (
@@ -27,6 +37,18 @@ var (
 
 	errCPUProfilerRunning    = errors.New("cpu profiler already running")
 	errCPUProfilerNotRunning = errors.New("cpu profiler doesn't exist")
+	errTraceRunning          = errors.New("execution trace already running")
+	errTraceNotRunning       = errors.New("execution trace doesn't exist")
+
+	profileFiles = map[Type]string{
+		CPU:       cpuProfileFile,
+		Heap:      memProfileFile,
+		Allocs:    allocsProfileFile,
+		Goroutine: goroutineProfileFile,
+		Block:     blockProfileFile,
+		Mutex:     lockProfileFile,
+		Trace:     traceFile,
+	}
 )
 
 // Profiler provides helper methods for measuring the current performance of
@@ -52,6 +74,7 @@ type profiler struct {
 	lockProfileName string
 
 	cpuProfileFile *os.File
+	traceFile      *os.File
 }
 
 func New(dir string) Profiler {
@@ -132,3 +155,66 @@ func (p *profiler) LockProfile() error {
 	}
 	return file.Close()
 }
+
+// fileName returns the path of the file that profiles of [profileType] are
+// written to.
+func (p *profiler) fileName(profileType Type) (string, error) {
+	name, ok := profileFiles[profileType]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", errUnknownType, profileType)
+	}
+	return filepath.Join(p.dir, name), nil
+}
+
+func (p *profiler) startTrace() error {
+	if p.traceFile != nil {
+		return errTraceRunning
+	}
+
+	if err := os.MkdirAll(p.dir, perms.ReadWriteExecute); err != nil {
+		return err
+	}
+	file, err := perms.Create(filepath.Join(p.dir, traceFile), perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	if err := trace.Start(file); err != nil {
+		_ = file.Close() // Return the original error
+		return err
+	}
+
+	p.traceFile = file
+	return nil
+}
+
+func (p *profiler) stopTrace() error {
+	if p.traceFile == nil {
+		return errTraceNotRunning
+	}
+
+	trace.Stop()
+	err := p.traceFile.Close()
+	p.traceFile = nil
+	return err
+}
+
+// writeProfileFile writes a snapshot of the profile of [profileType] to its
+// file.
+func (p *profiler) writeProfileFile(profileType Type) error {
+	name, err := p.fileName(profileType)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(p.dir, perms.ReadWriteExecute); err != nil {
+		return err
+	}
+	file, err := perms.Create(name, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	if err := writeProfile(file, profileType); err != nil {
+		_ = file.Close() // Return the original error
+		return err
+	}
+	return file.Close()
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -42,3 +43,22 @@ func TestProfiler(t *testing.T) {
 	_, err = os.Stat(filepath.Join(dir, lockProfileFile))
 	require.NoError(err)
 }
+
+func TestContinuousProfiler(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	p := NewContinuous(dir, time.Hour, 2, Types)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- p.Dispatch()
+	}()
+	p.Shutdown()
+	require.NoError(<-errs)
+
+	for _, name := range profileFiles {
+		_, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(err)
+	}
+}
@@ -513,7 +513,7 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 
 	baseDB := versiondb.New(memdb.New())
 
-	state, err := state.New(baseDB, parser, registerer, trackChecksums)
+	state, err := state.New(baseDB, parser, registerer, trackChecksums, false)
 	require.NoError(err)
 
 	clk := &mockable.Clock{}
@@ -172,6 +172,25 @@ func (c *Client) GetAssetDescription(ctx context.Context, assetID string, option
 	return res, err
 }
 
+// GetAssets returns at most limit descriptions of the assets whose name or
+// symbol contains query, ordered by asset ID and starting after startAssetID.
+// The returned ID can be passed as startAssetID to fetch the next page.
+func (c *Client) GetAssets(
+	ctx context.Context,
+	query string,
+	startAssetID ids.ID,
+	limit uint32,
+	options ...rpc.Option,
+) ([]GetAssetDescriptionReply, ids.ID, error) {
+	res := &GetAssetsReply{}
+	err := c.Requester.SendRequest(ctx, "avm.getAssets", &GetAssetsArgs{
+		Query:        query,
+		StartAssetID: startAssetID,
+		Limit:        json.Uint32(limit),
+	}, res, options...)
+	return res.Assets, res.EndAssetID, err
+}
+
 // GetBalance returns the balance of assetID held by addr.
 //
 // If includePartial is set, balance includes partial owned (i.e. in a multisig)
@@ -211,6 +230,42 @@ func (c *Client) GetAllBalances(
 	return res.Balances, err
 }
 
+// GetNFTs returns the NFTs held by UTXOs that reference at least one of addrs.
+// If assetID is non-empty, only the NFTs of assetID are returned. The payloads
+// of the returned NFTs are hex encoded.
+func (c *Client) GetNFTs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	assetID string,
+	options ...rpc.Option,
+) ([]NFT, error) {
+	res := &GetNFTsReply{}
+	err := c.Requester.SendRequest(ctx, "avm.getNFTs", &GetNFTsArgs{
+		JSONAddresses: api.JSONAddresses{Addresses: ids.ShortIDsToStrings(addrs)},
+		AssetID:       assetID,
+		Encoding:      formatting.Hex,
+	}, res, options...)
+	return res.NFTs, err
+}
+
+// GetOwnedAssets returns the assets held by addrs.
+//
+// If includePartial is set, partially owned (i.e. in a multisig) and locked
+// outputs are included.
+func (c *Client) GetOwnedAssets(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	includePartial bool,
+	options ...rpc.Option,
+) ([]OwnedAsset, error) {
+	res := &GetOwnedAssetsReply{}
+	err := c.Requester.SendRequest(ctx, "avm.getOwnedAssets", &GetOwnedAssetsArgs{
+		JSONAddresses:  api.JSONAddresses{Addresses: ids.ShortIDsToStrings(addrs)},
+		IncludePartial: includePartial,
+	}, res, options...)
+	return res.Assets, err
+}
+
 // GetTxFee returns the cost to issue certain transactions.
 func (c *Client) GetTxFee(ctx context.Context, options ...rpc.Option) (uint64, uint64, error) {
 	res := &GetTxFeeReply{}
@@ -218,6 +273,23 @@ func (c *Client) GetTxFee(ctx context.Context, options ...rpc.Option) (uint64, u
 	return uint64(res.TxFee), uint64(res.CreateAssetTxFee), err
 }
 
+// EstimateFee returns the fee the transaction would pay if it was issued now,
+// and the fee it would pay if it was issued [seconds] later.
+func (c *Client) EstimateFee(ctx context.Context, txBytes []byte, seconds uint64, options ...rpc.Option) (uint64, uint64, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	res := &EstimateFeeReply{}
+	err = c.Requester.SendRequest(ctx, "avm.estimateFee", &EstimateFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+		Seconds:  json.Uint64(seconds),
+	}, res, options...)
+	return uint64(res.Fee), uint64(res.ProjectedFee), err
+}
+
 func AwaitTxAccepted(
 	c *Client,
 	ctx context.Context,
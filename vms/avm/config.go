@@ -10,13 +10,17 @@ import (
 )
 
 var DefaultConfig = Config{
-	Network:          network.DefaultConfig,
-	ChecksumsEnabled: false,
+	Network:             network.DefaultConfig,
+	ChecksumsEnabled:    false,
+	AssetIndexEnabled:   false,
+	ScheduledTxsEnabled: false,
 }
 
 type Config struct {
-	Network          network.Config `json:"network"`
-	ChecksumsEnabled bool           `json:"checksums-enabled"`
+	Network             network.Config `json:"network"`
+	ChecksumsEnabled    bool           `json:"checksums-enabled"`
+	AssetIndexEnabled   bool           `json:"asset-index-enabled"`
+	ScheduledTxsEnabled bool           `json:"scheduled-txs-enabled"`
 }
 
 func ParseConfig(configBytes []byte) (Config, error) {
@@ -31,6 +31,22 @@ func TestParseConfig(t *testing.T) {
 				ChecksumsEnabled: true,
 			},
 		},
+		{
+			name:        "manually specified asset index enabled",
+			configBytes: []byte(`{"asset-index-enabled":true}`),
+			expectedConfig: Config{
+				Network:           network.DefaultConfig,
+				AssetIndexEnabled: true,
+			},
+		},
+		{
+			name:        "manually specified scheduled txs enabled",
+			configBytes: []byte(`{"scheduled-txs-enabled":true}`),
+			expectedConfig: Config{
+				Network:             network.DefaultConfig,
+				ScheduledTxsEnabled: true,
+			},
+		},
 		{
 			name:        "manually specified network value",
 			configBytes: []byte(`{"network":{"max-validator-set-staleness":1}}`),
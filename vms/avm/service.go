@@ -4,11 +4,14 @@
 package avm
 
 import (
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"net/http"
+	"slices"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -19,8 +22,11 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/formatting"
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/avm/state"
 	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/nftfx"
+	"github.com/MetalBlockchain/metalgo/vms/propertyfx"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 
 	avajson "github.com/MetalBlockchain/metalgo/utils/json"
@@ -33,6 +39,13 @@ const (
 
 	// Max number of items allowed in a page
 	maxPageSize uint64 = 1024
+
+	// Transaction types accepted by EstimateFee
+	baseTxType        = "BaseTx"
+	createAssetTxType = "CreateAssetTx"
+	operationTxType   = "OperationTx"
+	importTxType      = "ImportTx"
+	exportTxType      = "ExportTx"
 )
 
 var (
@@ -40,6 +53,7 @@ var (
 	errNilTxID          = errors.New("nil transaction ID")
 	errNoAddresses      = errors.New("no addresses provided")
 	errNotLinearized    = errors.New("chain is not linearized")
+	errUnknownTxType    = errors.New("unknown tx type")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -397,6 +411,12 @@ type GetAssetDescriptionReply struct {
 	Name         string        `json:"name"`
 	Symbol       string        `json:"symbol"`
 	Denomination avajson.Uint8 `json:"denomination"`
+	// Supply is the total amount of fungible tokens that have been minted.
+	// Only populated if the asset index is built.
+	Supply *avajson.Uint64 `json:"supply,omitempty"`
+	// Holders is the number of addresses that own at least one output of the
+	// asset. Only populated if the asset index is built.
+	Holders *avajson.Uint64 `json:"holders,omitempty"`
 }
 
 // GetAssetDescription creates an empty account with the name passed in
@@ -424,11 +444,98 @@ func (s *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescription
 		return errTxNotCreateAsset
 	}
 
-	reply.AssetID = assetID
-	reply.Name = createAssetTx.Name
-	reply.Symbol = createAssetTx.Symbol
-	reply.Denomination = avajson.Uint8(createAssetTx.Denomination)
+	asset, err := s.vm.state.GetAsset(assetID)
+	switch {
+	case err == nil:
+		*reply = newAssetDescription(asset)
+		return nil
+	case errors.Is(err, state.ErrAssetsNotIndexed):
+		reply.AssetID = assetID
+		reply.Name = createAssetTx.Name
+		reply.Symbol = createAssetTx.Symbol
+		reply.Denomination = avajson.Uint8(createAssetTx.Denomination)
+		return nil
+	default:
+		return fmt.Errorf("couldn't get asset %s: %w", assetID, err)
+	}
+}
+
+func newAssetDescription(asset *state.Asset) GetAssetDescriptionReply {
+	var (
+		supply  = avajson.Uint64(asset.Supply)
+		holders = avajson.Uint64(asset.Holders)
+	)
+	return GetAssetDescriptionReply{
+		FormattedAssetID: FormattedAssetID{
+			AssetID: asset.ID,
+		},
+		Name:         asset.Name,
+		Symbol:       asset.Symbol,
+		Denomination: avajson.Uint8(asset.Denomination),
+		Supply:       &supply,
+		Holders:      &holders,
+	}
+}
+
+// GetAssetsArgs are arguments for passing into GetAssets requests
+type GetAssetsArgs struct {
+	// Query, if provided, only returns the assets whose name or symbol
+	// contains it, ignoring case
+	Query string `json:"query"`
+	// StartAssetID, if provided, only returns the assets after it
+	StartAssetID ids.ID         `json:"startAssetID"`
+	Limit        avajson.Uint32 `json:"limit"`
+}
+
+// GetAssetsReply is the response from a call to GetAssets
+type GetAssetsReply struct {
+	Assets     []GetAssetDescriptionReply `json:"assets"`
+	NumFetched avajson.Uint64             `json:"numFetched"`
+	// EndAssetID is the ID of the last asset returned. It can be passed as
+	// StartAssetID to fetch the next page.
+	EndAssetID ids.ID `json:"endAssetID"`
+}
+
+// GetAssets returns the descriptions of the assets created on this chain,
+// ordered by asset ID. Requires the asset index to be enabled and built.
+func (s *Service) GetAssets(_ *http.Request, args *GetAssetsArgs, reply *GetAssetsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssets"),
+		logging.UserString("query", args.Query),
+	)
+
+	limit := int(args.Limit)
+	if limit <= 0 || int(maxPageSize) < limit {
+		limit = int(maxPageSize)
+	}
 
+	var filter func(*state.Asset) bool
+	if args.Query != "" {
+		query := strings.ToLower(args.Query)
+		filter = func(asset *state.Asset) bool {
+			return strings.Contains(strings.ToLower(asset.Name), query) ||
+				strings.Contains(strings.ToLower(asset.Symbol), query)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	assets, err := s.vm.state.GetAssets(args.StartAssetID, limit, filter)
+	if err != nil {
+		return fmt.Errorf("problem retrieving assets: %w", err)
+	}
+
+	reply.Assets = make([]GetAssetDescriptionReply, len(assets))
+	for i, asset := range assets {
+		reply.Assets[i] = newAssetDescription(asset)
+	}
+	reply.NumFetched = avajson.Uint64(len(assets))
+	reply.EndAssetID = args.StartAssetID
+	if len(assets) > 0 {
+		reply.EndAssetID = assets[len(assets)-1].ID
+	}
 	return nil
 }
 
@@ -586,6 +693,259 @@ func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, repl
 	return nil
 }
 
+// Owner is the JSON representation of the owners of an output.
+type Owner struct {
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// NFT is an NFT held by a UTXO.
+type NFT struct {
+	UTXOID  ids.ID         `json:"utxoID"`
+	AssetID ids.ID         `json:"assetID"`
+	GroupID avajson.Uint32 `json:"groupID"`
+	Payload string         `json:"payload"`
+	Owner   Owner          `json:"owner"`
+}
+
+// GetNFTsArgs are arguments for passing into GetNFTs requests
+type GetNFTsArgs struct {
+	api.JSONAddresses
+	// AssetID, if provided, only returns the NFTs of this asset
+	AssetID  string              `json:"assetID"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetNFTsReply is the response from a call to GetNFTs
+type GetNFTsReply struct {
+	NFTs     []NFT               `json:"nfts"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetNFTs returns the NFTs held by UTXOs that reference at least one of
+// [args.Addresses], sorted by asset, group and UTXO ID. The payloads are
+// encoded with [args.Encoding].
+func (s *Service) GetNFTs(_ *http.Request, args *GetNFTsArgs, reply *GetNFTsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getNFTs"),
+		logging.UserStrings("addresses", args.Addresses),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	var assetID ids.ID
+	if args.AssetID != "" {
+		assetID, err = s.vm.lookupAssetID(args.AssetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses' UTXOs: %w", err)
+	}
+
+	reply.NFTs = []NFT{}
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*nftfx.TransferOutput)
+		if !ok {
+			continue
+		}
+		if args.AssetID != "" && utxo.AssetID() != assetID {
+			continue
+		}
+
+		payload, err := formatting.Encode(args.Encoding, out.Payload)
+		if err != nil {
+			return fmt.Errorf("couldn't encode payload of UTXO %s: %w", utxo.InputID(), err)
+		}
+		owner, err := s.formatOwner(&out.OutputOwners)
+		if err != nil {
+			return err
+		}
+		reply.NFTs = append(reply.NFTs, NFT{
+			UTXOID:  utxo.InputID(),
+			AssetID: utxo.AssetID(),
+			GroupID: avajson.Uint32(out.GroupID),
+			Payload: payload,
+			Owner:   owner,
+		})
+	}
+	slices.SortFunc(reply.NFTs, func(a, b NFT) int {
+		if c := a.AssetID.Compare(b.AssetID); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.GroupID, b.GroupID); c != 0 {
+			return c
+		}
+		return a.UTXOID.Compare(b.UTXOID)
+	})
+	reply.Encoding = args.Encoding
+	return nil
+}
+
+func (s *Service) formatOwner(owners *secp256k1fx.OutputOwners) (Owner, error) {
+	addrs := make([]string, len(owners.Addrs))
+	for i, addr := range owners.Addrs {
+		addrStr, err := s.vm.FormatLocalAddress(addr)
+		if err != nil {
+			return Owner{}, fmt.Errorf("problem formatting address: %w", err)
+		}
+		addrs[i] = addrStr
+	}
+	return Owner{
+		Locktime:  avajson.Uint64(owners.Locktime),
+		Threshold: avajson.Uint32(owners.Threshold),
+		Addresses: addrs,
+	}, nil
+}
+
+// OwnedAsset describes an asset held by a set of addresses.
+type OwnedAsset struct {
+	FormattedAssetID
+	Name         string        `json:"name"`
+	Symbol       string        `json:"symbol"`
+	Denomination avajson.Uint8 `json:"denomination"`
+	// Balance is the amount of fungible tokens of the asset
+	Balance avajson.Uint64 `json:"balance"`
+	// NFTs is the number of NFTs of the asset
+	NFTs avajson.Uint64 `json:"nfts"`
+	// Properties is the number of properties of the asset
+	Properties avajson.Uint64 `json:"properties"`
+}
+
+// GetOwnedAssetsArgs are arguments for passing into GetOwnedAssets requests
+type GetOwnedAssetsArgs struct {
+	api.JSONAddresses
+	IncludePartial bool `json:"includePartial"`
+}
+
+// GetOwnedAssetsReply is the response from a call to GetOwnedAssets
+type GetOwnedAssetsReply struct {
+	Assets []OwnedAsset `json:"assets"`
+}
+
+// GetOwnedAssets returns the description of every asset held by
+// [args.Addresses], along with the fungible balance and the number of NFTs and
+// properties held of each asset. Assets are sorted by ID.
+//
+// If ![args.IncludePartial], only unlocked outputs with a 1-out-of-1 multisig
+// are counted.
+func (s *Service) GetOwnedAssets(_ *http.Request, args *GetOwnedAssetsArgs, reply *GetOwnedAssetsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getOwnedAssets"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses' UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	assets := make(map[ids.ID]*OwnedAsset)
+	for _, utxo := range utxos {
+		var owners *secp256k1fx.OutputOwners
+		switch out := utxo.Out.(type) {
+		case *secp256k1fx.TransferOutput:
+			owners = &out.OutputOwners
+		case *nftfx.TransferOutput:
+			owners = &out.OutputOwners
+		case *propertyfx.OwnedOutput:
+			owners = &out.OutputOwners
+		default:
+			continue
+		}
+		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+			continue
+		}
+
+		assetID := utxo.AssetID()
+		asset, ok := assets[assetID]
+		if !ok {
+			asset, err = s.getOwnedAsset(assetID)
+			if err != nil {
+				return err
+			}
+			assets[assetID] = asset
+		}
+
+		switch out := utxo.Out.(type) {
+		case *secp256k1fx.TransferOutput:
+			balance, err := safemath.Add(uint64(asset.Balance), out.Amount())
+			if err != nil {
+				balance = math.MaxUint64
+			}
+			asset.Balance = avajson.Uint64(balance)
+		case *nftfx.TransferOutput:
+			asset.NFTs++
+		case *propertyfx.OwnedOutput:
+			asset.Properties++
+		}
+	}
+
+	reply.Assets = make([]OwnedAsset, 0, len(assets))
+	for _, asset := range assets {
+		reply.Assets = append(reply.Assets, *asset)
+	}
+	slices.SortFunc(reply.Assets, func(a, b OwnedAsset) int {
+		return a.AssetID.Compare(b.AssetID)
+	})
+	return nil
+}
+
+func (s *Service) getOwnedAsset(assetID ids.ID) (*OwnedAsset, error) {
+	tx, err := s.vm.state.GetTx(assetID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get asset %s: %w", assetID, err)
+	}
+	createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errTxNotCreateAsset, assetID)
+	}
+	return &OwnedAsset{
+		FormattedAssetID: FormattedAssetID{
+			AssetID: assetID,
+		},
+		Name:         createAssetTx.Name,
+		Symbol:       createAssetTx.Symbol,
+		Denomination: avajson.Uint8(createAssetTx.Denomination),
+	}, nil
+}
+
 type GetTxFeeReply struct {
 	TxFee            avajson.Uint64 `json:"txFee"`
 	CreateAssetTxFee avajson.Uint64 `json:"createAssetTxFee"`
@@ -601,3 +961,60 @@ func (s *Service) GetTxFee(_ *http.Request, _ *struct{}, reply *GetTxFeeReply) e
 	reply.CreateAssetTxFee = avajson.Uint64(s.vm.CreateAssetTxFee)
 	return nil
 }
+
+type EstimateFeeArgs struct {
+	// Tx is the byte representation of a signed or unsigned transaction. If it
+	// is empty, the fee is estimated from TxType.
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+	// TxType is the name of the transaction type, such as BaseTx.
+	TxType string `json:"txType"`
+	// Seconds is how far past the current time the fee is projected.
+	Seconds avajson.Uint64 `json:"seconds"`
+}
+
+type EstimateFeeReply struct {
+	Fee          avajson.Uint64 `json:"fee"`
+	ProjectedFee avajson.Uint64 `json:"projectedFee"`
+}
+
+// EstimateFee returns the fee a transaction would pay if it was issued now, and
+// the fee it would pay if it was issued [args.Seconds] later. The X-Chain fees
+// are static, so both fees are equal and only depend on the transaction type.
+func (s *Service) EstimateFee(_ *http.Request, args *EstimateFeeArgs, reply *EstimateFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "estimateFee"),
+	)
+
+	txType := args.TxType
+	if args.Tx != "" {
+		txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+		if err != nil {
+			return fmt.Errorf("problem decoding transaction: %w", err)
+		}
+		tx, err := s.vm.parser.ParseTx(txBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't parse tx: %w", err)
+		}
+		if _, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+			txType = createAssetTxType
+		} else {
+			txType = baseTxType
+		}
+	}
+
+	var txFee uint64
+	switch txType {
+	case createAssetTxType:
+		txFee = s.vm.CreateAssetTxFee
+	case baseTxType, operationTxType, importTxType, exportTxType:
+		txFee = s.vm.TxFee
+	default:
+		return fmt.Errorf("%w: %q", errUnknownTxType, txType)
+	}
+
+	reply.Fee = avajson.Uint64(txFee)
+	reply.ProjectedFee = avajson.Uint64(txFee)
+	return nil
+}
@@ -6,6 +6,7 @@ package avm
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/units"
 	"github.com/MetalBlockchain/metalgo/vms/avm/block"
 	"github.com/MetalBlockchain/metalgo/vms/avm/block/executor/executormock"
+	"github.com/MetalBlockchain/metalgo/vms/avm/state"
 	"github.com/MetalBlockchain/metalgo/vms/avm/state/statemock"
 	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
@@ -37,6 +39,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/nftfx"
 	"github.com/MetalBlockchain/metalgo/vms/propertyfx"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/vms/txs/scheduler"
 
 	avajson "github.com/MetalBlockchain/metalgo/utils/json"
 )
@@ -64,6 +67,92 @@ func TestServiceIssueTx(t *testing.T) {
 	require.Equal(tx.ID(), txReply.TxID)
 }
 
+func TestWalletServiceScheduleTx(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.ScheduledTxsEnabled = true
+	env := setup(t, &envConfig{
+		fork:            upgradetest.Latest,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	service := &env.vm.walletService
+	env.vm.ctx.Lock.Unlock()
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "METAL")
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	notBefore := env.vm.clock.Time().Add(time.Hour)
+	scheduleArgs := &ScheduleTxArgs{
+		Tx:        txStr,
+		Encoding:  formatting.Hex,
+		NotBefore: avajson.Uint64(notBefore.Unix()),
+	}
+	scheduleReply := &ScheduleTxReply{}
+	require.NoError(service.ScheduleTx(nil, scheduleArgs, scheduleReply))
+	require.Equal(tx.ID(), scheduleReply.TxID)
+
+	err = service.ScheduleTx(nil, scheduleArgs, &ScheduleTxReply{})
+	require.ErrorIs(err, scheduler.ErrDuplicateTx)
+
+	getReply := &GetScheduledTxsReply{}
+	require.NoError(service.GetScheduledTxs(nil, nil, getReply))
+	require.Equal(
+		[]ScheduledTx{
+			{
+				TxID:      tx.ID(),
+				NotBefore: avajson.Uint64(notBefore.Unix()),
+			},
+		},
+		getReply.Txs,
+	)
+
+	// Only the submitter, who knows the cancel token, can cancel the tx.
+	cancelArgs := &CancelScheduledTxArgs{
+		TxID:        tx.ID(),
+		CancelToken: ids.GenerateTestID(),
+	}
+	err = service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{})
+	require.ErrorIs(err, scheduler.ErrInvalidCancelToken)
+
+	cancelArgs.CancelToken = scheduleReply.CancelToken
+	require.NoError(service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{}))
+	err = service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{})
+	require.ErrorIs(err, scheduler.ErrUnknownTx)
+
+	// Once the chain time has passed, the tx is issued over the wallet API.
+	scheduleArgs.NotBefore = 0
+	require.NoError(service.ScheduleTx(nil, scheduleArgs, &ScheduleTxReply{}))
+	require.Eventually(func() bool {
+		env.vm.ctx.Lock.Lock()
+		defer env.vm.ctx.Lock.Unlock()
+
+		_, ok := service.pendingTxs.Get(tx.ID())
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Zero(service.scheduledTxs.Len())
+}
+
+func TestWalletServiceScheduleTxDisabled(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+	})
+	service := &env.vm.walletService
+	env.vm.ctx.Lock.Unlock()
+
+	err := service.ScheduleTx(nil, &ScheduleTxArgs{}, &ScheduleTxReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+
+	err = service.GetScheduledTxs(nil, nil, &GetScheduledTxsReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+
+	err = service.CancelScheduledTx(nil, &CancelScheduledTxArgs{}, &api.EmptyReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	require := require.New(t)
 
@@ -463,6 +552,41 @@ func TestServiceGetTxFee(t *testing.T) {
 	require.Equal(avajson.Uint64(testTxFee), reply.CreateAssetTxFee)
 }
 
+func TestServiceEstimateFee(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.CreateAssetTxFee = 2 * testTxFee
+	env.vm.ctx.Lock.Unlock()
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "METAL")
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply := EstimateFeeReply{}
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+		Seconds:  60,
+	}, &reply))
+	require.Equal(avajson.Uint64(testTxFee), reply.Fee)
+	require.Equal(avajson.Uint64(testTxFee), reply.ProjectedFee)
+
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		TxType: createAssetTxType,
+	}, &reply))
+	require.Equal(avajson.Uint64(2*testTxFee), reply.Fee)
+	require.Equal(avajson.Uint64(2*testTxFee), reply.ProjectedFee)
+
+	err = service.EstimateFee(nil, &EstimateFeeArgs{
+		TxType: "unknown",
+	}, &reply)
+	require.ErrorIs(err, errUnknownTxType)
+}
+
 func TestServiceGetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -2256,6 +2380,206 @@ func TestServiceGetUTXOs(t *testing.T) {
 	}
 }
 
+func TestServiceGetNFTs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	key := keys[0]
+	initialStates := map[uint32][]verify.State{
+		1: {
+			&nftfx.MintOutput{
+				GroupID: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{key.PublicKey().Address()},
+				},
+			},
+		},
+	}
+	createAssetTx := newAvaxCreateAssetTxWithOutputs(t, env, initialStates)
+	issueAndAccept(require, env.vm, createAssetTx)
+
+	op := buildNFTxMintOp(createAssetTx, key, 1, 1)
+	mintNFTTx := buildOperationTxWithOps(t, env, op)
+	issueAndAccept(require, env.vm, mintNFTTx)
+
+	addrStr, err := env.vm.FormatLocalAddress(key.PublicKey().Address())
+	require.NoError(err)
+
+	tests := []struct {
+		name         string
+		assetID      string
+		expectedNFTs int
+	}{
+		{
+			name:         "all assets",
+			expectedNFTs: 1,
+		},
+		{
+			name:         "matching asset",
+			assetID:      createAssetTx.ID().String(),
+			expectedNFTs: 1,
+		},
+		{
+			name:         "other asset",
+			assetID:      env.genesisTx.ID().String(),
+			expectedNFTs: 0,
+		},
+	}
+	for _, test := range tests {
+		reply := &GetNFTsReply{}
+		require.NoError(service.GetNFTs(nil, &GetNFTsArgs{
+			JSONAddresses: api.JSONAddresses{Addresses: []string{addrStr}},
+			AssetID:       test.assetID,
+			Encoding:      formatting.Hex,
+		}, reply))
+		require.Len(reply.NFTs, test.expectedNFTs)
+		require.Equal(formatting.Hex, reply.Encoding)
+		if test.expectedNFTs == 0 {
+			continue
+		}
+
+		nft := reply.NFTs[0]
+		require.Equal(createAssetTx.ID(), nft.AssetID)
+		require.Equal(uint32(1), uint32(nft.GroupID))
+
+		payload, err := formatting.Decode(reply.Encoding, nft.Payload)
+		require.NoError(err)
+		require.Equal([]byte("hello"), payload)
+
+		require.Equal(Owner{
+			Threshold: 1,
+			Addresses: []string{addrStr},
+		}, nft.Owner)
+	}
+
+	err = service.GetNFTs(nil, &GetNFTsArgs{}, &GetNFTsReply{})
+	require.ErrorIs(err, errNoAddresses)
+}
+
+func TestServiceGetOwnedAssets(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	key := keys[0]
+	addr := key.PublicKey().Address()
+	initialStates := map[uint32][]verify.State{
+		1: {
+			&nftfx.MintOutput{
+				GroupID: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		},
+		2: {
+			&propertyfx.MintOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		},
+	}
+	createAssetTx := newAvaxCreateAssetTxWithOutputs(t, env, initialStates)
+	issueAndAccept(require, env.vm, createAssetTx)
+
+	mintNFTTx := buildOperationTxWithOps(t, env, buildNFTxMintOp(createAssetTx, key, 1, 1))
+	issueAndAccept(require, env.vm, mintNFTTx)
+
+	mintPropertyOp := buildPropertyFxMintOp(createAssetTx, key, 2)
+	mintPropertyOp.Op.(*propertyfx.MintOperation).OwnedOutput = propertyfx.OwnedOutput{
+		OutputOwners: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		},
+	}
+	mintPropertyTx := buildOperationTxWithOps(t, env, mintPropertyOp)
+	issueAndAccept(require, env.vm, mintPropertyTx)
+
+	env.vm.ctx.Lock.Lock()
+
+	// A UTXO with a 2 out of 2 multisig where one of the addresses is [addr]
+	env.vm.state.AddUTXO(&avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: avax.Asset{ID: createAssetTx.ID()},
+		Out: &nftfx.TransferOutput{
+			GroupID: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr, ids.GenerateTestShortID()},
+			},
+		},
+	})
+	require.NoError(env.vm.state.Commit())
+
+	env.vm.ctx.Lock.Unlock()
+
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	tests := []struct {
+		name           string
+		includePartial bool
+		expectedNFTs   uint64
+	}{
+		{
+			name:         "excluding partial",
+			expectedNFTs: 1,
+		},
+		{
+			name:           "including partial",
+			includePartial: true,
+			expectedNFTs:   2,
+		},
+	}
+	for _, test := range tests {
+		reply := &GetOwnedAssetsReply{}
+		require.NoError(service.GetOwnedAssets(nil, &GetOwnedAssetsArgs{
+			JSONAddresses:  api.JSONAddresses{Addresses: []string{addrStr}},
+			IncludePartial: test.includePartial,
+		}, reply))
+
+		assets := make(map[ids.ID]OwnedAsset)
+		for _, asset := range reply.Assets {
+			assets[asset.AssetID] = asset
+		}
+		avaxAsset := assets[env.genesisTx.ID()]
+		require.Equal("METAL", avaxAsset.Name)
+		require.Positive(uint64(avaxAsset.Balance))
+		require.Zero(uint64(avaxAsset.NFTs))
+
+		require.Equal(OwnedAsset{
+			FormattedAssetID: FormattedAssetID{
+				AssetID: createAssetTx.ID(),
+			},
+			Name:         "Team Rocket",
+			Symbol:       "TR",
+			Denomination: 0,
+			NFTs:         avajson.Uint64(test.expectedNFTs),
+			Properties:   1,
+		}, assets[createAssetTx.ID()])
+	}
+}
+
 func TestGetAssetDescription(t *testing.T) {
 	require := require.New(t)
 
@@ -2274,6 +2598,127 @@ func TestGetAssetDescription(t *testing.T) {
 
 	require.Equal("METAL", reply.Name)
 	require.Equal("SYMB", reply.Symbol)
+	require.Nil(reply.Supply)
+	require.Nil(reply.Holders)
+}
+
+func TestGetAssetDescriptionIndexed(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.AssetIndexEnabled = true
+	env := setup(t, &envConfig{
+		fork:            upgradetest.Latest,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	avaxAssetID := env.genesisTx.ID()
+
+	reply := GetAssetDescriptionReply{}
+	require.NoError(service.GetAssetDescription(nil, &GetAssetDescriptionArgs{
+		AssetID: avaxAssetID.String(),
+	}, &reply))
+
+	var (
+		expectedSupply  = avajson.Uint64(startBalance * uint64(len(keys)))
+		expectedHolders = avajson.Uint64(len(keys))
+	)
+	require.Equal("METAL", reply.Name)
+	require.Equal("SYMB", reply.Symbol)
+	require.Equal(&expectedSupply, reply.Supply)
+	require.Equal(&expectedHolders, reply.Holders)
+}
+
+func TestServiceGetAssetsNotIndexed(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: upgradetest.Latest,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	err := service.GetAssets(nil, &GetAssetsArgs{}, &GetAssetsReply{})
+	require.ErrorIs(err, state.ErrAssetsNotIndexed)
+}
+
+func TestServiceGetAssets(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.AssetIndexEnabled = true
+	env := setup(t, &envConfig{
+		fork:            upgradetest.Latest,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	createAssetTx := newAvaxCreateAssetTxWithOutputs(t, env, map[uint32][]verify.State{
+		0: {
+			&secp256k1fx.TransferOutput{
+				Amt: 1337,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+				},
+			},
+		},
+	})
+	issueAndAccept(require, env.vm, createAssetTx)
+
+	var (
+		expectedSupply  avajson.Uint64 = 1337
+		expectedHolders avajson.Uint64 = 1
+	)
+	reply := &GetAssetsReply{}
+	require.NoError(service.GetAssets(nil, &GetAssetsArgs{}, reply))
+	allAssets := reply.Assets
+	require.Len(allAssets, int(reply.NumFetched))
+	require.True(slices.IsSortedFunc(allAssets, func(a, b GetAssetDescriptionReply) int {
+		return a.AssetID.Compare(b.AssetID)
+	}))
+	require.Contains(allAssets, GetAssetDescriptionReply{
+		FormattedAssetID: FormattedAssetID{
+			AssetID: createAssetTx.ID(),
+		},
+		Name:         "Team Rocket",
+		Symbol:       "TR",
+		Denomination: 0,
+		Supply:       &expectedSupply,
+		Holders:      &expectedHolders,
+	})
+
+	// Page through the assets one at a time
+	var (
+		pagedAssets []GetAssetDescriptionReply
+		start       ids.ID
+	)
+	for {
+		reply := &GetAssetsReply{}
+		require.NoError(service.GetAssets(nil, &GetAssetsArgs{
+			StartAssetID: start,
+			Limit:        1,
+		}, reply))
+		if reply.NumFetched == 0 {
+			break
+		}
+		pagedAssets = append(pagedAssets, reply.Assets...)
+		start = reply.EndAssetID
+	}
+	require.Equal(allAssets, pagedAssets)
+
+	// Search ignores case and matches both names and symbols
+	for _, query := range []string{"rocket", "tr"} {
+		reply := &GetAssetsReply{}
+		require.NoError(service.GetAssets(nil, &GetAssetsArgs{
+			Query: query,
+		}, reply))
+		require.Len(reply.Assets, 1, query)
+		require.Equal(createAssetTx.ID(), reply.Assets[0].AssetID, query)
+	}
 }
 
 func TestGetBalance(t *testing.T) {
@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/nftfx"
+	"github.com/MetalBlockchain/metalgo/vms/propertyfx"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+
+	safemath "github.com/MetalBlockchain/metalgo/utils/math"
+)
+
+var ErrAssetsNotIndexed = errors.New("assets are not indexed")
+
+// Asset is the indexed description of an asset created by a CreateAssetTx.
+type Asset struct {
+	ID           ids.ID
+	Name         string `serialize:"true"`
+	Symbol       string `serialize:"true"`
+	Denomination byte   `serialize:"true"`
+	// Supply is the total amount of fungible tokens of the asset that have
+	// been minted.
+	Supply uint64 `serialize:"true"`
+	// Holders is the number of addresses that are an owner of at least one
+	// UTXO holding tokens, an NFT, or a property of the asset.
+	Holders uint64 `serialize:"true"`
+}
+
+func (s *state) GetAsset(assetID ids.ID) (*Asset, error) {
+	if !s.assetsIndexed {
+		return nil, ErrAssetsNotIndexed
+	}
+	return s.getAsset(assetID)
+}
+
+func (s *state) getAsset(assetID ids.ID) (*Asset, error) {
+	assetBytes, err := s.assetDB.Get(assetID[:])
+	if err != nil {
+		return nil, err
+	}
+	return s.parseAsset(assetID, assetBytes)
+}
+
+func (s *state) GetAssets(start ids.ID, limit int, filter func(*Asset) bool) ([]*Asset, error) {
+	if !s.assetsIndexed {
+		return nil, ErrAssetsNotIndexed
+	}
+
+	iter := s.assetDB.NewIteratorWithStart(start[:])
+	defer iter.Release()
+
+	var assets []*Asset
+	for len(assets) < limit && iter.Next() {
+		assetID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if assetID == start {
+			continue
+		}
+
+		asset, err := s.parseAsset(assetID, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil || filter(asset) {
+			assets = append(assets, asset)
+		}
+	}
+	return assets, iter.Error()
+}
+
+func (s *state) parseAsset(assetID ids.ID, assetBytes []byte) (*Asset, error) {
+	asset := &Asset{}
+	if _, err := s.parser.Codec().Unmarshal(assetBytes, asset); err != nil {
+		return nil, fmt.Errorf("failed to parse asset %s: %w", assetID, err)
+	}
+	asset.ID = assetID
+	return asset, nil
+}
+
+// getOrCreateAsset returns the indexed asset, or an empty asset if [assetID]
+// isn't indexed yet. Assets may be modified before the tx creating them is
+// indexed because the writes of a commit, and the txs indexed while building
+// the index, are unordered.
+func (s *state) getOrCreateAsset(assetID ids.ID) (*Asset, error) {
+	asset, err := s.getAsset(assetID)
+	if err == database.ErrNotFound {
+		return &Asset{ID: assetID}, nil
+	}
+	return asset, err
+}
+
+func (s *state) putAsset(asset *Asset) error {
+	assetBytes, err := s.parser.Codec().Marshal(txs.CodecVersion, asset)
+	if err != nil {
+		return fmt.Errorf("failed to marshal asset %s: %w", asset.ID, err)
+	}
+	return s.assetDB.Put(asset.ID[:], assetBytes)
+}
+
+// isAssetIndexed returns true if the effects of [txID] on the asset index
+// should be written. While the index is being built, txs after the cursor are
+// skipped as they will be indexed once the cursor reaches them.
+func (s *state) isAssetIndexed(txID ids.ID) bool {
+	if !s.indexAssets {
+		return false
+	}
+	return s.assetsIndexed || (s.assetIndexStarted && txID.Compare(s.assetCursor) <= 0)
+}
+
+// indexTx indexes the assets created and the tokens minted by [tx].
+func (s *state) indexTx(tx *txs.Tx) error {
+	if !s.isAssetIndexed(tx.ID()) {
+		return nil
+	}
+
+	switch utx := tx.Unsigned.(type) {
+	case *txs.CreateAssetTx:
+		asset, err := s.getOrCreateAsset(tx.ID())
+		if err != nil {
+			return err
+		}
+		asset.Name = utx.Name
+		asset.Symbol = utx.Symbol
+		asset.Denomination = utx.Denomination
+		for _, initialState := range utx.States {
+			for _, out := range initialState.Outs {
+				if out, ok := out.(*secp256k1fx.TransferOutput); ok {
+					asset.Supply = addSupply(asset.Supply, out.Amt)
+				}
+			}
+		}
+		return s.putAsset(asset)
+	case *txs.OperationTx:
+		for _, op := range utx.Ops {
+			mintOp, ok := op.Op.(*secp256k1fx.MintOperation)
+			if !ok {
+				continue
+			}
+			asset, err := s.getOrCreateAsset(op.AssetID())
+			if err != nil {
+				return err
+			}
+			asset.Supply = addSupply(asset.Supply, mintOp.TransferOutput.Amt)
+			if err := s.putAsset(asset); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addSupply returns [supply] + [amount], capped at the max uint64, as variable
+// cap assets may mint without bound.
+func addSupply(supply, amount uint64) uint64 {
+	supply, err := safemath.Add(supply, amount)
+	if err != nil {
+		return math.MaxUint64
+	}
+	return supply
+}
+
+// indexUTXO indexes the holders of [utxo], which was either [added] or
+// removed.
+func (s *state) indexUTXO(utxo *avax.UTXO, added bool) error {
+	if !s.isAssetIndexed(utxo.TxID) {
+		return nil
+	}
+	return s.updateHolders(utxo, added)
+}
+
+// updateHolders increments, if [added], or decrements the number of UTXOs of
+// the asset of [utxo] that are owned by each of the owners of [utxo]. The holder
+// count of the asset is updated when an address starts or stops owning its
+// UTXOs.
+func (s *state) updateHolders(utxo *avax.UTXO, added bool) error {
+	var owners *secp256k1fx.OutputOwners
+	switch out := utxo.Out.(type) {
+	case *secp256k1fx.TransferOutput:
+		owners = &out.OutputOwners
+	case *nftfx.TransferOutput:
+		owners = &out.OutputOwners
+	case *propertyfx.OwnedOutput:
+		owners = &out.OutputOwners
+	default:
+		return nil
+	}
+
+	assetID := utxo.AssetID()
+	asset, err := s.getOrCreateAsset(assetID)
+	if err != nil {
+		return err
+	}
+	for addr := range owners.AddressesSet() {
+		key := make([]byte, 0, ids.IDLen+ids.ShortIDLen)
+		key = append(key, assetID[:]...)
+		key = append(key, addr[:]...)
+
+		numUTXOs, err := database.WithDefault(database.GetUInt64, s.assetHolderDB, key, 0)
+		if err != nil {
+			return err
+		}
+		switch {
+		case added:
+			if numUTXOs == 0 {
+				asset.Holders++
+			}
+			numUTXOs++
+		case numUTXOs == 0:
+			// Never underflow, even if the index is missing this UTXO.
+			continue
+		default:
+			numUTXOs--
+			if numUTXOs == 0 {
+				asset.Holders--
+			}
+		}
+
+		if numUTXOs == 0 {
+			err = s.assetHolderDB.Delete(key)
+		} else {
+			err = database.PutUInt64(s.assetHolderDB, key, numUTXOs)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return s.putAsset(asset)
+}
+
+func (s *state) IndexAssets(limit int) (bool, error) {
+	if !s.indexAssets || s.assetsIndexed {
+		return true, nil
+	}
+
+	// Remove the index left by a previous run before the index was disabled.
+	if !s.assetIndexStarted {
+		for _, db := range []database.Database{s.assetDB, s.assetHolderDB} {
+			numDeleted, err := deleteKeys(db, limit)
+			if err != nil || numDeleted > 0 {
+				return false, err
+			}
+		}
+
+		s.assetIndexStarted = true
+		s.assetCursor = ids.Empty
+	}
+
+	iter := s.txDB.NewIteratorWithStart(s.assetCursor[:])
+	defer iter.Release()
+
+	numIndexed := 0
+	for numIndexed < limit && iter.Next() {
+		txID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return false, err
+		}
+		if txID == s.assetCursor {
+			continue
+		}
+
+		tx, err := s.parser.ParseGenesisTx(iter.Value())
+		if err != nil {
+			return false, fmt.Errorf("failed to parse tx %s: %w", txID, err)
+		}
+
+		// Moving the cursor first causes [tx] to be indexed.
+		s.assetCursor = txID
+		if err := s.indexTx(tx); err != nil {
+			return false, fmt.Errorf("failed to index tx %s: %w", txID, err)
+		}
+		for _, utxo := range tx.UTXOs() {
+			// Only UTXOs that haven't been spent are held.
+			_, err := s.utxoState.GetUTXO(utxo.InputID())
+			if err == database.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			if err := s.indexUTXO(utxo, true); err != nil {
+				return false, err
+			}
+		}
+		numIndexed++
+	}
+	if err := iter.Error(); err != nil {
+		return false, err
+	}
+
+	if numIndexed < limit {
+		s.assetsIndexed = true
+		return true, errors.Join(
+			s.singletonDB.Put(assetsIndexedKey, nil),
+			s.singletonDB.Delete(assetCursorKey),
+		)
+	}
+	return false, database.PutID(s.singletonDB, assetCursorKey, s.assetCursor)
+}
+
+// deleteKeys deletes at most [limit] keys from [db] and returns the number of
+// deleted keys.
+func deleteKeys(db database.Database, limit int) (int, error) {
+	iter := db.NewIterator()
+	var keys [][]byte
+	for len(keys) < limit && iter.Next() {
+		keys = append(keys, slices.Clone(iter.Key()))
+	}
+	err := iter.Error()
+	iter.Release()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if err := db.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	return len(keys), nil
+}
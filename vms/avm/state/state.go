@@ -29,15 +29,19 @@ const (
 )
 
 var (
-	utxoPrefix      = []byte("utxo")
-	txPrefix        = []byte("tx")
-	blockIDPrefix   = []byte("blockID")
-	blockPrefix     = []byte("block")
-	singletonPrefix = []byte("singleton")
+	utxoPrefix        = []byte("utxo")
+	txPrefix          = []byte("tx")
+	blockIDPrefix     = []byte("blockID")
+	blockPrefix       = []byte("block")
+	singletonPrefix   = []byte("singleton")
+	assetPrefix       = []byte("asset")
+	assetHolderPrefix = []byte("assetHolder")
 
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
+	assetsIndexedKey = []byte{0x03}
+	assetCursorKey   = []byte{0x04}
 
 	_ State = (*state)(nil)
 )
@@ -93,6 +97,22 @@ type State interface {
 	// Checksum returns the current state checksum.
 	Checksum() ids.ID
 
+	// IndexAssets continues to build the asset index of a database that was
+	// initialized before the index was enabled, by indexing at most [limit]
+	// accepted txs. The progress is written on the next commit. Returns true
+	// once the index is built.
+	IndexAssets(limit int) (bool, error)
+
+	// GetAsset returns the indexed description of [assetID], as of the last
+	// commit. Returns [ErrAssetsNotIndexed] if the index isn't built.
+	GetAsset(assetID ids.ID) (*Asset, error)
+
+	// GetAssets returns the indexed descriptions of the assets, as of the last
+	// commit, ordered by ID and starting after [start]. Only assets that match
+	// [filter], if provided, are returned. Returns at most [limit] assets.
+	// Returns [ErrAssetsNotIndexed] if the index isn't built.
+	GetAssets(start ids.ID, limit int, filter func(*Asset) bool) ([]*Asset, error)
+
 	Close() error
 }
 
@@ -106,10 +126,16 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. assets
+ * | '-- assetID -> asset bytes
+ * |-. assetHolders
+ * | '-- assetID + address -> number of UTXOs
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   |-- assetsIndexedKey -> nil
+ *   '-- assetCursorKey -> ID of the last tx indexed while building the index
  */
 type state struct {
 	parser block.Parser
@@ -131,6 +157,14 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, block.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	// [indexAssets] is true if the asset index is enabled. While the index
+	// is being built, only the txs, and the UTXOs they produced, with an ID
+	// less than or equal to [assetCursor] are indexed.
+	indexAssets, assetsIndexed, assetIndexStarted bool
+	assetCursor                                   ids.ID
+	assetDB                                       database.Database
+	assetHolderDB                                 database.Database
+
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
@@ -142,12 +176,15 @@ func New(
 	parser block.Parser,
 	metrics prometheus.Registerer,
 	trackChecksums bool,
+	indexAssets bool,
 ) (State, error) {
 	utxoDB := prefixdb.New(utxoPrefix, db)
 	txDB := prefixdb.New(txPrefix, db)
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
+	assetDB := prefixdb.New(assetPrefix, db)
+	assetHolderDB := prefixdb.New(assetHolderPrefix, db)
 
 	txCache, err := metercacher.New[ids.ID, *txs.Tx](
 		"tx_cache",
@@ -181,7 +218,7 @@ func New(
 		return nil, err
 	}
 
-	return &state{
+	s := &state{
 		parser: parser,
 		db:     db,
 
@@ -201,8 +238,56 @@ func New(
 		blockCache:  blockCache,
 		blockDB:     blockDB,
 
+		indexAssets:   indexAssets,
+		assetDB:       assetDB,
+		assetHolderDB: assetHolderDB,
+
 		singletonDB: singletonDB,
-	}, nil
+	}
+	return s, s.initAssets()
+}
+
+// initAssets loads the progress of the asset index.
+//
+// If the index is disabled, its progress is reset so that it is rebuilt if it
+// is enabled again. If the index is enabled on a new database, it is built as
+// the genesis is written.
+func (s *state) initAssets() error {
+	if !s.indexAssets {
+		return errors.Join(
+			s.singletonDB.Delete(assetsIndexedKey),
+			s.singletonDB.Delete(assetCursorKey),
+		)
+	}
+
+	indexed, err := s.singletonDB.Has(assetsIndexedKey)
+	if err != nil {
+		return err
+	}
+	if indexed {
+		s.assetsIndexed = true
+		return nil
+	}
+
+	initialized, err := s.IsInitialized()
+	if err != nil {
+		return err
+	}
+	if !initialized {
+		s.assetsIndexed = true
+		return s.singletonDB.Put(assetsIndexedKey, nil)
+	}
+
+	s.assetCursor, err = database.GetID(s.singletonDB, assetCursorKey)
+	switch err {
+	case nil:
+		s.assetIndexStarted = true
+		return nil
+	case database.ErrNotFound:
+		return nil
+	default:
+		return err
+	}
 }
 
 func (s *state) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
@@ -420,6 +505,8 @@ func (s *state) Close() error {
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
 		s.singletonDB.Close(),
+		s.assetDB.Close(),
+		s.assetHolderDB.Close(),
 		s.db.Close(),
 	)
 }
@@ -442,10 +529,28 @@ func (s *state) writeUTXOs() error {
 			if err := s.utxoState.PutUTXO(utxo); err != nil {
 				return fmt.Errorf("failed to add utxo: %w", err)
 			}
-		} else {
-			if err := s.utxoState.DeleteUTXO(utxoID); err != nil {
-				return fmt.Errorf("failed to remove utxo: %w", err)
+			if err := s.indexUTXO(utxo, true); err != nil {
+				return fmt.Errorf("failed to index utxo: %w", err)
 			}
+			continue
+		}
+
+		if s.indexAssets {
+			// The UTXO must be read before it is deleted to update the
+			// holders of its asset.
+			utxo, err := s.utxoState.GetUTXO(utxoID)
+			if err == database.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get utxo: %w", err)
+			}
+			if err := s.indexUTXO(utxo, false); err != nil {
+				return fmt.Errorf("failed to index utxo: %w", err)
+			}
+		}
+		if err := s.utxoState.DeleteUTXO(utxoID); err != nil {
+			return fmt.Errorf("failed to remove utxo: %w", err)
 		}
 	}
 	return nil
@@ -460,6 +565,9 @@ func (s *state) writeTxs() error {
 		if err := s.txDB.Put(txID[:], txBytes); err != nil {
 			return fmt.Errorf("failed to add tx: %w", err)
 		}
+		if err := s.indexTx(tx); err != nil {
+			return fmt.Errorf("failed to index tx: %w", err)
+		}
 	}
 	return nil
 }
@@ -19,10 +19,14 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/avm/fxs"
 	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 )
 
-const trackChecksums = false
+const (
+	trackChecksums = false
+	indexAssets    = true
+)
 
 var (
 	parser             block.Parser
@@ -102,7 +106,7 @@ func TestState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -110,7 +114,7 @@ func TestState(t *testing.T) {
 	s.AddBlock(populatedBlk)
 	require.NoError(s.Commit())
 
-	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
 	require.NoError(err)
 
 	ChainUTXOTest(t, s)
@@ -123,7 +127,7 @@ func TestDiff(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -283,7 +287,7 @@ func TestInitializeChainState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
 	require.NoError(err)
 
 	stopVertexID := ids.GenerateTestID()
@@ -316,3 +320,244 @@ func TestInitializeChainState(t *testing.T) {
 	require.NoError(err)
 	require.Equal(genesis.ID(), lastAccepted.Parent())
 }
+
+func TestAssetIndex(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
+	require.NoError(err)
+	require.NoError(s.SetInitialized())
+
+	var (
+		addr0 = ids.GenerateTestShortID()
+		addr1 = ids.GenerateTestShortID()
+	)
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+		}},
+		Name:         "Team Rocket",
+		Symbol:       "TR",
+		Denomination: 2,
+		States: []*txs.InitialState{{
+			Outs: []verify.State{
+				&secp256k1fx.MintOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr0},
+					},
+				},
+				&secp256k1fx.TransferOutput{
+					Amt: 100,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr0},
+					},
+				},
+			},
+		}},
+	}}
+	require.NoError(createAssetTx.Initialize(parser.Codec()))
+	assetID := createAssetTx.ID()
+
+	s.AddTx(createAssetTx)
+	createdUTXOs := createAssetTx.UTXOs()
+	for _, utxo := range createdUTXOs {
+		s.AddUTXO(utxo)
+	}
+	require.NoError(s.Commit())
+
+	asset, err := s.GetAsset(assetID)
+	require.NoError(err)
+	require.Equal(&Asset{
+		ID:           assetID,
+		Name:         "Team Rocket",
+		Symbol:       "TR",
+		Denomination: 2,
+		Supply:       100,
+		Holders:      1,
+	}, asset)
+
+	mintTx := &txs.Tx{Unsigned: &txs.OperationTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+		}},
+		Ops: []*txs.Operation{{
+			Asset:   avax.Asset{ID: assetID},
+			UTXOIDs: []*avax.UTXOID{&createdUTXOs[0].UTXOID},
+			Op: &secp256k1fx.MintOperation{
+				MintOutput: secp256k1fx.MintOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr0},
+					},
+				},
+				TransferOutput: secp256k1fx.TransferOutput{
+					Amt: 50,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr0, addr1},
+					},
+				},
+			},
+		}},
+	}}
+	require.NoError(mintTx.Initialize(parser.Codec()))
+
+	s.AddTx(mintTx)
+	s.DeleteUTXO(createdUTXOs[0].InputID())
+	for _, utxo := range mintTx.UTXOs() {
+		s.AddUTXO(utxo)
+	}
+	require.NoError(s.Commit())
+
+	asset, err = s.GetAsset(assetID)
+	require.NoError(err)
+	require.Equal(uint64(150), asset.Supply)
+	require.Equal(uint64(2), asset.Holders)
+
+	// Spending the only UTXO held solely by [addr0] doesn't remove [addr0] as
+	// a holder.
+	s.DeleteUTXO(createdUTXOs[1].InputID())
+	require.NoError(s.Commit())
+
+	asset, err = s.GetAsset(assetID)
+	require.NoError(err)
+	require.Equal(uint64(150), asset.Supply)
+	require.Equal(uint64(2), asset.Holders)
+
+	assets, err := s.GetAssets(ids.Empty, 10, func(asset *Asset) bool {
+		return asset.Symbol == "TR"
+	})
+	require.NoError(err)
+	require.Equal([]*Asset{asset}, assets)
+
+	assets, err = s.GetAssets(assetID, 10, nil)
+	require.NoError(err)
+	require.Empty(assets)
+
+	// Disabling the index discards it.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
+	require.NoError(err)
+	require.NoError(s.Commit())
+
+	_, err = s.GetAsset(assetID)
+	require.ErrorIs(err, ErrAssetsNotIndexed)
+	_, err = s.GetAssets(ids.Empty, 10, nil)
+	require.ErrorIs(err, ErrAssetsNotIndexed)
+
+	done, err := s.IndexAssets(1)
+	require.NoError(err)
+	require.True(done)
+
+	// Re-enabling the index rebuilds it from the accepted txs, one tx at a
+	// time.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
+	require.NoError(err)
+	require.NoError(s.Commit())
+
+	_, err = s.GetAsset(assetID)
+	require.ErrorIs(err, ErrAssetsNotIndexed)
+
+	var (
+		mintedUTXOs = mintTx.UTXOs()
+		spent       bool
+	)
+	for done = false; !done; {
+		done, err = s.IndexAssets(1)
+		require.NoError(err)
+
+		// UTXOs spent while the index is being built are removed from the
+		// index regardless of whether their tx was indexed yet.
+		if !spent {
+			s.DeleteUTXO(mintedUTXOs[1].InputID())
+			spent = true
+		}
+		require.NoError(s.Commit())
+	}
+
+	reindexedAsset, err := s.GetAsset(assetID)
+	require.NoError(err)
+	require.Equal(&Asset{
+		ID:           assetID,
+		Name:         "Team Rocket",
+		Symbol:       "TR",
+		Denomination: 2,
+		Supply:       150,
+		Holders:      0,
+	}, reindexedAsset)
+
+	// The built index is loaded after a restart.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
+	require.NoError(err)
+
+	reindexedAsset, err = s.GetAsset(assetID)
+	require.NoError(err)
+	require.Equal(uint64(150), reindexedAsset.Supply)
+}
+
+func TestAssetIndexResumes(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false)
+	require.NoError(err)
+	require.NoError(s.SetInitialized())
+
+	const numAssets = 5
+	for i := 0; i < numAssets; i++ {
+		tx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				BlockchainID: ids.GenerateTestID(),
+			}},
+			Name:   "Team Rocket",
+			Symbol: "TR",
+			States: []*txs.InitialState{{
+				Outs: []verify.State{
+					&secp256k1fx.TransferOutput{
+						Amt: 100,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+						},
+					},
+				},
+			}},
+		}}
+		require.NoError(tx.Initialize(parser.Codec()))
+
+		s.AddTx(tx)
+		for _, utxo := range tx.UTXOs() {
+			s.AddUTXO(utxo)
+		}
+	}
+	require.NoError(s.Commit())
+
+	// Index a single batch before restarting.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
+	require.NoError(err)
+
+	done, err := s.IndexAssets(2)
+	require.NoError(err)
+	require.False(done)
+	require.NoError(s.Commit())
+
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, indexAssets)
+	require.NoError(err)
+
+	done, err = s.IndexAssets(numAssets)
+	require.NoError(err)
+	require.True(done)
+	require.NoError(s.Commit())
+
+	assets, err := s.GetAssets(ids.Empty, numAssets+1, nil)
+	require.NoError(err)
+	require.Len(assets, numAssets)
+	for _, asset := range assets {
+		require.Equal(uint64(100), asset.Supply)
+		require.Equal(uint64(1), asset.Holders)
+	}
+}
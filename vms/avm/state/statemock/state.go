@@ -16,6 +16,7 @@ import (
 	database "github.com/MetalBlockchain/metalgo/database"
 	ids "github.com/MetalBlockchain/metalgo/ids"
 	block "github.com/MetalBlockchain/metalgo/vms/avm/block"
+	state "github.com/MetalBlockchain/metalgo/vms/avm/state"
 	txs "github.com/MetalBlockchain/metalgo/vms/avm/txs"
 	avax "github.com/MetalBlockchain/metalgo/vms/components/avax"
 	gomock "go.uber.org/mock/gomock"
@@ -162,6 +163,36 @@ func (mr *StateMockRecorder) DeleteUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*State)(nil).DeleteUTXO), utxoID)
 }
 
+// GetAsset mocks base method.
+func (m *State) GetAsset(assetID ids.ID) (*state.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsset", assetID)
+	ret0, _ := ret[0].(*state.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsset indicates an expected call of GetAsset.
+func (mr *StateMockRecorder) GetAsset(assetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsset", reflect.TypeOf((*State)(nil).GetAsset), assetID)
+}
+
+// GetAssets mocks base method.
+func (m *State) GetAssets(start ids.ID, limit int, filter func(*state.Asset) bool) ([]*state.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssets", start, limit, filter)
+	ret0, _ := ret[0].([]*state.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssets indicates an expected call of GetAssets.
+func (mr *StateMockRecorder) GetAssets(start, limit, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssets", reflect.TypeOf((*State)(nil).GetAssets), start, limit, filter)
+}
+
 // GetBlock mocks base method.
 func (m *State) GetBlock(blkID ids.ID) (block.Block, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +281,21 @@ func (mr *StateMockRecorder) GetUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*State)(nil).GetUTXO), utxoID)
 }
 
+// IndexAssets mocks base method.
+func (m *State) IndexAssets(limit int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexAssets", limit)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexAssets indicates an expected call of IndexAssets.
+func (mr *StateMockRecorder) IndexAssets(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexAssets", reflect.TypeOf((*State)(nil).IndexAssets), limit)
+}
+
 // InitializeChainState mocks base method.
 func (m *State) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
 	m.ctrl.T.Helper()
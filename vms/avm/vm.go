@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"github.com/prometheus/client_golang/prometheus"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/MetalBlockchain/metalgo/api/metrics"
 	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/database/prefixdb"
 	"github.com/MetalBlockchain/metalgo/database/versiondb"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow"
@@ -36,6 +38,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/avm/utxo"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
 	"github.com/MetalBlockchain/metalgo/vms/txs/mempool"
+	"github.com/MetalBlockchain/metalgo/vms/txs/scheduler"
 
 	blockbuilder "github.com/MetalBlockchain/metalgo/vms/avm/block/builder"
 	blockexecutor "github.com/MetalBlockchain/metalgo/vms/avm/block/executor"
@@ -45,7 +48,18 @@ import (
 	xmempool "github.com/MetalBlockchain/metalgo/vms/avm/txs/mempool"
 )
 
+const (
+	// assetIndexBatchSize is the number of txs indexed per commit while
+	// building the asset index.
+	assetIndexBatchSize = 1024
+	// assetIndexLogFrequency is the number of batches between progress logs
+	// while building the asset index.
+	assetIndexLogFrequency = 100
+)
+
 var (
+	scheduledTxsPrefix = []byte("scheduledTxs")
+
 	errIncompatibleFx            = errors.New("incompatible feature extension")
 	errUnknownFx                 = errors.New("unknown feature extension")
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
@@ -211,6 +225,7 @@ func (vm *VM) Initialize(
 		vm.parser,
 		vm.registerer,
 		avmConfig.ChecksumsEnabled,
+		avmConfig.AssetIndexEnabled,
 	)
 	if err != nil {
 		return err
@@ -224,6 +239,15 @@ func (vm *VM) Initialize(
 
 	vm.walletService.vm = vm
 	vm.walletService.pendingTxs = linked.NewHashmap[ids.ID, *txs.Tx]()
+	if avmConfig.ScheduledTxsEnabled {
+		vm.walletService.scheduledTxs, err = scheduler.New(
+			prefixdb.New(scheduledTxsPrefix, vm.baseDB),
+			vm.parser.ParseTx,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to load scheduled txs: %w", err)
+		}
+	}
 
 	vm.txBackend = &txexecutor.Backend{
 		Ctx:           ctx,
@@ -237,7 +261,66 @@ func (vm *VM) Initialize(
 
 	vm.onShutdownCtx, vm.onShutdownCtxCancel = context.WithCancel(context.Background())
 	vm.networkConfig = avmConfig.Network
-	return vm.state.Commit()
+	if err := vm.state.Commit(); err != nil {
+		return err
+	}
+
+	if avmConfig.AssetIndexEnabled {
+		// This goroutine isn't tracked by [vm.awaitShutdown] because Shutdown
+		// is called while holding the context lock, which this goroutine
+		// may be waiting on. The context is instead checked after grabbing
+		// the lock.
+		go vm.indexAssets(vm.onShutdownCtx)
+	}
+	return nil
+}
+
+// indexAssets builds the asset index, if it isn't built yet, in batches of
+// [assetIndexBatchSize] txs. The context lock is released between batches so
+// that the chain keeps making progress.
+func (vm *VM) indexAssets(ctx context.Context) {
+	var (
+		start      = time.Now()
+		numBatches = 0
+	)
+	for {
+		vm.ctx.Lock.Lock()
+		if ctx.Err() != nil {
+			vm.ctx.Lock.Unlock()
+			return
+		}
+
+		done, err := vm.state.IndexAssets(assetIndexBatchSize)
+		if err == nil {
+			err = vm.state.Commit()
+		} else {
+			vm.state.Abort()
+		}
+		vm.ctx.Lock.Unlock()
+		if err != nil {
+			vm.ctx.Log.Error("failed to index assets",
+				zap.Error(err),
+			)
+			return
+		}
+		if done {
+			break
+		}
+
+		numBatches++
+		if numBatches%assetIndexLogFrequency == 0 {
+			vm.ctx.Log.Info("indexing assets",
+				zap.Int("numIndexedTxs", numBatches*assetIndexBatchSize),
+				zap.Duration("duration", time.Since(start)),
+			)
+		}
+	}
+
+	if numBatches > 0 {
+		vm.ctx.Log.Info("indexed assets",
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
 }
 
 // onBootstrapStarted is called by the consensus engine when it starts bootstrapping this chain
@@ -258,6 +341,16 @@ func (vm *VM) onNormalOperationsStarted() error {
 			return err
 		}
 	}
+
+	// Scheduled txs are only issued once the chain is bootstrapped, as they
+	// would otherwise be issued against a stale chain time.
+	//
+	// Incrementing [awaitShutdown] would cause a deadlock since the scheduled
+	// txs grab the context lock.
+	if vm.walletService.scheduledTxs != nil && !vm.walletService.runningScheduledTxs {
+		vm.walletService.runningScheduledTxs = true
+		go vm.walletService.runScheduledTxs(vm.onShutdownCtx)
+	}
 	return nil
 }
 
@@ -6,11 +6,13 @@ package avm
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/MetalBlockchain/metalgo/api"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/formatting"
+	"github.com/MetalBlockchain/metalgo/utils/json"
 	"github.com/MetalBlockchain/metalgo/utils/rpc"
 )
 
@@ -52,3 +54,38 @@ func (c *WalletClient) IssueTx(ctx context.Context, txBytes []byte, options ...r
 	}, res, options...)
 	return res.TxID, err
 }
+
+// ScheduleTx stores a signed transaction on the node, which issues it once the
+// chain time reaches [notBefore], and returns the TxID along with the token
+// required to cancel it
+func (c *WalletClient) ScheduleTx(ctx context.Context, txBytes []byte, notBefore time.Time, options ...rpc.Option) (ids.ID, ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+	res := &ScheduleTxReply{}
+	err = c.Requester.SendRequest(ctx, "wallet.scheduleTx", &ScheduleTxArgs{
+		Tx:        txStr,
+		Encoding:  formatting.Hex,
+		NotBefore: json.Uint64(notBefore.Unix()),
+	}, res, options...)
+	return res.TxID, res.CancelToken, err
+}
+
+// GetScheduledTxs returns the IDs of the transactions that are scheduled on the
+// node
+func (c *WalletClient) GetScheduledTxs(ctx context.Context, options ...rpc.Option) (*GetScheduledTxsReply, error) {
+	res := &GetScheduledTxsReply{}
+	err := c.Requester.SendRequest(ctx, "wallet.getScheduledTxs", struct{}{}, res, options...)
+	return res, err
+}
+
+// CancelScheduledTx removes a transaction that hasn't been issued yet from the
+// transactions that are scheduled on the node. [cancelToken] is the token that
+// was returned when the transaction was scheduled.
+func (c *WalletClient) CancelScheduledTx(ctx context.Context, txID ids.ID, cancelToken ids.ID, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "wallet.cancelScheduledTx", &CancelScheduledTxArgs{
+		TxID:        txID,
+		CancelToken: cancelToken,
+	}, &api.EmptyReply{}, options...)
+}
@@ -4,9 +4,11 @@
 package avm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -17,11 +19,26 @@ import (
 	"github.com/MetalBlockchain/metalgo/utils/logging"
 	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/txs/mempool"
+	"github.com/MetalBlockchain/metalgo/vms/txs/scheduler"
+
+	avajson "github.com/MetalBlockchain/metalgo/utils/json"
+	blockexecutor "github.com/MetalBlockchain/metalgo/vms/avm/block/executor"
+	txexecutor "github.com/MetalBlockchain/metalgo/vms/avm/txs/executor"
 )
 
+// scheduledTxsFrequency is how often the scheduled txs are checked to see if
+// they can be issued.
+const scheduledTxsFrequency = time.Second
+
+var errScheduledTxsDisabled = errors.New("scheduled txs are disabled")
+
 type WalletService struct {
 	vm         *VM
 	pendingTxs *linked.Hashmap[ids.ID, *txs.Tx]
+	// scheduledTxs is nil unless scheduled txs are enabled.
+	scheduledTxs *scheduler.Queue[*txs.Tx]
+	// runningScheduledTxs is set once the scheduled txs started being issued.
+	runningScheduledTxs bool
 }
 
 func (w *WalletService) decided(txID ids.ID) {
@@ -117,3 +134,169 @@ func (w *WalletService) IssueTx(_ *http.Request, args *api.FormattedTx, reply *a
 	reply.TxID = txID
 	return err
 }
+
+// runScheduledTxs issues the scheduled txs once the chain time reaches their
+// not before time, until [ctx] is cancelled.
+//
+// Invariant: This function is only called after Linearize has been called.
+// Because it grabs the context lock, the context is checked after grabbing the
+// lock rather than waiting for this function to return during Shutdown.
+func (w *WalletService) runScheduledTxs(ctx context.Context) {
+	w.scheduledTxs.Run(
+		ctx,
+		w.vm.ctx.Log,
+		scheduledTxsFrequency,
+		func() (time.Time, error) {
+			w.vm.ctx.Lock.Lock()
+			defer w.vm.ctx.Lock.Unlock()
+
+			if err := ctx.Err(); err != nil {
+				return time.Time{}, err
+			}
+
+			// The next block will have a timestamp of at least
+			// max(now, chain time).
+			chainTime := w.vm.state.GetTimestamp()
+			if now := w.vm.clock.Time(); now.After(chainTime) {
+				chainTime = now
+			}
+			return chainTime, nil
+		},
+		func(tx *txs.Tx) error {
+			w.vm.ctx.Lock.Lock()
+			defer w.vm.ctx.Lock.Unlock()
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			_, err := w.issue(tx)
+			return err
+		},
+		isRetryableScheduledTxErr,
+	)
+}
+
+// isRetryableScheduledTxErr returns true if a scheduled tx that failed to be
+// issued with [err] may be issued successfully later.
+func isRetryableScheduledTxErr(err error) bool {
+	return errors.Is(err, blockexecutor.ErrChainNotSynced) ||
+		errors.Is(err, mempool.ErrMempoolFull) ||
+		errors.Is(err, mempool.ErrConflictsWithOtherTx)
+}
+
+type ScheduleTxArgs struct {
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+	// NotBefore is the unix time, in seconds, before which the tx must not be
+	// issued.
+	NotBefore avajson.Uint64 `json:"notBefore"`
+}
+
+type ScheduleTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// CancelToken must be provided to CancelScheduledTx to cancel the tx.
+	CancelToken ids.ID `json:"cancelToken"`
+}
+
+// ScheduleTx stores a signed transaction on this node and issues it once the
+// chain time reaches [NotBefore].
+func (w *WalletService) ScheduleTx(_ *http.Request, args *ScheduleTxArgs, reply *ScheduleTxReply) error {
+	w.vm.ctx.Log.Debug("API called",
+		zap.String("service", "wallet"),
+		zap.String("method", "scheduleTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	if w.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx, err := w.vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	w.vm.ctx.Lock.Lock()
+	defer w.vm.ctx.Lock.Unlock()
+
+	err = tx.Unsigned.Visit(&txexecutor.SyntacticVerifier{
+		Backend: w.vm.txBackend,
+		Tx:      tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	notBefore := time.Unix(int64(args.NotBefore), 0)
+	cancelToken, err := w.scheduledTxs.Add(tx, notBefore)
+	if err != nil {
+		return err
+	}
+
+	reply.TxID = tx.ID()
+	reply.CancelToken = cancelToken
+	w.vm.ctx.Log.Info("scheduled tx over wallet API",
+		zap.Stringer("txID", reply.TxID),
+		zap.Time("notBefore", notBefore),
+	)
+	return nil
+}
+
+type ScheduledTx struct {
+	TxID      ids.ID         `json:"txID"`
+	NotBefore avajson.Uint64 `json:"notBefore"`
+}
+
+type GetScheduledTxsReply struct {
+	Txs []ScheduledTx `json:"txs"`
+}
+
+// GetScheduledTxs returns the IDs of the transactions that are scheduled on
+// this node, ordered by their not before time.
+func (w *WalletService) GetScheduledTxs(_ *http.Request, _ *struct{}, reply *GetScheduledTxsReply) error {
+	w.vm.ctx.Log.Debug("API called",
+		zap.String("service", "wallet"),
+		zap.String("method", "getScheduledTxs"),
+	)
+
+	if w.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+
+	entries := w.scheduledTxs.List()
+	reply.Txs = make([]ScheduledTx, len(entries))
+	for i, entry := range entries {
+		reply.Txs[i] = ScheduledTx{
+			TxID:      entry.Tx.ID(),
+			NotBefore: avajson.Uint64(entry.NotBefore.Unix()),
+		}
+	}
+	return nil
+}
+
+type CancelScheduledTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// CancelToken is the token that was returned when the tx was scheduled.
+	CancelToken ids.ID `json:"cancelToken"`
+}
+
+// CancelScheduledTx removes a transaction that hasn't been issued yet from the
+// scheduled transactions.
+func (w *WalletService) CancelScheduledTx(_ *http.Request, args *CancelScheduledTxArgs, _ *api.EmptyReply) error {
+	w.vm.ctx.Log.Debug("API called",
+		zap.String("service", "wallet"),
+		zap.String("method", "cancelScheduledTx"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if w.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+	return w.scheduledTxs.Cancel(args.TxID, args.CancelToken)
+}
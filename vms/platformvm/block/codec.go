@@ -36,6 +36,7 @@ func init() {
 			RegisterBanffTypes(c),
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterGraniteTypes(c),
 		)
 	}
 
@@ -86,3 +87,9 @@ func RegisterDurangoTypes(targetCodec linearcodec.Codec) error {
 func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 	return txs.RegisterEtnaTypes(targetCodec)
 }
+
+// RegisterGraniteTypes registers the type information for blocks that were
+// valid during the Granite series of upgrades.
+func RegisterGraniteTypes(targetCodec linearcodec.Codec) error {
+	return txs.RegisterGraniteTypes(targetCodec)
+}
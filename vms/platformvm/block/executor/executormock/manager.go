@@ -16,6 +16,7 @@ import (
 	snowman "github.com/MetalBlockchain/metalgo/snow/consensus/snowman"
 	set "github.com/MetalBlockchain/metalgo/utils/set"
 	block "github.com/MetalBlockchain/metalgo/vms/platformvm/block"
+	executor "github.com/MetalBlockchain/metalgo/vms/platformvm/block/executor"
 	state "github.com/MetalBlockchain/metalgo/vms/platformvm/state"
 	txs "github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
 	gomock "go.uber.org/mock/gomock"
@@ -144,6 +145,21 @@ func (mr *ManagerMockRecorder) SetPreference(blkID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*Manager)(nil).SetPreference), blkID)
 }
 
+// SimulateTx mocks base method.
+func (m *Manager) SimulateTx(tx *txs.Tx) (*executor.Simulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTx", tx)
+	ret0, _ := ret[0].(*executor.Simulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTx indicates an expected call of SimulateTx.
+func (mr *ManagerMockRecorder) SimulateTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTx", reflect.TypeOf((*Manager)(nil).SimulateTx), tx)
+}
+
 // VerifyTx mocks base method.
 func (m *Manager) VerifyTx(tx *txs.Tx) error {
 	m.ctrl.T.Helper()
@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/MetalBlockchain/metalgo/chains/atomic"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow/consensus/snowman"
 	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/components/gas"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/block"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/metrics"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/state"
@@ -45,6 +47,12 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx executes the transaction on top of the currently preferred
+	// state, as VerifyTx does, and reports the changes it would make. If the
+	// transaction doesn't have any credentials, its signatures aren't
+	// verified.
+	SimulateTx(tx *txs.Tx) (*Simulation, error)
+
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -119,22 +127,41 @@ func (m *manager) Preferred() ids.ID {
 }
 
 func (m *manager) VerifyTx(tx *txs.Tx) error {
-	if !m.txExecutorBackend.Bootstrapped.Get() {
-		return ErrChainNotSynced
+	execution, err := m.prepareTx(m.txExecutorBackend, tx)
+	if err != nil {
+		return err
+	}
+	_, err = executeTx(m.txExecutorBackend, tx, execution.state)
+	return err
+}
+
+// txExecution is the state a transaction is executed on top of.
+type txExecution struct {
+	// state is the preferred state after advancing the chain time.
+	state      state.Diff
+	complexity gas.Dimensions
+	gas        gas.Gas
+}
+
+// prepareTx performs the checks that don't require executing [tx] and advances
+// the chain time of the preferred state.
+func (m *manager) prepareTx(backend *executor.Backend, tx *txs.Tx) (*txExecution, error) {
+	if !backend.Bootstrapped.Get() {
+		return nil, ErrChainNotSynced
 	}
 
 	// If partial sync is enabled, this node isn't guaranteed to have the full
 	// UTXO set from shared memory. To avoid issuing invalid transactions,
 	// issuance of an ImportTx during this state is completely disallowed.
-	if m.txExecutorBackend.Config.PartialSyncPrimaryNetwork {
+	if backend.Config.PartialSyncPrimaryNetwork {
 		if _, isImportTx := tx.Unsigned.(*txs.ImportTx); isImportTx {
-			return ErrImportTxWhilePartialSyncing
+			return nil, ErrImportTxWhilePartialSyncing
 		}
 	}
 
 	recommendedPChainHeight, err := m.ctx.ValidatorState.GetMinimumHeight(context.TODO())
 	if err != nil {
-		return fmt.Errorf("failed to fetch P-chain height: %w", err)
+		return nil, fmt.Errorf("failed to fetch P-chain height: %w", err)
 	}
 	err = executor.VerifyWarpMessages(
 		context.TODO(),
@@ -144,57 +171,68 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		tx.Unsigned,
 	)
 	if err != nil {
-		return fmt.Errorf("failed verifying warp messages: %w", err)
+		return nil, fmt.Errorf("failed verifying warp messages: %w", err)
 	}
 
 	stateDiff, err := state.NewDiff(m.preferred, m)
 	if err != nil {
-		return fmt.Errorf("failed creating state diff: %w", err)
+		return nil, fmt.Errorf("failed creating state diff: %w", err)
 	}
 
 	nextBlkTime, _, err := state.NextBlockTime(
-		m.txExecutorBackend.Config.ValidatorFeeConfig,
+		backend.Config.ValidatorFeeConfig,
 		stateDiff,
-		m.txExecutorBackend.Clk,
+		backend.Clk,
 	)
 	if err != nil {
-		return fmt.Errorf("failed selecting next block time: %w", err)
+		return nil, fmt.Errorf("failed selecting next block time: %w", err)
 	}
 
-	_, err = executor.AdvanceTimeTo(m.txExecutorBackend, stateDiff, nextBlkTime)
+	_, err = executor.AdvanceTimeTo(backend, stateDiff, nextBlkTime)
 	if err != nil {
-		return fmt.Errorf("failed to advance the chain time: %w", err)
+		return nil, fmt.Errorf("failed to advance the chain time: %w", err)
 	}
 
-	if timestamp := stateDiff.GetTimestamp(); m.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
-		complexity, err := fee.TxComplexity(tx.Unsigned)
+	execution := &txExecution{
+		state: stateDiff,
+	}
+	if timestamp := stateDiff.GetTimestamp(); backend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
+		execution.complexity, err = fee.TxComplexity(tx.Unsigned)
 		if err != nil {
-			return fmt.Errorf("failed to calculate tx complexity: %w", err)
+			return nil, fmt.Errorf("failed to calculate tx complexity: %w", err)
 		}
-		gas, err := complexity.ToGas(m.txExecutorBackend.Config.DynamicFeeConfig.Weights)
+		execution.gas, err = execution.complexity.ToGas(backend.Config.DynamicFeeConfig.Weights)
 		if err != nil {
-			return fmt.Errorf("failed to calculate tx gas: %w", err)
+			return nil, fmt.Errorf("failed to calculate tx gas: %w", err)
 		}
 
 		// TODO: After the mempool is updated, convert this check to use the
 		// maximum mempool capacity.
 		feeState := stateDiff.GetFeeState()
-		if gas > feeState.Capacity {
-			return fmt.Errorf("tx exceeds current gas capacity: %d > %d", gas, feeState.Capacity)
+		if execution.gas > feeState.Capacity {
+			return nil, fmt.Errorf("tx exceeds current gas capacity: %d > %d", execution.gas, feeState.Capacity)
 		}
 	}
 
-	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
-	_, _, _, err = executor.StandardTx(
-		m.txExecutorBackend,
+	return execution, nil
+}
+
+func executeTx(
+	backend *executor.Backend,
+	tx *txs.Tx,
+	stateDiff state.Diff,
+) (map[ids.ID]*atomic.Requests, error) {
+	feeCalculator := state.PickFeeCalculator(backend.Config, stateDiff)
+	_, atomicRequests, _, err := executor.StandardTx(
+		backend,
 		feeCalculator,
 		tx,
 		stateDiff,
 	)
 	if err != nil {
-		return fmt.Errorf("failed execution: %w", err)
+		return nil, fmt.Errorf("failed execution: %w", err)
 	}
-	return nil
+	return atomicRequests, nil
 }
 
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
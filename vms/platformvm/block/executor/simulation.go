@@ -0,0 +1,495 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/chains/atomic"
+	"github.com/MetalBlockchain/metalgo/codec"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/utils/timer/mockable"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/gas"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/fx"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/stakeable"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/state"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/status"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs/executor"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/utxo"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+// Kinds of validator set entries modified by a transaction.
+const (
+	CurrentValidator = "currentValidator"
+	CurrentDelegator = "currentDelegator"
+	PendingValidator = "pendingValidator"
+	PendingDelegator = "pendingDelegator"
+	L1Validator      = "l1Validator"
+)
+
+// Actions a transaction can take on a validator set entry.
+const (
+	Added    = "added"
+	Modified = "modified"
+	Removed  = "removed"
+)
+
+var (
+	_ state.Chain    = (*changeRecorder)(nil)
+	_ txs.Visitor    = (*placeholderCredentials)(nil)
+	_ secp256k1fx.VM = (*unsignedFxVM)(nil)
+
+	errUnsupportedTxType   = errors.New("unsupported tx type")
+	errUnknownInputType    = errors.New("unknown input type")
+	errUnsupportedAuthType = errors.New("unsupported auth type")
+)
+
+// Simulation reports the outcome of executing a transaction on top of the
+// preferred state.
+type Simulation struct {
+	// Err is the reason the transaction would be rejected, or nil if it would
+	// be accepted into the mempool. If Err is non-nil, only
+	// SignaturesVerified is set.
+	Err error
+	// SignaturesVerified is false if the transaction didn't have any
+	// credentials. In that case the IDs of the produced UTXOs differ from the
+	// IDs they will have once the transaction is signed.
+	SignaturesVerified bool
+	// Complexity and Gas are only populated once Etna is activated.
+	Complexity gas.Dimensions
+	Gas        gas.Gas
+	Fee        uint64
+	// ConsumedUTXOs includes the UTXOs imported from other chains.
+	ConsumedUTXOs []*avax.UTXO
+	// ProducedUTXOs includes the UTXOs exported to other chains.
+	ProducedUTXOs    []*avax.UTXO
+	ValidatorChanges []ValidatorChange
+}
+
+// ValidatorChange is a modification of the validator set.
+type ValidatorChange struct {
+	Kind     string
+	Action   string
+	SubnetID ids.ID
+	NodeID   ids.NodeID
+	// ID is the ID of the staker's transaction, or the validationID of an L1
+	// validator.
+	ID     ids.ID
+	Weight uint64
+}
+
+func (m *manager) SimulateTx(tx *txs.Tx) (*Simulation, error) {
+	var (
+		backend    = m.txExecutorBackend
+		simulation = &Simulation{
+			SignaturesVerified: len(tx.Creds) != 0,
+		}
+	)
+	if !simulation.SignaturesVerified {
+		creds := &placeholderCredentials{}
+		if err := tx.Unsigned.Visit(creds); err != nil {
+			simulation.Err = err
+			return simulation, nil
+		}
+		tx = &txs.Tx{
+			Unsigned: tx.Unsigned,
+			Creds:    creds.creds,
+		}
+		if err := tx.Initialize(txs.Codec); err != nil {
+			return nil, fmt.Errorf("failed to initialize tx: %w", err)
+		}
+		backend = m.unsignedBackend()
+	}
+
+	execution, err := m.prepareTx(backend, tx)
+	if err != nil {
+		simulation.Err = err
+		return simulation, nil
+	}
+
+	// The tx is first executed on its own diff so that its changes can be told
+	// apart from the changes made by advancing the chain time.
+	txState, err := state.NewDiffOn(execution.state)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating state diff: %w", err)
+	}
+	atomicRequests, err := executeTx(backend, tx, txState)
+	if err != nil {
+		simulation.Err = err
+		return simulation, nil
+	}
+
+	recorder := &changeRecorder{
+		Chain: execution.state,
+	}
+	if err := txState.Apply(recorder); err != nil {
+		return nil, fmt.Errorf("failed to record changes: %w", err)
+	}
+	if err := recorder.recordAtomicRequests(m.ctx.SharedMemory, atomicRequests); err != nil {
+		return nil, err
+	}
+
+	// Some checks, such as re-adding a staker removed by advancing the chain
+	// time, only fail if the tx is executed on the same diff as the time
+	// advancement, as it is in a block.
+	if _, err := executeTx(backend, tx, execution.state); err != nil {
+		simulation.Err = err
+		return simulation, nil
+	}
+
+	feeCalculator := state.PickFeeCalculator(backend.Config, execution.state)
+	simulation.Fee, err = feeCalculator.CalculateFee(tx.Unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate tx fee: %w", err)
+	}
+	simulation.Complexity = execution.complexity
+	simulation.Gas = execution.gas
+	simulation.ConsumedUTXOs = recorder.consumed
+	simulation.ProducedUTXOs = recorder.produced
+	simulation.ValidatorChanges = recorder.validatorChanges
+	return simulation, nil
+}
+
+// unsignedBackend returns a copy of the backend whose fx doesn't verify
+// signatures. The fx only verifies signatures once it is bootstrapped, so the
+// copy uses an fx that is never bootstrapped.
+func (m *manager) unsignedBackend() *executor.Backend {
+	backend := *m.txExecutorBackend
+	unsignedFx := &secp256k1fx.Fx{}
+	// InitializeVM only fails if the VM has the wrong type.
+	_ = unsignedFx.InitializeVM(&unsignedFxVM{
+		clock: backend.Clk,
+		log:   backend.Ctx.Log,
+	})
+	backend.Fx = unsignedFx
+	backend.FlowChecker = utxo.NewVerifier(backend.Ctx, backend.Clk, unsignedFx)
+	return &backend
+}
+
+// unsignedFxVM provides the fx with the clock it verifies locktimes against.
+type unsignedFxVM struct {
+	clock *mockable.Clock
+	log   logging.Logger
+}
+
+func (*unsignedFxVM) CodecRegistry() codec.Registry {
+	return nil
+}
+
+func (vm *unsignedFxVM) Clock() *mockable.Clock {
+	return vm.clock
+}
+
+func (vm *unsignedFxVM) Logger() logging.Logger {
+	return vm.log
+}
+
+// changeRecorder records the changes a diff applies on top of its parent,
+// without modifying the parent.
+type changeRecorder struct {
+	state.Chain
+
+	consumed         []*avax.UTXO
+	produced         []*avax.UTXO
+	validatorChanges []ValidatorChange
+}
+
+func (r *changeRecorder) AddUTXO(utxo *avax.UTXO) {
+	r.produced = append(r.produced, utxo)
+}
+
+func (r *changeRecorder) DeleteUTXO(utxoID ids.ID) {
+	// A UTXO can only be deleted if it exists in the parent.
+	if utxo, err := r.Chain.GetUTXO(utxoID); err == nil {
+		r.consumed = append(r.consumed, utxo)
+	}
+}
+
+func (r *changeRecorder) PutCurrentValidator(staker *state.Staker) error {
+	r.recordStaker(CurrentValidator, Added, staker)
+	return nil
+}
+
+func (r *changeRecorder) DeleteCurrentValidator(staker *state.Staker) {
+	r.recordStaker(CurrentValidator, Removed, staker)
+}
+
+func (r *changeRecorder) PutCurrentDelegator(staker *state.Staker) {
+	r.recordStaker(CurrentDelegator, Added, staker)
+}
+
+func (r *changeRecorder) DeleteCurrentDelegator(staker *state.Staker) {
+	r.recordStaker(CurrentDelegator, Removed, staker)
+}
+
+func (r *changeRecorder) PutPendingValidator(staker *state.Staker) error {
+	r.recordStaker(PendingValidator, Added, staker)
+	return nil
+}
+
+func (r *changeRecorder) DeletePendingValidator(staker *state.Staker) {
+	r.recordStaker(PendingValidator, Removed, staker)
+}
+
+func (r *changeRecorder) PutPendingDelegator(staker *state.Staker) {
+	r.recordStaker(PendingDelegator, Added, staker)
+}
+
+func (r *changeRecorder) DeletePendingDelegator(staker *state.Staker) {
+	r.recordStaker(PendingDelegator, Removed, staker)
+}
+
+func (r *changeRecorder) PutL1Validator(l1Validator state.L1Validator) error {
+	action := Modified
+	switch _, err := r.Chain.GetL1Validator(l1Validator.ValidationID); {
+	case l1Validator.Weight == 0:
+		action = Removed
+	case err != nil:
+		action = Added
+	}
+	r.validatorChanges = append(r.validatorChanges, ValidatorChange{
+		Kind:     L1Validator,
+		Action:   action,
+		SubnetID: l1Validator.SubnetID,
+		NodeID:   l1Validator.NodeID,
+		ID:       l1Validator.ValidationID,
+		Weight:   l1Validator.Weight,
+	})
+	return nil
+}
+
+// The remaining changes aren't reported, and are dropped to leave the parent
+// unmodified.
+
+func (*changeRecorder) SetTimestamp(time.Time) {}
+
+func (*changeRecorder) SetFeeState(gas.State) {}
+
+func (*changeRecorder) SetL1ValidatorExcess(gas.Gas) {}
+
+func (*changeRecorder) SetAccruedFees(uint64) {}
+
+func (*changeRecorder) SetCurrentSupply(ids.ID, uint64) {}
+
+func (*changeRecorder) PutExpiry(state.ExpiryEntry) {}
+
+func (*changeRecorder) DeleteExpiry(state.ExpiryEntry) {}
+
+func (*changeRecorder) SetDelegateeReward(ids.ID, ids.NodeID, uint64) error {
+	return nil
+}
+
+func (*changeRecorder) AddSubnet(ids.ID) {}
+
+func (*changeRecorder) SetSubnetOwner(ids.ID, fx.Owner) {}
+
+func (*changeRecorder) SetSubnetToL1Conversion(ids.ID, state.SubnetToL1Conversion) {}
+
+func (*changeRecorder) AddSubnetTransformation(*txs.Tx) {}
+
+func (*changeRecorder) SetSubnetRewardCurve(*txs.Tx) {}
+
+func (*changeRecorder) AddChain(*txs.Tx) {}
+
+func (*changeRecorder) AddTx(*txs.Tx, status.Status) {}
+
+func (*changeRecorder) AddRewardUTXO(ids.ID, *avax.UTXO) {}
+
+func (r *changeRecorder) recordStaker(kind, action string, staker *state.Staker) {
+	r.validatorChanges = append(r.validatorChanges, ValidatorChange{
+		Kind:     kind,
+		Action:   action,
+		SubnetID: staker.SubnetID,
+		NodeID:   staker.NodeID,
+		ID:       staker.TxID,
+		Weight:   staker.Weight,
+	})
+}
+
+// recordAtomicRequests records the UTXOs imported from and exported to other
+// chains.
+func (r *changeRecorder) recordAtomicRequests(
+	sharedMemory atomic.SharedMemory,
+	atomicRequests map[ids.ID]*atomic.Requests,
+) error {
+	for chainID, requests := range atomicRequests {
+		if len(requests.RemoveRequests) != 0 {
+			allUTXOBytes, err := sharedMemory.Get(chainID, requests.RemoveRequests)
+			if err != nil {
+				return fmt.Errorf("failed to get shared memory: %w", err)
+			}
+			for _, utxoBytes := range allUTXOBytes {
+				utxo := &avax.UTXO{}
+				if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+					return fmt.Errorf("failed to unmarshal UTXO: %w", err)
+				}
+				r.consumed = append(r.consumed, utxo)
+			}
+		}
+		for _, element := range requests.PutRequests {
+			utxo := &avax.UTXO{}
+			if _, err := txs.Codec.Unmarshal(element.Value, utxo); err != nil {
+				return fmt.Errorf("failed to unmarshal UTXO: %w", err)
+			}
+			r.produced = append(r.produced, utxo)
+		}
+	}
+	return nil
+}
+
+// placeholderCredentials creates a credential with an empty signature for
+// every signature a transaction requires, in the order the wallet signs them.
+type placeholderCredentials struct {
+	creds []verify.Verifiable
+}
+
+func (*placeholderCredentials) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
+	return errUnsupportedTxType
+}
+
+func (*placeholderCredentials) RewardValidatorTx(*txs.RewardValidatorTx) error {
+	return errUnsupportedTxType
+}
+
+func (c *placeholderCredentials) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) CreateChainTx(tx *txs.CreateChainTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) ImportTx(tx *txs.ImportTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addInputs(tx.ImportedInputs)
+}
+
+func (c *placeholderCredentials) ExportTx(tx *txs.ExportTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) BaseTx(tx *txs.BaseTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) SetL1ValidatorWeightTx(tx *txs.SetL1ValidatorWeightTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) IncreaseL1ValidatorBalanceTx(tx *txs.IncreaseL1ValidatorBalanceTx) error {
+	return c.addInputs(tx.Ins)
+}
+
+func (c *placeholderCredentials) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.DisableAuth)
+}
+
+func (c *placeholderCredentials) SetSubnetRewardCurveTx(tx *txs.SetSubnetRewardCurveTx) error {
+	if err := c.addInputs(tx.Ins); err != nil {
+		return err
+	}
+	return c.addAuth(tx.SubnetAuth)
+}
+
+func (c *placeholderCredentials) addInputs(ins []*avax.TransferableInput) error {
+	for _, transferInput := range ins {
+		inIntf := transferInput.In
+		if stakeableIn, ok := inIntf.(*stakeable.LockIn); ok {
+			inIntf = stakeableIn.TransferableIn
+		}
+
+		input, ok := inIntf.(*secp256k1fx.TransferInput)
+		if !ok {
+			return errUnknownInputType
+		}
+		c.addCredential(len(input.SigIndices))
+	}
+	return nil
+}
+
+func (c *placeholderCredentials) addAuth(auth verify.Verifiable) error {
+	input, ok := auth.(*secp256k1fx.Input)
+	if !ok {
+		return errUnsupportedAuthType
+	}
+	c.addCredential(len(input.SigIndices))
+	return nil
+}
+
+func (c *placeholderCredentials) addCredential(numSigs int) {
+	c.creds = append(c.creds, &secp256k1fx.Credential{
+		Sigs: make([][secp256k1.SignatureLen]byte, numSigs),
+	})
+}
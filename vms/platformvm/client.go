@@ -125,6 +125,8 @@ type GetSubnetClientResponse struct {
 	Locktime    uint64
 	// subnet transformation tx ID for a permissionless subnet
 	SubnetTransformationTxID ids.ID
+	// ID of the tx that set the reward curve of a permissionless subnet
+	RewardCurveTxID ids.ID
 	// subnet conversion information for an L1
 	ConversionID   ids.ID
 	ManagerChainID ids.ID
@@ -151,6 +153,7 @@ func (c *Client) GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.
 		Threshold:                uint32(res.Threshold),
 		Locktime:                 uint64(res.Locktime),
 		SubnetTransformationTxID: res.SubnetTransformationTxID,
+		RewardCurveTxID:          res.RewardCurveTxID,
 		ConversionID:             res.ConversionID,
 		ManagerChainID:           res.ManagerChainID,
 		ManagerAddress:           res.ManagerAddress,
@@ -373,6 +376,58 @@ func (c *Client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+// ScheduleTx stores a signed transaction on the node, which issues it once the
+// chain time reaches [notBefore], and returns the TxID along with the token
+// required to cancel it
+func (c *Client) ScheduleTx(ctx context.Context, txBytes []byte, notBefore time.Time, options ...rpc.Option) (ids.ID, ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+
+	res := &ScheduleTxReply{}
+	err = c.Requester.SendRequest(ctx, "platform.scheduleTx", &ScheduleTxArgs{
+		Tx:        txStr,
+		Encoding:  formatting.Hex,
+		NotBefore: json.Uint64(notBefore.Unix()),
+	}, res, options...)
+	return res.TxID, res.CancelToken, err
+}
+
+// GetScheduledTxs returns the IDs of the transactions that are scheduled on the
+// node
+func (c *Client) GetScheduledTxs(ctx context.Context, options ...rpc.Option) ([]ScheduledTx, error) {
+	res := &GetScheduledTxsReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getScheduledTxs", struct{}{}, res, options...)
+	return res.Txs, err
+}
+
+// CancelScheduledTx removes a transaction that hasn't been issued yet from the
+// transactions that are scheduled on the node. [cancelToken] is the token that
+// was returned when the transaction was scheduled.
+func (c *Client) CancelScheduledTx(ctx context.Context, txID ids.ID, cancelToken ids.ID, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "platform.cancelScheduledTx", &CancelScheduledTxArgs{
+		TxID:        txID,
+		CancelToken: cancelToken,
+	}, &api.EmptyReply{}, options...)
+}
+
+// SimulateTx executes the transaction on top of the preferred state without
+// issuing it. The returned UTXOs are hex encoded.
+func (c *Client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.Requester.SendRequest(ctx, "platform.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 // GetTx returns the byte representation of txID.
 func (c *Client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
@@ -552,6 +607,69 @@ func (c *Client) GetFeeState(ctx context.Context, options ...rpc.Option) (
 	return res.State, res.Price, res.Time, err
 }
 
+// EstimateFee returns the fee the transaction would pay if it was issued now,
+// and the fee it would pay if it was issued [seconds] later.
+func (c *Client) EstimateFee(ctx context.Context, txBytes []byte, seconds uint64, options ...rpc.Option) (*EstimateFeeReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &EstimateFeeReply{}
+	err = c.Requester.SendRequest(ctx, "platform.estimateFee", &EstimateFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+		Seconds:  json.Uint64(seconds),
+	}, res, options...)
+	return res, err
+}
+
+// EstimateFeeByType returns the fee a transaction of the provided type, with
+// [numInputs] inputs and [numOutputs] outputs, would pay if it was issued now,
+// and the fee it would pay if it was issued [seconds] later.
+func (c *Client) EstimateFeeByType(
+	ctx context.Context,
+	txType string,
+	numInputs uint32,
+	numOutputs uint32,
+	seconds uint64,
+	options ...rpc.Option,
+) (*EstimateFeeReply, error) {
+	res := &EstimateFeeReply{}
+	err := c.Requester.SendRequest(ctx, "platform.estimateFee", &EstimateFeeArgs{
+		TxType:     txType,
+		NumInputs:  json.Uint32(numInputs),
+		NumOutputs: json.Uint32(numOutputs),
+		Seconds:    json.Uint64(seconds),
+	}, res, options...)
+	return res, err
+}
+
+// EstimateReward returns the potential reward of staking [stakeAmount] for
+// [stakeDuration] on [subnetID], how it would be split with a validator
+// charging [delegationFee] if the stake was delegated, and the reward history
+// of [nodeID].
+func (c *Client) EstimateReward(
+	ctx context.Context,
+	subnetID ids.ID,
+	stakeAmount uint64,
+	stakeDuration time.Duration,
+	delegationFee uint32,
+	nodeID ids.NodeID,
+	options ...rpc.Option,
+) (*EstimateRewardReply, error) {
+	res := &EstimateRewardReply{}
+	err := c.Requester.SendRequest(ctx, "platform.estimateReward", &EstimateRewardArgs{
+		SubnetID:      subnetID,
+		StakeAmount:   json.Uint64(stakeAmount),
+		StakeDuration: json.Uint64(stakeDuration / time.Second),
+		DelegationFee: json.Uint32(delegationFee),
+		NodeID:        nodeID,
+		Encoding:      formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 // GetValidatorFeeConfig returns the validator fee config.
 func (c *Client) GetValidatorFeeConfig(ctx context.Context, options ...rpc.Option) (*fee.Config, error) {
 	res := &fee.Config{}
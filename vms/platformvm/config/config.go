@@ -26,25 +26,31 @@ var Default = Config{
 	L1SubnetIDNodeIDCacheSize:     16 * units.KiB,
 	ChecksumsEnabled:              false,
 	MempoolPruneFrequency:         30 * time.Minute,
+	L1ValidatorTopUp:              DefaultL1ValidatorTopUp,
+	ScheduledTxsEnabled:           false,
 }
 
 // Config contains all of the user-configurable parameters of the PlatformVM.
 type Config struct {
-	Network                       Network       `json:"network"`
-	BlockCacheSize                int           `json:"block-cache-size"`
-	TxCacheSize                   int           `json:"tx-cache-size"`
-	TransformedSubnetTxCacheSize  int           `json:"transformed-subnet-tx-cache-size"`
-	RewardUTXOsCacheSize          int           `json:"reward-utxos-cache-size"`
-	ChainCacheSize                int           `json:"chain-cache-size"`
-	ChainDBCacheSize              int           `json:"chain-db-cache-size"`
-	BlockIDCacheSize              int           `json:"block-id-cache-size"`
-	FxOwnerCacheSize              int           `json:"fx-owner-cache-size"`
-	SubnetToL1ConversionCacheSize int           `json:"subnet-to-l1-conversion-cache-size"`
-	L1WeightsCacheSize            int           `json:"l1-weights-cache-size"`
-	L1InactiveValidatorsCacheSize int           `json:"l1-inactive-validators-cache-size"`
-	L1SubnetIDNodeIDCacheSize     int           `json:"l1-subnet-id-node-id-cache-size"`
-	ChecksumsEnabled              bool          `json:"checksums-enabled"`
-	MempoolPruneFrequency         time.Duration `json:"mempool-prune-frequency"`
+	Network                       Network          `json:"network"`
+	BlockCacheSize                int              `json:"block-cache-size"`
+	TxCacheSize                   int              `json:"tx-cache-size"`
+	TransformedSubnetTxCacheSize  int              `json:"transformed-subnet-tx-cache-size"`
+	RewardUTXOsCacheSize          int              `json:"reward-utxos-cache-size"`
+	ChainCacheSize                int              `json:"chain-cache-size"`
+	ChainDBCacheSize              int              `json:"chain-db-cache-size"`
+	BlockIDCacheSize              int              `json:"block-id-cache-size"`
+	FxOwnerCacheSize              int              `json:"fx-owner-cache-size"`
+	SubnetToL1ConversionCacheSize int              `json:"subnet-to-l1-conversion-cache-size"`
+	L1WeightsCacheSize            int              `json:"l1-weights-cache-size"`
+	L1InactiveValidatorsCacheSize int              `json:"l1-inactive-validators-cache-size"`
+	L1SubnetIDNodeIDCacheSize     int              `json:"l1-subnet-id-node-id-cache-size"`
+	ChecksumsEnabled              bool             `json:"checksums-enabled"`
+	MempoolPruneFrequency         time.Duration    `json:"mempool-prune-frequency"`
+	L1ValidatorTopUp              L1ValidatorTopUp `json:"l1-validator-top-up"`
+	// ScheduledTxsEnabled enables the API methods that store signed
+	// transactions on this node to be issued at a later time.
+	ScheduledTxsEnabled bool `json:"scheduled-txs-enabled"`
 }
 
 // GetConfig returns a Config from the provided json encoded bytes. If a
@@ -58,5 +64,8 @@ func GetConfig(b []byte) (*Config, error) {
 		return &ec, nil
 	}
 
-	return &ec, json.Unmarshal(b, &ec)
+	if err := json.Unmarshal(b, &ec); err != nil {
+		return nil, err
+	}
+	return &ec, ec.L1ValidatorTopUp.Verify()
 }
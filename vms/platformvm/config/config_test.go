@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
 )
 
 // Requires all values in a struct to be initialized
@@ -93,9 +95,18 @@ func TestConfigUnmarshal(t *testing.T) {
 			L1SubnetIDNodeIDCacheSize:     13,
 			ChecksumsEnabled:              true,
 			MempoolPruneFrequency:         time.Minute,
+			L1ValidatorTopUp: L1ValidatorTopUp{
+				ValidationIDs:  []ids.ID{ids.GenerateTestID()},
+				PrivateKeyFile: "key.txt",
+				Threshold:      time.Hour,
+				Amount:         14,
+				Frequency:      time.Second,
+			},
+			ScheduledTxsEnabled: true,
 		}
 		verifyInitializedStruct(t, *expected)
 		verifyInitializedStruct(t, expected.Network)
+		verifyInitializedStruct(t, expected.L1ValidatorTopUp)
 
 		b, err := json.Marshal(expected)
 		require.NoError(err)
@@ -105,3 +116,101 @@ func TestConfigUnmarshal(t *testing.T) {
 		require.Equal(expected, actual)
 	})
 }
+
+func TestL1ValidatorTopUpVerify(t *testing.T) {
+	validationIDs := []ids.ID{ids.GenerateTestID()}
+	tests := []struct {
+		name        string
+		config      L1ValidatorTopUp
+		expectedErr error
+	}{
+		{
+			name:   "disabled",
+			config: DefaultL1ValidatorTopUp,
+		},
+		{
+			name: "missing key",
+			config: L1ValidatorTopUp{
+				ValidationIDs: validationIDs,
+				Amount:        1,
+				Threshold:     time.Hour,
+				Frequency:     time.Minute,
+			},
+			expectedErr: errMissingTopUpKey,
+		},
+		{
+			name: "missing amount",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Threshold:      time.Hour,
+				Frequency:      time.Minute,
+			},
+			expectedErr: errMissingTopUpAmount,
+		},
+		{
+			name: "zero threshold",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Amount:         1,
+				Frequency:      time.Minute,
+			},
+			expectedErr: errInvalidTopUpThreshold,
+		},
+		{
+			name: "negative threshold",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Amount:         1,
+				Threshold:      -time.Hour,
+				Frequency:      time.Minute,
+			},
+			expectedErr: errInvalidTopUpThreshold,
+		},
+		{
+			name: "zero frequency",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Amount:         1,
+				Threshold:      time.Hour,
+			},
+			expectedErr: errInvalidTopUpFrequency,
+		},
+		{
+			name: "negative frequency",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Amount:         1,
+				Threshold:      time.Hour,
+				Frequency:      -time.Minute,
+			},
+			expectedErr: errInvalidTopUpFrequency,
+		},
+		{
+			name: "valid",
+			config: L1ValidatorTopUp{
+				ValidationIDs:  validationIDs,
+				PrivateKeyFile: "key.txt",
+				Amount:         1,
+				Threshold:      time.Hour,
+				Frequency:      time.Minute,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetConfigVerifiesL1ValidatorTopUp(t *testing.T) {
+	b := []byte(`{"l1-validator-top-up":{"validation-ids":["11111111111111111111111111111111LpoYY"],"private-key-file":"key.txt","amount":1,"threshold":-1}}`)
+	_, err := GetConfig(b)
+	require.ErrorIs(t, err, errInvalidTopUpThreshold)
+}
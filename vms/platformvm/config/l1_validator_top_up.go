@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+)
+
+var (
+	errMissingTopUpKey       = errors.New("missing private key file for L1 validator top ups")
+	errMissingTopUpAmount    = errors.New("missing amount for L1 validator top ups")
+	errInvalidTopUpThreshold = errors.New("threshold of L1 validator top ups must be positive")
+	errInvalidTopUpFrequency = errors.New("frequency of L1 validator top ups must be positive")
+)
+
+var DefaultL1ValidatorTopUp = L1ValidatorTopUp{
+	Threshold: 72 * time.Hour,
+	Frequency: time.Minute,
+}
+
+// L1ValidatorTopUp configures the node to increase the balance of L1
+// validators before their balance runs out and they are deactivated.
+type L1ValidatorTopUp struct {
+	// ValidationIDs are the L1 validators whose balance is topped up. If
+	// empty, no balance is topped up.
+	ValidationIDs []ids.ID `json:"validation-ids"`
+	// PrivateKeyFile is the path of the file containing the secp256k1 private
+	// keys, formatted as "PrivateKey-..." one per line, that pay for the top
+	// ups.
+	PrivateKeyFile string `json:"private-key-file"`
+	// Threshold is how long before the balance of a validator is projected to
+	// run out that its balance is topped up.
+	Threshold time.Duration `json:"threshold"`
+	// Amount is the number of nAVAX added to the balance of a validator on
+	// each top up.
+	Amount uint64 `json:"amount"`
+	// Frequency is how often the balances are checked.
+	Frequency time.Duration `json:"frequency"`
+}
+
+// Enabled returns true if the balance of any L1 validator is topped up.
+func (c *L1ValidatorTopUp) Enabled() bool {
+	return len(c.ValidationIDs) > 0
+}
+
+// Verify returns an error if the balances are topped up with an invalid
+// configuration.
+func (c *L1ValidatorTopUp) Verify() error {
+	switch {
+	case !c.Enabled():
+		return nil
+	case c.PrivateKeyFile == "":
+		return errMissingTopUpKey
+	case c.Amount == 0:
+		return errMissingTopUpAmount
+	case c.Threshold <= 0:
+		return fmt.Errorf("%w: %s", errInvalidTopUpThreshold, c.Threshold)
+	case c.Frequency <= 0:
+		return fmt.Errorf("%w: %s", errInvalidTopUpFrequency, c.Frequency)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain/rpckeychain"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/gas"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/config"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/p/builder"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/p/signer"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/p/wallet"
+	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary/common"
+
+	validatorfee "github.com/MetalBlockchain/metalgo/vms/platformvm/validators/fee"
+)
+
+// pendingTopUpTimeout is how long a top up can remain unaccepted before it is
+// assumed to have been dropped.
+const pendingTopUpTimeout = 10 * time.Minute
+
+var errNoTopUpKeys = errors.New("no private keys for L1 validator top ups")
+
+// pendingTopUp is a top up that was issued but hasn't been accepted yet.
+type pendingTopUp struct {
+	txID   ids.ID
+	issued time.Time
+}
+
+// l1ValidatorTopUp increases the balance of the configured L1 validators
+// before their balance runs out.
+type l1ValidatorTopUp struct {
+	vm       *VM
+	config   config.L1ValidatorTopUp
+	keychain *secp256k1fx.Keychain
+
+	// pending maps the validationID of an L1 validator to its last top up, if
+	// it hasn't been accepted yet. It is only accessed by [run].
+	pending map[ids.ID]pendingTopUp
+}
+
+// newL1ValidatorTopUp returns the top ups described by [config], which must
+// have been verified.
+func newL1ValidatorTopUp(vm *VM, config config.L1ValidatorTopUp) (*l1ValidatorTopUp, error) {
+	keys, err := rpckeychain.ReadKeyFile(config.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read L1 validator top up keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w in %q", errNoTopUpKeys, config.PrivateKeyFile)
+	}
+	return &l1ValidatorTopUp{
+		vm:       vm,
+		config:   config,
+		keychain: secp256k1fx.NewKeychain(keys...),
+		pending:  make(map[ids.ID]pendingTopUp),
+	}, nil
+}
+
+// run tops up the balances every [Frequency] until [ctx] is cancelled.
+func (t *l1ValidatorTopUp) run(ctx context.Context) {
+	ticker := time.NewTicker(t.config.Frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, tx := range t.buildTopUps() {
+			t.issue(tx)
+		}
+	}
+}
+
+// buildTopUps returns the signed txs that top up the balances of the L1
+// validators whose balance is projected to run out within [Threshold].
+func (t *l1ValidatorTopUp) buildTopUps() []*txs.Tx {
+	t.vm.ctx.Lock.Lock()
+	defer t.vm.ctx.Lock.Unlock()
+
+	if !t.vm.bootstrapped.Get() {
+		return nil
+	}
+
+	var (
+		ctx    = context.Background()
+		topUps []*txs.Tx
+		// utxos are the UTXOs of the keychain that haven't been spent by the
+		// top ups built so far. They are only fetched if a top up is needed.
+		utxos common.UTXOs
+	)
+	for _, validationID := range t.config.ValidationIDs {
+		if t.isPending(validationID) {
+			continue
+		}
+
+		needsTopUp, err := t.needsTopUp(validationID)
+		if err != nil {
+			t.vm.ctx.Log.Warn("failed to check L1 validator balance",
+				zap.Stringer("validationID", validationID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !needsTopUp {
+			continue
+		}
+
+		if utxos == nil {
+			utxos, err = t.getUTXOs(ctx)
+			if err != nil {
+				t.vm.ctx.Log.Warn("failed to get L1 validator top up UTXOs",
+					zap.Error(err),
+				)
+				return topUps
+			}
+		}
+
+		tx, err := t.buildTopUp(ctx, utxos, validationID)
+		if err != nil {
+			t.vm.ctx.Log.Warn("failed to build L1 validator top up",
+				zap.Stringer("validationID", validationID),
+				zap.Error(err),
+			)
+			continue
+		}
+		topUps = append(topUps, tx)
+	}
+	return topUps
+}
+
+// isPending returns true if the last top up of [validationID] may still be
+// accepted.
+func (t *l1ValidatorTopUp) isPending(validationID ids.ID) bool {
+	topUp, ok := t.pending[validationID]
+	if !ok {
+		return false
+	}
+
+	_, _, err := t.vm.state.GetTx(topUp.txID)
+	switch {
+	case err == nil:
+		t.vm.ctx.Log.Info("L1 validator top up accepted",
+			zap.Stringer("validationID", validationID),
+			zap.Stringer("txID", topUp.txID),
+		)
+	case !errors.Is(err, database.ErrNotFound):
+		t.vm.ctx.Log.Warn("failed to get L1 validator top up",
+			zap.Stringer("txID", topUp.txID),
+			zap.Error(err),
+		)
+		return true
+	case t.vm.Builder.GetDropReason(topUp.txID) != nil:
+		t.vm.ctx.Log.Warn("L1 validator top up dropped",
+			zap.Stringer("validationID", validationID),
+			zap.Stringer("txID", topUp.txID),
+			zap.Error(t.vm.Builder.GetDropReason(topUp.txID)),
+		)
+	case t.vm.clock.Time().Sub(topUp.issued) > pendingTopUpTimeout:
+		t.vm.ctx.Log.Warn("L1 validator top up timed out",
+			zap.Stringer("validationID", validationID),
+			zap.Stringer("txID", topUp.txID),
+		)
+	default:
+		return true
+	}
+
+	delete(t.pending, validationID)
+	return false
+}
+
+// needsTopUp returns true if the balance of [validationID] is projected to run
+// out within [Threshold] of now.
+func (t *l1ValidatorTopUp) needsTopUp(validationID ids.ID) (bool, error) {
+	l1Validator, err := t.vm.state.GetL1Validator(validationID)
+	if err != nil {
+		return false, err
+	}
+	// Inactive validators are topped up to reactivate them.
+	if !l1Validator.IsActive() {
+		return true, nil
+	}
+
+	var (
+		balance   = l1Validator.EndAccumulatedFee - t.vm.state.GetAccruedFees()
+		chainTime = t.vm.state.GetTimestamp()
+		deadline  = t.vm.clock.Time().Add(t.config.Threshold)
+		feeState  = validatorfee.State{
+			Current: gas.Gas(t.vm.state.NumActiveL1Validators()),
+			Excess:  t.vm.state.GetL1ValidatorExcess(),
+		}
+	)
+	if !deadline.After(chainTime) {
+		return false, nil
+	}
+
+	maxSeconds := uint64(deadline.Sub(chainTime) / time.Second)
+	remainingSeconds := feeState.SecondsRemaining(
+		t.vm.Internal.ValidatorFeeConfig,
+		maxSeconds,
+		balance,
+	)
+	return remainingSeconds < maxSeconds, nil
+}
+
+// getUTXOs returns the UTXOs of the keychain in the last accepted state.
+func (t *l1ValidatorTopUp) getUTXOs(ctx context.Context) (common.UTXOs, error) {
+	utxos, err := avax.GetAllUTXOs(t.vm.state, t.keychain.Addresses())
+	if err != nil {
+		return nil, err
+	}
+
+	walletUTXOs := common.NewUTXOs()
+	for _, utxo := range utxos {
+		err := walletUTXOs.AddUTXO(
+			ctx,
+			constants.PlatformChainID,
+			constants.PlatformChainID,
+			utxo,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return walletUTXOs, nil
+}
+
+// buildTopUp returns a signed tx, paid with [utxos], that increases the
+// balance of [validationID] by [Amount]. The UTXOs spent by the tx are removed
+// from [utxos], so the following top ups don't conflict with it.
+func (t *l1ValidatorTopUp) buildTopUp(
+	ctx context.Context,
+	utxos common.UTXOs,
+	validationID ids.ID,
+) (*txs.Tx, error) {
+	var (
+		addrs   = t.keychain.Addresses()
+		backend = wallet.NewBackend(
+			common.NewChainUTXOs(constants.PlatformChainID, utxos),
+			nil,
+		)
+		feeConfig      = t.vm.Internal.DynamicFeeConfig
+		builderContext = &builder.Context{
+			NetworkID:         t.vm.ctx.NetworkID,
+			AVAXAssetID:       t.vm.ctx.AVAXAssetID,
+			ComplexityWeights: feeConfig.Weights,
+			GasPrice: gas.CalculatePrice(
+				feeConfig.MinPrice,
+				t.vm.state.GetFeeState().Excess,
+				feeConfig.ExcessConversionConstant,
+			),
+		}
+		txBuilder = builder.New(addrs, builderContext, backend)
+	)
+	utx, err := txBuilder.NewIncreaseL1ValidatorBalanceTx(validationID, t.config.Amount)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := signer.SignUnsigned(ctx, signer.New(t.keychain, backend), utx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, in := range utx.Ins {
+		err := utxos.RemoveUTXO(
+			ctx,
+			constants.PlatformChainID,
+			constants.PlatformChainID,
+			in.InputID(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// issue adds the top up [tx] to the mempool.
+//
+// Invariant: The context lock is not held.
+func (t *l1ValidatorTopUp) issue(tx *txs.Tx) {
+	var (
+		utx          = tx.Unsigned.(*txs.IncreaseL1ValidatorBalanceTx)
+		validationID = utx.ValidationID
+		txID         = tx.ID()
+	)
+	if err := t.vm.issueTxFromRPC(tx); err != nil {
+		t.vm.ctx.Log.Warn("failed to issue L1 validator top up",
+			zap.Stringer("validationID", validationID),
+			zap.Stringer("txID", txID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	t.vm.ctx.Log.Info("issued L1 validator top up",
+		zap.Stringer("validationID", validationID),
+		zap.Stringer("txID", txID),
+		zap.Uint64("amount", utx.Balance),
+	)
+	t.pending[validationID] = pendingTopUp{
+		txID:   txID,
+		issued: t.vm.clock.Time(),
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/upgrade/upgradetest"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/bls/signer/localsigner"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/utils/units"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/config"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/genesis/genesistest"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/state"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+func TestNewL1ValidatorTopUp(t *testing.T) {
+	key := genesistest.DefaultFundedKeys[0]
+	keyFile := filepath.Join(t.TempDir(), "key.txt")
+	require.NoError(t, os.WriteFile(keyFile, []byte(key.String()+"\n"), 0o600))
+	emptyKeyFile := filepath.Join(t.TempDir(), "empty.txt")
+	require.NoError(t, os.WriteFile(emptyKeyFile, []byte("# no keys\n"), 0o600))
+
+	tests := []struct {
+		name        string
+		config      config.L1ValidatorTopUp
+		expectedErr error
+	}{
+		{
+			name: "missing key file",
+			config: config.L1ValidatorTopUp{
+				PrivateKeyFile: filepath.Join(t.TempDir(), "missing.txt"),
+				Amount:         1,
+				Threshold:      time.Hour,
+				Frequency:      time.Minute,
+			},
+			expectedErr: os.ErrNotExist,
+		},
+		{
+			name: "no keys",
+			config: config.L1ValidatorTopUp{
+				PrivateKeyFile: emptyKeyFile,
+				Amount:         1,
+				Threshold:      time.Hour,
+				Frequency:      time.Minute,
+			},
+			expectedErr: errNoTopUpKeys,
+		},
+		{
+			name: "valid",
+			config: config.L1ValidatorTopUp{
+				PrivateKeyFile: keyFile,
+				Amount:         1,
+				Threshold:      time.Hour,
+				Frequency:      time.Minute,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			topUp, err := newL1ValidatorTopUp(nil, test.config)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(key.Address(), topUp.keychain.Addresses().List()[0])
+		})
+	}
+}
+
+func TestL1ValidatorTopUp(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM(t, upgradetest.Latest)
+	vm.ctx.Lock.Lock()
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	pkBytes := bls.PublicKeyToUncompressedBytes(sk.PublicKey())
+
+	var (
+		subnetID = ids.GenerateTestID()
+		// The balance of the low validator runs out in less than an hour, so
+		// it's topped up.
+		low = state.L1Validator{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          subnetID,
+			NodeID:            ids.GenerateTestNodeID(),
+			PublicKey:         pkBytes,
+			Weight:            1,
+			EndAccumulatedFee: vm.state.GetAccruedFees() + 1,
+		}
+		high = state.L1Validator{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          subnetID,
+			NodeID:            ids.GenerateTestNodeID(),
+			PublicKey:         pkBytes,
+			Weight:            1,
+			EndAccumulatedFee: vm.state.GetAccruedFees() + units.MegaAvax,
+		}
+		inactive = state.L1Validator{
+			ValidationID: ids.GenerateTestID(),
+			SubnetID:     subnetID,
+			NodeID:       ids.GenerateTestNodeID(),
+			PublicKey:    pkBytes,
+			Weight:       1,
+		}
+	)
+	require.NoError(vm.state.PutL1Validator(low))
+	require.NoError(vm.state.PutL1Validator(high))
+	require.NoError(vm.state.PutL1Validator(inactive))
+	require.NoError(vm.state.Commit())
+	vm.ctx.Lock.Unlock()
+
+	topUp := &l1ValidatorTopUp{
+		vm: vm,
+		config: config.L1ValidatorTopUp{
+			ValidationIDs: []ids.ID{
+				low.ValidationID,
+				high.ValidationID,
+				inactive.ValidationID,
+			},
+			Threshold: time.Hour,
+			Amount:    units.MilliAvax,
+		},
+		// Each funded key holds a single UTXO.
+		keychain: secp256k1fx.NewKeychain(
+			genesistest.DefaultFundedKeys[0],
+			genesistest.DefaultFundedKeys[1],
+		),
+		pending: make(map[ids.ID]pendingTopUp),
+	}
+
+	topUps := topUp.buildTopUps()
+	require.Len(topUps, 2)
+
+	var (
+		validationIDs = make([]ids.ID, len(topUps))
+		spent         = set.NewSet[ids.ID](len(topUps))
+	)
+	for i, tx := range topUps {
+		utx, ok := tx.Unsigned.(*txs.IncreaseL1ValidatorBalanceTx)
+		require.True(ok)
+		require.Equal(uint64(units.MilliAvax), utx.Balance)
+		validationIDs[i] = utx.ValidationID
+
+		// The top ups must not conflict.
+		for _, in := range utx.Ins {
+			require.False(spent.Contains(in.InputID()))
+			spent.Add(in.InputID())
+		}
+	}
+	require.Equal([]ids.ID{low.ValidationID, inactive.ValidationID}, validationIDs)
+
+	// Top ups that can't be paid without conflicting with the previous top ups
+	// are built on a later tick.
+	topUp.keychain = secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys[0])
+	topUps = topUp.buildTopUps()
+	require.Len(topUps, 1)
+	require.Equal(low.ValidationID, topUps[0].Unsigned.(*txs.IncreaseL1ValidatorBalanceTx).ValidationID)
+
+	// Once a top up is issued, it isn't rebuilt until it is either accepted or
+	// dropped.
+	topUp.issue(topUps[0])
+
+	require.Contains(topUp.pending, low.ValidationID)
+	_, ok := vm.Builder.Get(topUps[0].ID())
+	require.True(ok)
+
+	topUps = topUp.buildTopUps()
+	require.Len(topUps, 1)
+	require.Equal(inactive.ValidationID, topUps[0].Unsigned.(*txs.IncreaseL1ValidatorBalanceTx).ValidationID)
+}
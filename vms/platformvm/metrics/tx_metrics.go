@@ -173,3 +173,10 @@ func (m *txMetrics) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
 	}).Inc()
 	return nil
 }
+
+func (m *txMetrics) SetSubnetRewardCurveTx(*txs.SetSubnetRewardCurveTx) error {
+	m.numTxs.With(prometheus.Labels{
+		txLabel: "set_subnet_reward_curve",
+	}).Inc()
+	return nil
+}
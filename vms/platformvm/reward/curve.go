@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+const (
+	// MaxRate is the largest annual rate, 100%, that a curve may specify.
+	MaxRate = PercentDenominator
+
+	// MaxSteps is the maximum number of steps in a [StepwiseCurve].
+	MaxSteps = 32
+
+	// MaxDuration is the largest duration, in seconds, that a curve may
+	// specify. Larger durations overflow a [time.Duration].
+	MaxDuration = math.MaxInt64 / uint64(time.Second)
+
+	year = 365 * 24 * time.Hour
+)
+
+var (
+	_ Curve = (*ConsumptionRateCurve)(nil)
+	_ Curve = (*FixedAPRCurve)(nil)
+	_ Curve = (*StepwiseCurve)(nil)
+
+	_ Calculator = (*fixedRateCalculator)(nil)
+	_ Calculator = (*stepwiseCalculator)(nil)
+
+	bigYear = new(big.Int).SetUint64(uint64(year))
+
+	ErrRateTooLarge          = errors.New("rate too large")
+	ErrMinRateExceedsMaxRate = errors.New("min consumption rate exceeds max consumption rate")
+	ErrZeroMintingPeriod     = errors.New("minting period must be non-zero")
+	ErrDurationTooLarge      = errors.New("duration too large")
+	ErrNoSteps               = errors.New("no steps")
+	ErrTooManySteps          = errors.New("too many steps")
+	ErrStepsNotSorted        = errors.New("steps not sorted by strictly increasing duration")
+)
+
+// Curve describes how staking rewards are minted on a permissionless subnet.
+//
+// The supply cap of the subnet is not part of the curve so that a curve can
+// never mint past the maximum supply the subnet was created with.
+type Curve interface {
+	Verify() error
+
+	// Calculator returns the calculator described by this curve for a subnet
+	// whose supply may not exceed [supplyCap].
+	Calculator(supplyCap uint64) Calculator
+}
+
+// Curves returns the registry of reward curves, ordered by version.
+//
+// The index of a curve is its version. Curves are registered into the P-chain
+// codec in this order, so new curves must only ever be appended.
+func Curves() []Curve {
+	return []Curve{
+		&ConsumptionRateCurve{},
+		&FixedAPRCurve{},
+		&StepwiseCurve{},
+	}
+}
+
+// ConsumptionRateCurve is the curve used by [NewCalculator], where the
+// consumption rate is interpolated by the staking duration and the reward is
+// asymptotic to the supply cap.
+type ConsumptionRateCurve struct {
+	// MaxConsumptionRate is the rate to allocate funds if the validator's stake
+	// duration is equal to [MintingPeriod]
+	MaxConsumptionRate uint64 `serialize:"true" json:"maxConsumptionRate"`
+	// MinConsumptionRate is the rate to allocate funds if the validator's stake
+	// duration is 0.
+	MinConsumptionRate uint64 `serialize:"true" json:"minConsumptionRate"`
+	// MintingPeriod, in seconds, that the calculator runs on.
+	MintingPeriod uint64 `serialize:"true" json:"mintingPeriod"`
+}
+
+func (c *ConsumptionRateCurve) Verify() error {
+	switch {
+	case c.MaxConsumptionRate > PercentDenominator:
+		return fmt.Errorf("%w: max consumption rate %d > %d", ErrRateTooLarge, c.MaxConsumptionRate, PercentDenominator)
+	case c.MinConsumptionRate > c.MaxConsumptionRate:
+		return ErrMinRateExceedsMaxRate
+	case c.MintingPeriod == 0:
+		return ErrZeroMintingPeriod
+	case c.MintingPeriod > MaxDuration:
+		return fmt.Errorf("%w: minting period %d > %d", ErrDurationTooLarge, c.MintingPeriod, MaxDuration)
+	default:
+		return nil
+	}
+}
+
+func (c *ConsumptionRateCurve) Calculator(supplyCap uint64) Calculator {
+	return NewCalculator(Config{
+		MaxConsumptionRate: c.MaxConsumptionRate,
+		MinConsumptionRate: c.MinConsumptionRate,
+		MintingPeriod:      time.Duration(c.MintingPeriod) * time.Second,
+		SupplyCap:          supplyCap,
+	})
+}
+
+// FixedAPRCurve rewards stakers with a fixed annual rate of their stake,
+// regardless of the staking duration or the current supply.
+type FixedAPRCurve struct {
+	// Rate is the annual reward rate, denominated in [PercentDenominator].
+	Rate uint64 `serialize:"true" json:"rate"`
+}
+
+func (c *FixedAPRCurve) Verify() error {
+	if c.Rate > MaxRate {
+		return fmt.Errorf("%w: %d > %d", ErrRateTooLarge, c.Rate, MaxRate)
+	}
+	return nil
+}
+
+func (c *FixedAPRCurve) Calculator(supplyCap uint64) Calculator {
+	return newFixedRateCalculator(c.Rate, supplyCap)
+}
+
+// Step is the annual rate given to stakers whose staking duration is at least
+// [MinDuration].
+type Step struct {
+	// MinDuration, in seconds, that must be staked to receive [Rate].
+	MinDuration uint64 `serialize:"true" json:"minDuration"`
+	// Rate is the annual reward rate, denominated in [PercentDenominator].
+	Rate uint64 `serialize:"true" json:"rate"`
+}
+
+// StepwiseCurve rewards stakers with a fixed annual rate that is chosen by
+// their staking duration. Stakers that stake for less than the first step's
+// [MinDuration] are not rewarded.
+type StepwiseCurve struct {
+	// Steps sorted by strictly increasing [MinDuration].
+	Steps []Step `serialize:"true" json:"steps"`
+}
+
+func (c *StepwiseCurve) Verify() error {
+	switch numSteps := len(c.Steps); {
+	case numSteps == 0:
+		return ErrNoSteps
+	case numSteps > MaxSteps:
+		return fmt.Errorf("%w: %d > %d", ErrTooManySteps, numSteps, MaxSteps)
+	}
+
+	for i, step := range c.Steps {
+		if step.Rate > MaxRate {
+			return fmt.Errorf("%w: step %d has rate %d > %d", ErrRateTooLarge, i, step.Rate, MaxRate)
+		}
+		if step.MinDuration > MaxDuration {
+			return fmt.Errorf("%w: step %d has min duration %d > %d", ErrDurationTooLarge, i, step.MinDuration, MaxDuration)
+		}
+		if i > 0 && step.MinDuration <= c.Steps[i-1].MinDuration {
+			return fmt.Errorf("%w: step %d", ErrStepsNotSorted, i)
+		}
+	}
+	return nil
+}
+
+func (c *StepwiseCurve) Calculator(supplyCap uint64) Calculator {
+	calculator := &stepwiseCalculator{
+		minDurations: make([]time.Duration, len(c.Steps)),
+		calculators:  make([]*fixedRateCalculator, len(c.Steps)),
+	}
+	for i, step := range c.Steps {
+		calculator.minDurations[i] = time.Duration(step.MinDuration) * time.Second
+		calculator.calculators[i] = newFixedRateCalculator(step.Rate, supplyCap)
+	}
+	return calculator
+}
+
+type fixedRateCalculator struct {
+	rate      *big.Int
+	supplyCap uint64
+}
+
+func newFixedRateCalculator(rate, supplyCap uint64) *fixedRateCalculator {
+	return &fixedRateCalculator{
+		rate:      new(big.Int).SetUint64(rate),
+		supplyCap: supplyCap,
+	}
+}
+
+// Calculate returns the amount of tokens to reward the staker with.
+//
+// RemainingSupply = SupplyCap - ExistingSupply
+// Reward = min(StakedAmount * Rate * StakingDuration / Year, RemainingSupply)
+func (c *fixedRateCalculator) Calculate(stakedDuration time.Duration, stakedAmount, currentSupply uint64) uint64 {
+	if currentSupply >= c.supplyCap {
+		return 0
+	}
+
+	reward := new(big.Int).SetUint64(stakedAmount)
+	reward.Mul(reward, c.rate)
+	reward.Mul(reward, new(big.Int).SetUint64(uint64(stakedDuration)))
+	reward.Div(reward, consumptionRateDenominator)
+	reward.Div(reward, bigYear)
+
+	remainingSupply := c.supplyCap - currentSupply
+	if !reward.IsUint64() {
+		return remainingSupply
+	}
+	return min(remainingSupply, reward.Uint64())
+}
+
+type stepwiseCalculator struct {
+	minDurations []time.Duration
+	calculators  []*fixedRateCalculator
+}
+
+func (c *stepwiseCalculator) Calculate(stakedDuration time.Duration, stakedAmount, currentSupply uint64) uint64 {
+	for i := len(c.minDurations) - 1; i >= 0; i-- {
+		if stakedDuration >= c.minDurations[i] {
+			return c.calculators[i].Calculate(stakedDuration, stakedAmount, currentSupply)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/utils/units"
+)
+
+func TestCurveVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		curve       Curve
+		expectedErr error
+	}{
+		{
+			name: "valid consumption rate curve",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: .12 * PercentDenominator,
+				MinConsumptionRate: .10 * PercentDenominator,
+				MintingPeriod:      uint64(year / time.Second),
+			},
+		},
+		{
+			name: "consumption rate too large",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: PercentDenominator + 1,
+				MintingPeriod:      1,
+			},
+			expectedErr: ErrRateTooLarge,
+		},
+		{
+			name: "min consumption rate exceeds max consumption rate",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: .10 * PercentDenominator,
+				MinConsumptionRate: .12 * PercentDenominator,
+				MintingPeriod:      1,
+			},
+			expectedErr: ErrMinRateExceedsMaxRate,
+		},
+		{
+			name: "zero minting period",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: .12 * PercentDenominator,
+				MinConsumptionRate: .10 * PercentDenominator,
+			},
+			expectedErr: ErrZeroMintingPeriod,
+		},
+		{
+			name: "max minting period",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: .12 * PercentDenominator,
+				MinConsumptionRate: .10 * PercentDenominator,
+				MintingPeriod:      MaxDuration,
+			},
+		},
+		{
+			name: "minting period overflows",
+			curve: &ConsumptionRateCurve{
+				MaxConsumptionRate: .12 * PercentDenominator,
+				MinConsumptionRate: .10 * PercentDenominator,
+				MintingPeriod:      1 << 55,
+			},
+			expectedErr: ErrDurationTooLarge,
+		},
+		{
+			name: "valid fixed APR curve",
+			curve: &FixedAPRCurve{
+				Rate: MaxRate,
+			},
+		},
+		{
+			name: "fixed APR too large",
+			curve: &FixedAPRCurve{
+				Rate: MaxRate + 1,
+			},
+			expectedErr: ErrRateTooLarge,
+		},
+		{
+			name: "valid stepwise curve",
+			curve: &StepwiseCurve{
+				Steps: []Step{
+					{MinDuration: 0, Rate: .05 * PercentDenominator},
+					{MinDuration: 1, Rate: .08 * PercentDenominator},
+				},
+			},
+		},
+		{
+			name:        "no steps",
+			curve:       &StepwiseCurve{},
+			expectedErr: ErrNoSteps,
+		},
+		{
+			name: "too many steps",
+			curve: &StepwiseCurve{
+				Steps: make([]Step, MaxSteps+1),
+			},
+			expectedErr: ErrTooManySteps,
+		},
+		{
+			name: "step rate too large",
+			curve: &StepwiseCurve{
+				Steps: []Step{
+					{MinDuration: 0, Rate: MaxRate + 1},
+				},
+			},
+			expectedErr: ErrRateTooLarge,
+		},
+		{
+			name: "steps not sorted",
+			curve: &StepwiseCurve{
+				Steps: []Step{
+					{MinDuration: 1},
+					{MinDuration: 1},
+				},
+			},
+			expectedErr: ErrStepsNotSorted,
+		},
+		{
+			name: "step min duration overflows",
+			curve: &StepwiseCurve{
+				Steps: []Step{
+					{MinDuration: 0},
+					{MinDuration: 1 << 55},
+				},
+			},
+			expectedErr: ErrDurationTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.curve.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestCurveMaxDuration(t *testing.T) {
+	curves := []Curve{
+		&ConsumptionRateCurve{
+			MaxConsumptionRate: .12 * PercentDenominator,
+			MinConsumptionRate: .10 * PercentDenominator,
+			MintingPeriod:      MaxDuration,
+		},
+		&StepwiseCurve{
+			Steps: []Step{
+				{MinDuration: MaxDuration, Rate: .10 * PercentDenominator},
+			},
+		},
+	}
+	for _, curve := range curves {
+		require.NoError(t, curve.Verify())
+
+		// The largest valid durations must not overflow into a calculator
+		// that divides by zero.
+		c := curve.Calculator(720 * units.MegaAvax)
+		require.NotPanics(t, func() {
+			c.Calculate(year, units.MegaAvax, 360*units.MegaAvax)
+		})
+	}
+}
+
+func TestConsumptionRateCurve(t *testing.T) {
+	curve := &ConsumptionRateCurve{
+		MaxConsumptionRate: defaultConfig.MaxConsumptionRate,
+		MinConsumptionRate: defaultConfig.MinConsumptionRate,
+		MintingPeriod:      uint64(defaultConfig.MintingPeriod / time.Second),
+	}
+	var (
+		expected = NewCalculator(defaultConfig)
+		actual   = curve.Calculator(defaultConfig.SupplyCap)
+	)
+	for _, duration := range []time.Duration{defaultMinStakingDuration, defaultMaxStakingDuration} {
+		require.Equal(
+			t,
+			expected.Calculate(duration, units.MegaAvax, 360*units.MegaAvax),
+			actual.Calculate(duration, units.MegaAvax, 360*units.MegaAvax),
+		)
+	}
+}
+
+func TestFixedAPRCurve(t *testing.T) {
+	tests := []struct {
+		name           string
+		rate           uint64
+		duration       time.Duration
+		stakeAmount    uint64
+		currentSupply  uint64
+		expectedReward uint64
+	}{
+		{ // 1M * 10%
+			name:           "full year",
+			rate:           .10 * PercentDenominator,
+			duration:       year,
+			stakeAmount:    units.MegaAvax,
+			currentSupply:  360 * units.MegaAvax,
+			expectedReward: 100 * units.KiloAvax,
+		},
+		{ // 1M * 10% / 4
+			name:           "quarter year",
+			rate:           .10 * PercentDenominator,
+			duration:       year / 4,
+			stakeAmount:    units.MegaAvax,
+			currentSupply:  360 * units.MegaAvax,
+			expectedReward: 25 * units.KiloAvax,
+		},
+		{ // Independent of the current supply
+			name:           "low supply",
+			rate:           .10 * PercentDenominator,
+			duration:       year,
+			stakeAmount:    units.MegaAvax,
+			currentSupply:  units.MegaAvax,
+			expectedReward: 100 * units.KiloAvax,
+		},
+		{ // 720M - 719.99M
+			name:           "bounded by supply cap",
+			rate:           .10 * PercentDenominator,
+			duration:       year,
+			stakeAmount:    units.MegaAvax,
+			currentSupply:  720*units.MegaAvax - 10*units.KiloAvax,
+			expectedReward: 10 * units.KiloAvax,
+		},
+		{
+			name:           "supply cap reached",
+			rate:           .10 * PercentDenominator,
+			duration:       year,
+			stakeAmount:    units.MegaAvax,
+			currentSupply:  720 * units.MegaAvax,
+			expectedReward: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			curve := &FixedAPRCurve{
+				Rate: test.rate,
+			}
+			c := curve.Calculator(720 * units.MegaAvax)
+			reward := c.Calculate(test.duration, test.stakeAmount, test.currentSupply)
+			require.Equal(t, test.expectedReward, reward)
+		})
+	}
+}
+
+func TestStepwiseCurve(t *testing.T) {
+	curve := &StepwiseCurve{
+		Steps: []Step{
+			{
+				MinDuration: uint64(defaultMinStakingDuration / time.Second),
+				Rate:        .05 * PercentDenominator,
+			},
+			{
+				MinDuration: uint64(year / 2 / time.Second),
+				Rate:        .08 * PercentDenominator,
+			},
+		},
+	}
+	c := curve.Calculator(720 * units.MegaAvax)
+
+	tests := []struct {
+		name           string
+		duration       time.Duration
+		expectedReward uint64
+	}{
+		{
+			name:           "shorter than the first step",
+			duration:       defaultMinStakingDuration - time.Second,
+			expectedReward: 0,
+		},
+		{ // 1M * 5% / 4
+			name:           "first step",
+			duration:       year / 4,
+			expectedReward: 12_500 * units.Avax,
+		},
+		{ // 1M * 8% / 2
+			name:           "second step boundary",
+			duration:       year / 2,
+			expectedReward: 40 * units.KiloAvax,
+		},
+		{ // 1M * 8%
+			name:           "second step",
+			duration:       year,
+			expectedReward: 80 * units.KiloAvax,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reward := c.Calculate(test.duration, units.MegaAvax, 360*units.MegaAvax)
+			require.Equal(t, test.expectedReward, reward)
+		})
+	}
+}
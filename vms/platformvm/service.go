@@ -44,6 +44,8 @@ import (
 	avajson "github.com/MetalBlockchain/metalgo/utils/json"
 	safemath "github.com/MetalBlockchain/metalgo/utils/math"
 	platformapi "github.com/MetalBlockchain/metalgo/vms/platformvm/api"
+	txexecutor "github.com/MetalBlockchain/metalgo/vms/platformvm/txs/executor"
+	txfee "github.com/MetalBlockchain/metalgo/vms/platformvm/txs/fee"
 )
 
 const (
@@ -66,6 +68,8 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errInvalidDelegationFee       = errors.New("delegation fee exceeds the maximum")
+	errScheduledTxsDisabled       = errors.New("scheduled txs are disabled")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -381,6 +385,8 @@ type GetSubnetResponse struct {
 	Locktime    avajson.Uint64 `json:"locktime"`
 	// subnet transformation tx ID for an elastic subnet
 	SubnetTransformationTxID ids.ID `json:"subnetTransformationTxID"`
+	// ID of the tx that set the reward curve of an elastic subnet
+	RewardCurveTxID ids.ID `json:"rewardCurveTxID"`
 	// subnet conversion information for an L1
 	ConversionID   ids.ID              `json:"conversionID"`
 	ManagerChainID ids.ID              `json:"managerChainID"`
@@ -433,6 +439,15 @@ func (s *Service) GetSubnet(_ *http.Request, args *GetSubnetArgs, response *GetS
 		return err
 	}
 
+	switch rewardCurveTx, err := s.vm.state.GetSubnetRewardCurve(args.SubnetID); err {
+	case nil:
+		response.RewardCurveTxID = rewardCurveTx.ID()
+	case database.ErrNotFound:
+		response.RewardCurveTxID = ids.Empty
+	default:
+		return err
+	}
+
 	switch c, err := s.vm.state.GetSubnetToL1Conversion(args.SubnetID); err {
 	case nil:
 		response.IsPermissioned = false
@@ -1429,6 +1444,219 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// ScheduleTxArgs are the arguments for calling ScheduleTx
+type ScheduleTxArgs struct {
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+	// NotBefore is the unix time, in seconds, before which the tx must not be
+	// issued.
+	NotBefore avajson.Uint64 `json:"notBefore"`
+}
+
+// ScheduleTxReply is the response from calling ScheduleTx
+type ScheduleTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// CancelToken must be provided to CancelScheduledTx to cancel the tx.
+	CancelToken ids.ID `json:"cancelToken"`
+}
+
+// ScheduleTx stores a signed transaction on this node and issues it once the
+// chain time reaches [NotBefore].
+func (s *Service) ScheduleTx(_ *http.Request, args *ScheduleTxArgs, response *ScheduleTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "scheduleTx"),
+	)
+
+	if s.vm.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	if err := tx.SyntacticVerify(s.vm.ctx); err != nil {
+		return fmt.Errorf("couldn't verify tx: %w", err)
+	}
+
+	notBefore := time.Unix(int64(args.NotBefore), 0)
+	cancelToken, err := s.vm.scheduledTxs.Add(tx, notBefore)
+	if err != nil {
+		return fmt.Errorf("couldn't schedule tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.CancelToken = cancelToken
+	return nil
+}
+
+// ScheduledTx is a transaction that is issued once the chain time reaches
+// [NotBefore]
+type ScheduledTx struct {
+	TxID      ids.ID         `json:"txID"`
+	NotBefore avajson.Uint64 `json:"notBefore"`
+}
+
+// GetScheduledTxsReply is the response from calling GetScheduledTxs
+type GetScheduledTxsReply struct {
+	Txs []ScheduledTx `json:"txs"`
+}
+
+// GetScheduledTxs returns the IDs of the transactions that are scheduled on
+// this node, ordered by their not before time.
+func (s *Service) GetScheduledTxs(_ *http.Request, _ *struct{}, response *GetScheduledTxsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getScheduledTxs"),
+	)
+
+	if s.vm.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+
+	entries := s.vm.scheduledTxs.List()
+	response.Txs = make([]ScheduledTx, len(entries))
+	for i, entry := range entries {
+		response.Txs[i] = ScheduledTx{
+			TxID:      entry.Tx.ID(),
+			NotBefore: avajson.Uint64(entry.NotBefore.Unix()),
+		}
+	}
+	return nil
+}
+
+// CancelScheduledTxArgs are the arguments for calling CancelScheduledTx
+type CancelScheduledTxArgs struct {
+	TxID ids.ID `json:"txID"`
+	// CancelToken is the token that was returned when the tx was scheduled.
+	CancelToken ids.ID `json:"cancelToken"`
+}
+
+// CancelScheduledTx removes a transaction that hasn't been issued yet from the
+// scheduled transactions.
+func (s *Service) CancelScheduledTx(_ *http.Request, args *CancelScheduledTxArgs, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "cancelScheduledTx"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if s.vm.scheduledTxs == nil {
+		return errScheduledTxsDisabled
+	}
+	return s.vm.scheduledTxs.Cancel(args.TxID, args.CancelToken)
+}
+
+// ValidatorChange is a modification of the validator set made by a simulated
+// transaction.
+type ValidatorChange struct {
+	// Kind is one of currentValidator, currentDelegator, pendingValidator,
+	// pendingDelegator or l1Validator.
+	Kind string `json:"kind"`
+	// Action is one of added, modified or removed.
+	Action   string     `json:"action"`
+	SubnetID ids.ID     `json:"subnetID"`
+	NodeID   ids.NodeID `json:"nodeID"`
+	// ID is the ID of the staker's transaction, or the validationID of an L1
+	// validator.
+	ID     ids.ID         `json:"id"`
+	Weight avajson.Uint64 `json:"weight"`
+}
+
+type SimulateTxReply struct {
+	// Error is the reason the transaction would be rejected. If it is set, the
+	// other fields are unset.
+	Error string `json:"error,omitempty"`
+	// SignaturesVerified is false if the transaction didn't have any
+	// credentials.
+	SignaturesVerified bool                `json:"signaturesVerified"`
+	Complexity         gas.Dimensions      `json:"complexity"`
+	Gas                gas.Gas             `json:"gas"`
+	Fee                avajson.Uint64      `json:"fee"`
+	ConsumedUTXOs      []string            `json:"consumedUTXOs"`
+	ProducedUTXOs      []string            `json:"producedUTXOs"`
+	ValidatorChanges   []ValidatorChange   `json:"validatorChanges"`
+	Encoding           formatting.Encoding `json:"encoding"`
+}
+
+// SimulateTx executes a transaction on top of the preferred state without
+// issuing it, and returns the changes it would make.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "simulateTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	simulation, err := s.vm.manager.SimulateTx(tx)
+	if err != nil {
+		return fmt.Errorf("couldn't simulate tx: %w", err)
+	}
+
+	reply.Encoding = args.Encoding
+	if simulation.Err != nil {
+		reply.Error = simulation.Err.Error()
+		return nil
+	}
+
+	reply.SignaturesVerified = simulation.SignaturesVerified
+	reply.Complexity = simulation.Complexity
+	reply.Gas = simulation.Gas
+	reply.Fee = avajson.Uint64(simulation.Fee)
+	reply.ConsumedUTXOs, err = encodeUTXOs(args.Encoding, simulation.ConsumedUTXOs)
+	if err != nil {
+		return err
+	}
+	reply.ProducedUTXOs, err = encodeUTXOs(args.Encoding, simulation.ProducedUTXOs)
+	if err != nil {
+		return err
+	}
+	reply.ValidatorChanges = make([]ValidatorChange, len(simulation.ValidatorChanges))
+	for i, change := range simulation.ValidatorChanges {
+		reply.ValidatorChanges[i] = ValidatorChange{
+			Kind:     change.Kind,
+			Action:   change.Action,
+			SubnetID: change.SubnetID,
+			NodeID:   change.NodeID,
+			ID:       change.ID,
+			Weight:   avajson.Uint64(change.Weight),
+		}
+	}
+	return nil
+}
+
+func encodeUTXOs(encoding formatting.Encoding, utxos []*avax.UTXO) ([]string, error) {
+	utxoStrs := make([]string, len(utxos))
+	for i, utxo := range utxos {
+		utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encode UTXO to bytes: %w", err)
+		}
+
+		utxoStrs[i], err = formatting.Encode(encoding, utxoBytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encode utxo as %s: %w", encoding, err)
+		}
+	}
+	return utxoStrs, nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1762,6 +1990,138 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 	return nil
 }
 
+type EstimateRewardArgs struct {
+	// SubnetID defaults to the primary network.
+	SubnetID    ids.ID         `json:"subnetID"`
+	StakeAmount avajson.Uint64 `json:"stakeAmount"`
+	// StakeDuration is the length of the staking period, in seconds.
+	StakeDuration avajson.Uint64 `json:"stakeDuration"`
+	// DelegationFee is the share of the delegation rewards the validator
+	// receives, out of [reward.PercentDenominator].
+	DelegationFee avajson.Uint32 `json:"delegationFee"`
+	// Supply is the supply of the subnet when the staking period starts. If
+	// it is zero, the current supply is used.
+	Supply avajson.Uint64 `json:"supply"`
+	// NodeID is the node whose reward history is returned. If it is empty, no
+	// history is returned.
+	NodeID   ids.NodeID          `json:"nodeID"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+type EstimateRewardReply struct {
+	// Supply the reward was calculated with
+	Supply avajson.Uint64 `json:"supply"`
+	// Reward is the potential reward of the stake
+	Reward avajson.Uint64 `json:"reward"`
+	// ValidatorReward is the part of the reward the validator receives if the
+	// stake is delegated
+	ValidatorReward avajson.Uint64 `json:"validatorReward"`
+	// DelegatorReward is the part of the reward the delegator receives if the
+	// stake is delegated
+	DelegatorReward avajson.Uint64  `json:"delegatorReward"`
+	RewardHistory   []RewardHistory `json:"rewardHistory"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// RewardHistory describes the reward of a staking transaction.
+type RewardHistory struct {
+	TxID ids.ID `json:"txID"`
+	// Amount is the sum of the amounts of the reward UTXOs
+	Amount avajson.Uint64 `json:"amount"`
+	UTXOs  []string       `json:"utxos"`
+}
+
+// EstimateReward returns the potential reward of staking [args.StakeAmount]
+// for [args.StakeDuration], and how it would be split if the stake was
+// delegated.
+func (s *Service) EstimateReward(_ *http.Request, args *EstimateRewardArgs, reply *EstimateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "estimateReward"),
+	)
+
+	if args.DelegationFee > reward.PercentDenominator {
+		return fmt.Errorf("%w: %d > %d", errInvalidDelegationFee, args.DelegationFee, reward.PercentDenominator)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	rewards, err := txexecutor.GetRewardsCalculator(
+		&txexecutor.Backend{
+			Config:  &s.vm.Internal,
+			Rewards: s.vm.rewards,
+		},
+		s.vm.state,
+		args.SubnetID,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't get rewards calculator: %w", err)
+	}
+
+	supply := uint64(args.Supply)
+	if supply == 0 {
+		supply, err = s.vm.state.GetCurrentSupply(args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("fetching current supply failed: %w", err)
+		}
+	}
+
+	potentialReward := rewards.Calculate(
+		time.Duration(args.StakeDuration)*time.Second,
+		uint64(args.StakeAmount),
+		supply,
+	)
+	validatorReward, delegatorReward := reward.Split(potentialReward, uint32(args.DelegationFee))
+
+	reply.Supply = avajson.Uint64(supply)
+	reply.Reward = avajson.Uint64(potentialReward)
+	reply.ValidatorReward = avajson.Uint64(validatorReward)
+	reply.DelegatorReward = avajson.Uint64(delegatorReward)
+	reply.Encoding = args.Encoding
+	if args.NodeID == ids.EmptyNodeID {
+		return nil
+	}
+
+	txIDs, err := s.vm.state.GetRewardTxIDs(args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get rewarded txs: %w", err)
+	}
+	reply.RewardHistory = make([]RewardHistory, len(txIDs))
+	for i, txID := range txIDs {
+		utxos, err := s.vm.state.GetRewardUTXOs(txID)
+		if err != nil {
+			return fmt.Errorf("couldn't get reward UTXOs: %w", err)
+		}
+
+		history := RewardHistory{
+			TxID:  txID,
+			UTXOs: make([]string, len(utxos)),
+		}
+		for j, utxo := range utxos {
+			if out, ok := utxo.Out.(avax.Amounter); ok {
+				amount, err := safemath.Add(uint64(history.Amount), out.Amount())
+				if err != nil {
+					return err
+				}
+				history.Amount = avajson.Uint64(amount)
+			}
+
+			utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, utxo)
+			if err != nil {
+				return fmt.Errorf("couldn't encode UTXO to bytes: %w", err)
+			}
+			history.UTXOs[j], err = formatting.Encode(args.Encoding, utxoBytes)
+			if err != nil {
+				return fmt.Errorf("couldn't encode utxo as %s: %w", args.Encoding, err)
+			}
+		}
+		reply.RewardHistory[i] = history
+	}
+	return nil
+}
+
 // GetTimestampReply is the response from GetTimestamp
 type GetTimestampReply struct {
 	// Current timestamp
@@ -1994,6 +2354,137 @@ func (s *Service) GetFeeState(_ *http.Request, _ *struct{}, reply *GetFeeStateRe
 	return nil
 }
 
+type EstimateFeeArgs struct {
+	// Tx is the byte representation of a signed or unsigned transaction. If it
+	// is empty, the fee is estimated from TxType, NumInputs and NumOutputs.
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+	// TxType is the name of the transaction type, such as BaseTx.
+	TxType     string         `json:"txType"`
+	NumInputs  avajson.Uint32 `json:"numInputs"`
+	NumOutputs avajson.Uint32 `json:"numOutputs"`
+	// Seconds is how far past the current time the fee is projected.
+	Seconds avajson.Uint64 `json:"seconds"`
+}
+
+type EstimateFeeReply struct {
+	Complexity gas.Dimensions `json:"complexity"`
+	Gas        gas.Gas        `json:"gas"`
+	Price      gas.Price      `json:"price"`
+	Fee        avajson.Uint64 `json:"fee"`
+	Time       time.Time      `json:"timestamp"`
+	// The projected price and fee assume that no gas is consumed until the
+	// projected time, so they are a lower bound.
+	ProjectedPrice gas.Price      `json:"projectedPrice"`
+	ProjectedFee   avajson.Uint64 `json:"projectedFee"`
+	ProjectedTime  time.Time      `json:"projectedTimestamp"`
+}
+
+// EstimateFee returns the fee a transaction would pay if it was issued now, and
+// the fee it would pay if it was issued [args.Seconds] later.
+func (s *Service) EstimateFee(_ *http.Request, args *EstimateFeeArgs, reply *EstimateFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "estimateFee"),
+	)
+
+	complexity, err := estimateComplexity(args)
+	if err != nil {
+		return err
+	}
+
+	config := s.vm.DynamicFeeConfig
+	gasUsed, err := complexity.ToGas(config.Weights)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate gas: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	// The fee state is advanced to the current time, as it would be by the next
+	// block.
+	var (
+		feeState  = s.vm.state.GetFeeState()
+		timestamp = s.vm.state.GetTimestamp()
+		now       = s.vm.clock.Time()
+	)
+	if now.Before(timestamp) {
+		now = timestamp
+	}
+	feeState = feeState.AdvanceTime(
+		config.MaxCapacity,
+		config.MaxPerSecond,
+		config.TargetPerSecond,
+		uint64(now.Sub(timestamp)/time.Second),
+	)
+	projectedFeeState := feeState.AdvanceTime(
+		config.MaxCapacity,
+		config.MaxPerSecond,
+		config.TargetPerSecond,
+		uint64(args.Seconds),
+	)
+
+	reply.Complexity = complexity
+	reply.Gas = gasUsed
+	reply.Time = now
+	reply.Price, reply.Fee, err = s.feeAt(gasUsed, feeState, now)
+	if err != nil {
+		return err
+	}
+	reply.ProjectedTime = now.Add(time.Duration(args.Seconds) * time.Second)
+	reply.ProjectedPrice, reply.ProjectedFee, err = s.feeAt(gasUsed, projectedFeeState, reply.ProjectedTime)
+	return err
+}
+
+func estimateComplexity(args *EstimateFeeArgs) (gas.Dimensions, error) {
+	if args.Tx == "" {
+		complexity, err := txfee.EstimateTxComplexity(
+			args.TxType,
+			int(args.NumInputs),
+			int(args.NumOutputs),
+		)
+		if err != nil {
+			return gas.Dimensions{}, fmt.Errorf("couldn't estimate tx complexity: %w", err)
+		}
+		return complexity, nil
+	}
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return gas.Dimensions{}, fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return gas.Dimensions{}, fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	complexity, err := txfee.TxComplexity(tx.Unsigned)
+	if err != nil {
+		return gas.Dimensions{}, fmt.Errorf("couldn't calculate tx complexity: %w", err)
+	}
+	return complexity, nil
+}
+
+// feeAt returns the price and fee of [gas] at [timestamp]. Transactions don't
+// pay a fee before Etna is activated.
+func (s *Service) feeAt(gasUsed gas.Gas, feeState gas.State, timestamp time.Time) (gas.Price, avajson.Uint64, error) {
+	if !s.vm.UpgradeConfig.IsEtnaActivated(timestamp) {
+		return 0, 0, nil
+	}
+
+	config := s.vm.DynamicFeeConfig
+	price := gas.CalculatePrice(
+		config.MinPrice,
+		feeState.Excess,
+		config.ExcessConversionConstant,
+	)
+	txFee, err := gasUsed.Cost(price)
+	if err != nil {
+		return 0, 0, fmt.Errorf("couldn't calculate fee: %w", err)
+	}
+	return price, avajson.Uint64(txFee), nil
+}
+
 // GetValidatorFeeConfig returns the validator fee config of the chain.
 func (s *Service) GetValidatorFeeConfig(_ *http.Request, _ *struct{}, reply *fee.Config) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -42,6 +42,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/block"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/block/executor/executormock"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/genesis/genesistest"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/state"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/status"
@@ -49,6 +50,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/validators/fee"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/warp/message"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/vms/txs/scheduler"
 	"github.com/MetalBlockchain/metalgo/vms/types"
 	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary/common"
 
@@ -57,6 +59,7 @@ import (
 	blockbuilder "github.com/MetalBlockchain/metalgo/vms/platformvm/block/builder"
 	blockexecutor "github.com/MetalBlockchain/metalgo/vms/platformvm/block/executor"
 	txexecutor "github.com/MetalBlockchain/metalgo/vms/platformvm/txs/executor"
+	txfee "github.com/MetalBlockchain/metalgo/vms/platformvm/txs/fee"
 )
 
 var encodings = []formatting.Encoding{
@@ -386,6 +389,117 @@ func TestGetTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	tests := []struct {
+		name               string
+		weightOffset       uint64
+		unsigned           bool
+		expectedErr        error
+		signaturesVerified bool
+	}{
+		{
+			name:               "signed",
+			signaturesVerified: true,
+		},
+		{
+			name:     "unsigned",
+			unsigned: true,
+		},
+		{
+			name:         "weight too small",
+			weightOffset: 1,
+			expectedErr:  txexecutor.ErrWeightTooSmall,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			service, _ := defaultService(t)
+
+			service.vm.ctx.Lock.Lock()
+			wallet := newWallet(t, service.vm, walletConfig{})
+
+			sk, err := localsigner.New()
+			require.NoError(err)
+			pop, err := signer.NewProofOfPossession(sk)
+			require.NoError(err)
+
+			var (
+				nodeID       = ids.GenerateTestNodeID()
+				weight       = service.vm.MinValidatorStake - test.weightOffset
+				rewardsOwner = &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				}
+			)
+			tx, err := wallet.IssueAddPermissionlessValidatorTx(
+				&txs.SubnetValidator{
+					Validator: txs.Validator{
+						NodeID: nodeID,
+						End:    uint64(service.vm.clock.Time().Add(defaultMinStakingDuration).Unix()),
+						Wght:   weight,
+					},
+					Subnet: constants.PrimaryNetworkID,
+				},
+				pop,
+				service.vm.ctx.AVAXAssetID,
+				rewardsOwner,
+				rewardsOwner,
+				reward.PercentDenominator,
+			)
+			require.NoError(err)
+			service.vm.ctx.Lock.Unlock()
+
+			txBytes := tx.Bytes()
+			if test.unsigned {
+				txBytes, err = txs.Codec.Marshal(txs.CodecVersion, &txs.Tx{
+					Unsigned: tx.Unsigned,
+				})
+				require.NoError(err)
+			}
+			txStr, err := formatting.Encode(formatting.Hex, txBytes)
+			require.NoError(err)
+
+			var reply SimulateTxReply
+			require.NoError(service.SimulateTx(nil, &api.FormattedTx{
+				Tx:       txStr,
+				Encoding: formatting.Hex,
+			}, &reply))
+			if test.expectedErr != nil {
+				require.Contains(reply.Error, test.expectedErr.Error())
+				return
+			}
+
+			require.Empty(reply.Error)
+			require.Equal(test.signaturesVerified, reply.SignaturesVerified)
+			require.NotZero(reply.Fee)
+			require.Len(reply.ConsumedUTXOs, len(tx.Unsigned.InputIDs()))
+			require.NotEmpty(reply.ProducedUTXOs)
+			require.Len(reply.ValidatorChanges, 1)
+
+			// The ID of an unsigned transaction changes once it is signed.
+			change := reply.ValidatorChanges[0]
+			if test.signaturesVerified {
+				require.Equal(tx.ID(), change.ID)
+			}
+			change.ID = ids.Empty
+			require.Equal(ValidatorChange{
+				Kind:     blockexecutor.CurrentValidator,
+				Action:   blockexecutor.Added,
+				SubnetID: constants.PrimaryNetworkID,
+				NodeID:   nodeID,
+				Weight:   avajson.Uint64(weight),
+			}, change)
+
+			// Simulating the transaction must not issue it.
+			service.vm.ctx.Lock.Lock()
+			_, err = service.vm.Builder.BuildBlock(context.Background())
+			service.vm.ctx.Lock.Unlock()
+			require.ErrorIs(err, blockbuilder.ErrNoPendingBlocks)
+		})
+	}
+}
+
 func TestGetBalance(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -1330,6 +1444,263 @@ func FuzzGetFeeState(f *testing.F) {
 	})
 }
 
+func TestScheduleTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	subnetID := testSubnet1.ID()
+	wallet := newWallet(t, service.vm, walletConfig{
+		subnetIDs: []ids.ID{subnetID},
+	})
+	tx, err := wallet.IssueCreateChainTx(
+		subnetID,
+		[]byte{},
+		constants.AVMID,
+		[]ids.ID{},
+		"chain name",
+	)
+	require.NoError(err)
+	now := service.vm.clock.Time()
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	notBefore := now.Add(time.Hour)
+	args := &ScheduleTxArgs{
+		Tx:        txStr,
+		Encoding:  formatting.Hex,
+		NotBefore: avajson.Uint64(notBefore.Unix()),
+	}
+	var reply ScheduleTxReply
+	require.NoError(service.ScheduleTx(nil, args, &reply))
+	require.Equal(tx.ID(), reply.TxID)
+
+	err = service.ScheduleTx(nil, args, &ScheduleTxReply{})
+	require.ErrorIs(err, scheduler.ErrDuplicateTx)
+
+	var getReply GetScheduledTxsReply
+	require.NoError(service.GetScheduledTxs(nil, nil, &getReply))
+	require.Equal(
+		GetScheduledTxsReply{
+			Txs: []ScheduledTx{
+				{
+					TxID:      tx.ID(),
+					NotBefore: avajson.Uint64(notBefore.Unix()),
+				},
+			},
+		},
+		getReply,
+	)
+
+	// The tx isn't issued before the chain time reaches its not before time.
+	_, ok := service.vm.Builder.Get(tx.ID())
+	require.False(ok)
+
+	// Only the submitter, who knows the cancel token, can cancel the tx.
+	cancelArgs := &CancelScheduledTxArgs{
+		TxID:        tx.ID(),
+		CancelToken: ids.GenerateTestID(),
+	}
+	err = service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{})
+	require.ErrorIs(err, scheduler.ErrInvalidCancelToken)
+
+	cancelArgs.CancelToken = reply.CancelToken
+	require.NoError(service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{}))
+	err = service.CancelScheduledTx(nil, cancelArgs, &api.EmptyReply{})
+	require.ErrorIs(err, scheduler.ErrUnknownTx)
+
+	args.NotBefore = avajson.Uint64(now.Unix())
+	require.NoError(service.ScheduleTx(nil, args, &ScheduleTxReply{}))
+	require.Eventually(func() bool {
+		_, ok := service.vm.Builder.Get(tx.ID())
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Zero(service.vm.scheduledTxs.Len())
+}
+
+func TestScheduleTxDisabled(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.scheduledTxs = nil
+
+	err := service.ScheduleTx(nil, &ScheduleTxArgs{}, &ScheduleTxReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+
+	err = service.GetScheduledTxs(nil, nil, &GetScheduledTxsReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+
+	err = service.CancelScheduledTx(nil, &CancelScheduledTxArgs{}, &api.EmptyReply{})
+	require.ErrorIs(err, errScheduledTxsDisabled)
+}
+
+func TestEstimateFee(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+
+	var (
+		config   = defaultDynamicFeeConfig
+		now      = time.Unix(1_800_000_000, 0)
+		excess   = 10 * config.ExcessConversionConstant
+		feeState = gas.State{
+			Capacity: config.MaxCapacity,
+			Excess:   excess,
+		}
+		seconds uint64 = 60
+	)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.clock.Set(now)
+	service.vm.state.SetTimestamp(now)
+	service.vm.state.SetFeeState(feeState)
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+			},
+		},
+	}})
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	var reply EstimateFeeReply
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+		Seconds:  avajson.Uint64(seconds),
+	}, &reply))
+
+	expectedComplexity, err := txfee.TxComplexity(tx.Unsigned)
+	require.NoError(err)
+	expectedGas, err := expectedComplexity.ToGas(config.Weights)
+	require.NoError(err)
+
+	expectedPrice := gas.CalculatePrice(config.MinPrice, excess, config.ExcessConversionConstant)
+	expectedFee, err := expectedGas.Cost(expectedPrice)
+	require.NoError(err)
+
+	projectedExcess := excess.SubPerSecond(config.TargetPerSecond, seconds)
+	expectedProjectedPrice := gas.CalculatePrice(config.MinPrice, projectedExcess, config.ExcessConversionConstant)
+	expectedProjectedFee, err := expectedGas.Cost(expectedProjectedPrice)
+	require.NoError(err)
+
+	require.Equal(EstimateFeeReply{
+		Complexity:     expectedComplexity,
+		Gas:            expectedGas,
+		Price:          expectedPrice,
+		Fee:            avajson.Uint64(expectedFee),
+		Time:           now,
+		ProjectedPrice: expectedProjectedPrice,
+		ProjectedFee:   avajson.Uint64(expectedProjectedFee),
+		ProjectedTime:  now.Add(time.Duration(seconds) * time.Second),
+	}, reply)
+	require.Less(reply.ProjectedFee, reply.Fee)
+
+	// Estimating the fee from the shape of the tx doesn't require the tx.
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		TxType:     "BaseTx",
+		NumInputs:  avajson.Uint32(len(tx.Unsigned.InputIDs())),
+		NumOutputs: 2,
+	}, &reply))
+	expectedComplexity, err = txfee.EstimateTxComplexity("BaseTx", len(tx.Unsigned.InputIDs()), 2)
+	require.NoError(err)
+	require.Equal(expectedComplexity, reply.Complexity)
+
+	err = service.EstimateFee(nil, &EstimateFeeArgs{
+		TxType: "AdvanceTimeTx",
+	}, &reply)
+	require.ErrorIs(err, txfee.ErrUnknownTxType)
+}
+
+func TestEstimateReward(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+
+	var (
+		nodeID      = ids.GenerateTestNodeID()
+		stakeAmount = service.vm.MinDelegatorStake
+		duration    = defaultMinStakingDuration
+		shares      = uint32(reward.PercentDenominator / 10)
+		rewardUTXO  = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 12345,
+			},
+		}
+		delegatorTx = &txs.Tx{
+			Unsigned: &txs.AddPermissionlessDelegatorTx{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+				},
+				DelegationRewardsOwner: &secp256k1fx.OutputOwners{},
+			},
+		}
+	)
+	require.NoError(delegatorTx.Initialize(txs.Codec))
+
+	service.vm.ctx.Lock.Lock()
+	supply, err := service.vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	service.vm.state.AddTx(delegatorTx, status.Committed)
+	service.vm.state.AddRewardUTXO(delegatorTx.ID(), rewardUTXO)
+	require.NoError(service.vm.state.Commit())
+	service.vm.ctx.Lock.Unlock()
+
+	var reply EstimateRewardReply
+	require.NoError(service.EstimateReward(nil, &EstimateRewardArgs{
+		SubnetID:      constants.PrimaryNetworkID,
+		StakeAmount:   avajson.Uint64(stakeAmount),
+		StakeDuration: avajson.Uint64(duration / time.Second),
+		DelegationFee: avajson.Uint32(shares),
+		NodeID:        nodeID,
+		Encoding:      formatting.Hex,
+	}, &reply))
+
+	expectedReward := reward.NewCalculator(service.vm.RewardConfig).Calculate(duration, stakeAmount, supply)
+	expectedValidatorReward, expectedDelegatorReward := reward.Split(expectedReward, shares)
+	require.NotZero(expectedReward)
+	require.Equal(avajson.Uint64(supply), reply.Supply)
+	require.Equal(avajson.Uint64(expectedReward), reply.Reward)
+	require.Equal(avajson.Uint64(expectedValidatorReward), reply.ValidatorReward)
+	require.Equal(avajson.Uint64(expectedDelegatorReward), reply.DelegatorReward)
+
+	utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, rewardUTXO)
+	require.NoError(err)
+	utxoStr, err := formatting.Encode(formatting.Hex, utxoBytes)
+	require.NoError(err)
+	require.Equal([]RewardHistory{{
+		TxID:   delegatorTx.ID(),
+		Amount: 12345,
+		UTXOs:  []string{utxoStr},
+	}}, reply.RewardHistory)
+
+	// A larger future supply reduces the reward.
+	reply = EstimateRewardReply{}
+	require.NoError(service.EstimateReward(nil, &EstimateRewardArgs{
+		StakeAmount:   avajson.Uint64(stakeAmount),
+		StakeDuration: avajson.Uint64(duration / time.Second),
+		Supply:        avajson.Uint64(supply + (service.vm.RewardConfig.SupplyCap-supply)/2),
+	}, &reply))
+	require.Less(uint64(reply.Reward), expectedReward)
+	require.Empty(reply.RewardHistory)
+
+	err = service.EstimateReward(nil, &EstimateRewardArgs{
+		DelegationFee: reward.PercentDenominator + 1,
+	}, &reply)
+	require.ErrorIs(err, errInvalidDelegationFee)
+}
+
 func TestGetCurrentValidatorsForL1(t *testing.T) {
 	subnetID := ids.GenerateTestID()
 
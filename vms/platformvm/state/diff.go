@@ -59,6 +59,8 @@ type diff struct {
 	subnetToL1Conversions map[ids.ID]SubnetToL1Conversion
 	// Subnet ID --> Tx that transforms the subnet
 	transformedSubnets map[ids.ID]*txs.Tx
+	// Subnet ID --> Tx that sets the reward curve of the subnet
+	subnetRewardCurves map[ids.ID]*txs.Tx
 
 	addedChains map[ids.ID][]*txs.Tx
 
@@ -477,6 +479,31 @@ func (d *diff) AddSubnetTransformation(transformSubnetTxIntf *txs.Tx) {
 	}
 }
 
+func (d *diff) GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error) {
+	tx, exists := d.subnetRewardCurves[subnetID]
+	if exists {
+		return tx, nil
+	}
+
+	// If the reward curve wasn't set in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, ErrMissingParentState
+	}
+	return parentState.GetSubnetRewardCurve(subnetID)
+}
+
+func (d *diff) SetSubnetRewardCurve(setSubnetRewardCurveTxIntf *txs.Tx) {
+	setSubnetRewardCurveTx := setSubnetRewardCurveTxIntf.Unsigned.(*txs.SetSubnetRewardCurveTx)
+	if d.subnetRewardCurves == nil {
+		d.subnetRewardCurves = map[ids.ID]*txs.Tx{
+			setSubnetRewardCurveTx.Subnet: setSubnetRewardCurveTxIntf,
+		}
+	} else {
+		d.subnetRewardCurves[setSubnetRewardCurveTx.Subnet] = setSubnetRewardCurveTxIntf
+	}
+}
+
 func (d *diff) AddChain(createChainTx *txs.Tx) {
 	tx := createChainTx.Unsigned.(*txs.CreateChainTx)
 	if d.addedChains == nil {
@@ -649,6 +676,9 @@ func (d *diff) Apply(baseState Chain) error {
 	for _, tx := range d.transformedSubnets {
 		baseState.AddSubnetTransformation(tx)
 	}
+	for _, tx := range d.subnetRewardCurves {
+		baseState.SetSubnetRewardCurve(tx)
+	}
 	for _, chains := range d.addedChains {
 		for _, chain := range chains {
 			baseState.AddChain(chain)
@@ -413,6 +413,21 @@ func (mr *MockChainMockRecorder) GetSubnetOwner(subnetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwner", reflect.TypeOf((*MockChain)(nil).GetSubnetOwner), subnetID)
 }
 
+// GetSubnetRewardCurve mocks base method.
+func (m *MockChain) GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetRewardCurve", subnetID)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetRewardCurve indicates an expected call of GetSubnetRewardCurve.
+func (mr *MockChainMockRecorder) GetSubnetRewardCurve(subnetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetRewardCurve", reflect.TypeOf((*MockChain)(nil).GetSubnetRewardCurve), subnetID)
+}
+
 // GetSubnetToL1Conversion mocks base method.
 func (m *MockChain) GetSubnetToL1Conversion(subnetID ids.ID) (SubnetToL1Conversion, error) {
 	m.ctrl.T.Helper()
@@ -684,6 +699,18 @@ func (mr *MockChainMockRecorder) SetSubnetOwner(subnetID, owner any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockChain)(nil).SetSubnetOwner), subnetID, owner)
 }
 
+// SetSubnetRewardCurve mocks base method.
+func (m *MockChain) SetSubnetRewardCurve(setSubnetRewardCurveTx *txs.Tx) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetRewardCurve", setSubnetRewardCurveTx)
+}
+
+// SetSubnetRewardCurve indicates an expected call of SetSubnetRewardCurve.
+func (mr *MockChainMockRecorder) SetSubnetRewardCurve(setSubnetRewardCurveTx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetRewardCurve", reflect.TypeOf((*MockChain)(nil).SetSubnetRewardCurve), setSubnetRewardCurveTx)
+}
+
 // SetSubnetToL1Conversion mocks base method.
 func (m *MockChain) SetSubnetToL1Conversion(subnetID ids.ID, c SubnetToL1Conversion) {
 	m.ctrl.T.Helper()
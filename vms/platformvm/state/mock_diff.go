@@ -427,6 +427,21 @@ func (mr *MockDiffMockRecorder) GetSubnetOwner(subnetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwner", reflect.TypeOf((*MockDiff)(nil).GetSubnetOwner), subnetID)
 }
 
+// GetSubnetRewardCurve mocks base method.
+func (m *MockDiff) GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetRewardCurve", subnetID)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetRewardCurve indicates an expected call of GetSubnetRewardCurve.
+func (mr *MockDiffMockRecorder) GetSubnetRewardCurve(subnetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetRewardCurve", reflect.TypeOf((*MockDiff)(nil).GetSubnetRewardCurve), subnetID)
+}
+
 // GetSubnetToL1Conversion mocks base method.
 func (m *MockDiff) GetSubnetToL1Conversion(subnetID ids.ID) (SubnetToL1Conversion, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +713,18 @@ func (mr *MockDiffMockRecorder) SetSubnetOwner(subnetID, owner any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockDiff)(nil).SetSubnetOwner), subnetID, owner)
 }
 
+// SetSubnetRewardCurve mocks base method.
+func (m *MockDiff) SetSubnetRewardCurve(setSubnetRewardCurveTx *txs.Tx) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetRewardCurve", setSubnetRewardCurveTx)
+}
+
+// SetSubnetRewardCurve indicates an expected call of SetSubnetRewardCurve.
+func (mr *MockDiffMockRecorder) SetSubnetRewardCurve(setSubnetRewardCurveTx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetRewardCurve", reflect.TypeOf((*MockDiff)(nil).SetSubnetRewardCurve), setSubnetRewardCurveTx)
+}
+
 // SetSubnetToL1Conversion mocks base method.
 func (m *MockDiff) SetSubnetToL1Conversion(subnetID ids.ID, c SubnetToL1Conversion) {
 	m.ctrl.T.Helper()
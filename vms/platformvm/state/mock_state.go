@@ -574,6 +574,21 @@ func (mr *MockStateMockRecorder) GetPendingValidator(subnetID, nodeID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockState)(nil).GetPendingValidator), subnetID, nodeID)
 }
 
+// GetRewardTxIDs mocks base method.
+func (m *MockState) GetRewardTxIDs(nodeID ids.NodeID) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardTxIDs", nodeID)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardTxIDs indicates an expected call of GetRewardTxIDs.
+func (mr *MockStateMockRecorder) GetRewardTxIDs(nodeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardTxIDs", reflect.TypeOf((*MockState)(nil).GetRewardTxIDs), nodeID)
+}
+
 // GetRewardUTXOs mocks base method.
 func (m *MockState) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -649,6 +664,21 @@ func (mr *MockStateMockRecorder) GetSubnetOwner(subnetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwner", reflect.TypeOf((*MockState)(nil).GetSubnetOwner), subnetID)
 }
 
+// GetSubnetRewardCurve mocks base method.
+func (m *MockState) GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetRewardCurve", subnetID)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetRewardCurve indicates an expected call of GetSubnetRewardCurve.
+func (mr *MockStateMockRecorder) GetSubnetRewardCurve(subnetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetRewardCurve", reflect.TypeOf((*MockState)(nil).GetSubnetRewardCurve), subnetID)
+}
+
 // GetSubnetToL1Conversion mocks base method.
 func (m *MockState) GetSubnetToL1Conversion(subnetID ids.ID) (SubnetToL1Conversion, error) {
 	m.ctrl.T.Helper()
@@ -876,6 +906,20 @@ func (mr *MockStateMockRecorder) ReindexBlocks(lock, log any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexBlocks", reflect.TypeOf((*MockState)(nil).ReindexBlocks), lock, log)
 }
 
+// ReindexRewardTxIDs mocks base method.
+func (m *MockState) ReindexRewardTxIDs(lock sync.Locker, log logging.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReindexRewardTxIDs", lock, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReindexRewardTxIDs indicates an expected call of ReindexRewardTxIDs.
+func (mr *MockStateMockRecorder) ReindexRewardTxIDs(lock, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexRewardTxIDs", reflect.TypeOf((*MockState)(nil).ReindexRewardTxIDs), lock, log)
+}
+
 // SetAccruedFees mocks base method.
 func (m *MockState) SetAccruedFees(f uint64) {
 	m.ctrl.T.Helper()
@@ -974,6 +1018,18 @@ func (mr *MockStateMockRecorder) SetSubnetOwner(subnetID, owner any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockState)(nil).SetSubnetOwner), subnetID, owner)
 }
 
+// SetSubnetRewardCurve mocks base method.
+func (m *MockState) SetSubnetRewardCurve(setSubnetRewardCurveTx *txs.Tx) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetRewardCurve", setSubnetRewardCurveTx)
+}
+
+// SetSubnetRewardCurve indicates an expected call of SetSubnetRewardCurve.
+func (mr *MockStateMockRecorder) SetSubnetRewardCurve(setSubnetRewardCurveTx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetRewardCurve", reflect.TypeOf((*MockState)(nil).SetSubnetRewardCurve), setSubnetRewardCurveTx)
+}
+
 // SetSubnetToL1Conversion mocks base method.
 func (m *MockState) SetSubnetToL1Conversion(subnetID ids.ID, c SubnetToL1Conversion) {
 	m.ctrl.T.Helper()
@@ -80,11 +80,13 @@ var (
 	ValidatorPublicKeyDiffsPrefix = []byte("flatPublicKeyDiffs")
 	TxPrefix                      = []byte("tx")
 	RewardUTXOsPrefix             = []byte("rewardUTXOs")
+	RewardTxIDsPrefix             = []byte("rewardTxIDs")
 	UTXOPrefix                    = []byte("utxo")
 	SubnetPrefix                  = []byte("subnet")
 	SubnetOwnerPrefix             = []byte("subnetOwner")
 	SubnetToL1ConversionPrefix    = []byte("subnetToL1Conversion")
 	TransformedSubnetPrefix       = []byte("transformedSubnet")
+	SubnetRewardCurvePrefix       = []byte("subnetRewardCurve")
 	SupplyPrefix                  = []byte("supply")
 	ChainPrefix                   = []byte("chain")
 	ExpiryReplayProtectionPrefix  = []byte("expiryReplayProtection")
@@ -95,15 +97,16 @@ var (
 	InactivePrefix                = []byte("inactive")
 	SingletonPrefix               = []byte("singleton")
 
-	TimestampKey         = []byte("timestamp")
-	FeeStateKey          = []byte("fee state")
-	L1ValidatorExcessKey = []byte("l1Validator excess")
-	AccruedFeesKey       = []byte("accrued fees")
-	CurrentSupplyKey     = []byte("current supply")
-	LastAcceptedKey      = []byte("last accepted")
-	HeightsIndexedKey    = []byte("heights indexed")
-	InitializedKey       = []byte("initialized")
-	BlocksReindexedKey   = []byte("blocks reindexed.3")
+	TimestampKey          = []byte("timestamp")
+	FeeStateKey           = []byte("fee state")
+	L1ValidatorExcessKey  = []byte("l1Validator excess")
+	AccruedFeesKey        = []byte("accrued fees")
+	CurrentSupplyKey      = []byte("current supply")
+	LastAcceptedKey       = []byte("last accepted")
+	HeightsIndexedKey     = []byte("heights indexed")
+	InitializedKey        = []byte("initialized")
+	BlocksReindexedKey    = []byte("blocks reindexed.3")
+	RewardTxIDsIndexedKey = []byte("reward txIDs indexed")
 
 	emptyL1ValidatorCache = &cache.Empty[ids.ID, maybe.Maybe[L1Validator]]{}
 )
@@ -146,6 +149,9 @@ type Chain interface {
 	GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error)
 	AddSubnetTransformation(transformSubnetTx *txs.Tx)
 
+	GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error)
+	SetSubnetRewardCurve(setSubnetRewardCurveTx *txs.Tx)
+
 	AddChain(createChainTx *txs.Tx)
 
 	GetTx(txID ids.ID) (*txs.Tx, status.Status, error)
@@ -168,6 +174,11 @@ type State interface {
 	GetBlockIDAtHeight(height uint64) (ids.ID, error)
 
 	GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error)
+	// GetRewardTxIDs returns the IDs of the rewarded staking transactions of
+	// [nodeID], including the delegations to [nodeID]. Transactions rewarded
+	// before this index was introduced are only returned once
+	// [ReindexRewardTxIDs] has finished.
+	GetRewardTxIDs(nodeID ids.NodeID) ([]ids.ID, error)
 	GetSubnetIDs() ([]ids.ID, error)
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 
@@ -230,6 +241,12 @@ type State interface {
 	// TODO: Remove after v1.14.x is activated
 	ReindexBlocks(lock sync.Locker, log logging.Logger) error
 
+	// ReindexRewardTxIDs indexes the staking transactions that were rewarded
+	// before the reward txIDs were indexed. If this database has already
+	// indexed them, this function will return immediately, without iterating
+	// over the database.
+	ReindexRewardTxIDs(lock sync.Locker, log logging.Logger) error
+
 	// Commit changes to the base database.
 	Commit() error
 
@@ -399,6 +416,7 @@ type state struct {
 	addedRewardUTXOs map[ids.ID][]*avax.UTXO            // map of txID -> []*UTXO
 	rewardUTXOsCache cache.Cacher[ids.ID, []*avax.UTXO] // txID -> []*UTXO
 	rewardUTXODB     database.Database
+	rewardTxIDsDB    database.Database // nodeID + txID -> nil
 
 	modifiedUTXOs map[ids.ID]*avax.UTXO // map of modified UTXOID -> *UTXO; if the UTXO is nil, it has been removed
 	utxoDB        database.Database
@@ -421,6 +439,10 @@ type state struct {
 	transformedSubnetCache cache.Cacher[ids.ID, *txs.Tx] // cache of subnetID -> transformSubnetTx; if the entry is nil, it is not in the database
 	transformedSubnetDB    database.Database
 
+	subnetRewardCurves     map[ids.ID]*txs.Tx            // map of subnetID -> setSubnetRewardCurveTx
+	subnetRewardCurveCache cache.Cacher[ids.ID, *txs.Tx] // cache of subnetID -> setSubnetRewardCurveTx; if the entry is nil, it is not in the database
+	subnetRewardCurveDB    database.Database
+
 	modifiedSupplies map[ids.ID]uint64             // map of subnetID -> current supply
 	supplyCache      cache.Cacher[ids.ID, *uint64] // cache of subnetID -> current supply; if the entry is nil, it is not in the database
 	supplyDB         database.Database
@@ -680,6 +702,15 @@ func New(
 		return nil, err
 	}
 
+	subnetRewardCurveCache, err := metercacher.New(
+		"subnet_reward_curve_cache",
+		metricsReg,
+		lru.NewSizedCache(execCfg.TransformedSubnetTxCacheSize, txSize),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	supplyCache, err := metercacher.New[ids.ID, *uint64](
 		"supply_cache",
 		metricsReg,
@@ -772,6 +803,7 @@ func New(
 		addedRewardUTXOs: make(map[ids.ID][]*avax.UTXO),
 		rewardUTXODB:     rewardUTXODB,
 		rewardUTXOsCache: rewardUTXOsCache,
+		rewardTxIDsDB:    prefixdb.New(RewardTxIDsPrefix, baseDB),
 
 		modifiedUTXOs: make(map[ids.ID]*avax.UTXO),
 		utxoDB:        utxoDB,
@@ -792,6 +824,10 @@ func New(
 		transformedSubnetCache: transformedSubnetCache,
 		transformedSubnetDB:    prefixdb.New(TransformedSubnetPrefix, baseDB),
 
+		subnetRewardCurves:     make(map[ids.ID]*txs.Tx),
+		subnetRewardCurveCache: subnetRewardCurveCache,
+		subnetRewardCurveDB:    prefixdb.New(SubnetRewardCurvePrefix, baseDB),
+
 		modifiedSupplies: make(map[ids.ID]uint64),
 		supplyCache:      supplyCache,
 		supplyDB:         prefixdb.New(SupplyPrefix, baseDB),
@@ -1149,6 +1185,40 @@ func (s *state) AddSubnetTransformation(transformSubnetTxIntf *txs.Tx) {
 	s.transformedSubnets[transformSubnetTx.Subnet] = transformSubnetTxIntf
 }
 
+func (s *state) GetSubnetRewardCurve(subnetID ids.ID) (*txs.Tx, error) {
+	if tx, exists := s.subnetRewardCurves[subnetID]; exists {
+		return tx, nil
+	}
+
+	if tx, cached := s.subnetRewardCurveCache.Get(subnetID); cached {
+		if tx == nil {
+			return nil, database.ErrNotFound
+		}
+		return tx, nil
+	}
+
+	setSubnetRewardCurveTxID, err := database.GetID(s.subnetRewardCurveDB, subnetID[:])
+	if err == database.ErrNotFound {
+		s.subnetRewardCurveCache.Put(subnetID, nil)
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	setSubnetRewardCurveTx, _, err := s.GetTx(setSubnetRewardCurveTxID)
+	if err != nil {
+		return nil, err
+	}
+	s.subnetRewardCurveCache.Put(subnetID, setSubnetRewardCurveTx)
+	return setSubnetRewardCurveTx, nil
+}
+
+func (s *state) SetSubnetRewardCurve(setSubnetRewardCurveTxIntf *txs.Tx) {
+	setSubnetRewardCurveTx := setSubnetRewardCurveTxIntf.Unsigned.(*txs.SetSubnetRewardCurveTx)
+	s.subnetRewardCurves[setSubnetRewardCurveTx.Subnet] = setSubnetRewardCurveTxIntf
+}
+
 func (s *state) GetChains(subnetID ids.ID) ([]*txs.Tx, error) {
 	if chains, cached := s.chainCache.Get(subnetID); cached {
 		return chains, nil
@@ -1271,6 +1341,21 @@ func (s *state) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
 	return utxos, nil
 }
 
+func (s *state) GetRewardTxIDs(nodeID ids.NodeID) ([]ids.ID, error) {
+	it := s.rewardTxIDsDB.NewIteratorWithPrefix(nodeID[:])
+	defer it.Release()
+
+	var txIDs []ids.ID
+	for it.Next() {
+		txID, err := ids.ToID(it.Key()[ids.NodeIDLen:])
+		if err != nil {
+			return nil, err
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, it.Error()
+}
+
 func (s *state) AddRewardUTXO(txID ids.ID, utxo *avax.UTXO) {
 	s.addedRewardUTXOs[txID] = append(s.addedRewardUTXOs[txID], utxo)
 }
@@ -2100,6 +2185,7 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		s.writeSubnetOwners(),
 		s.writeSubnetToL1Conversions(),
 		s.writeTransformedSubnets(),
+		s.writeSubnetRewardCurves(),
 		s.writeSubnetSupplies(),
 		s.writeChains(),
 		s.writeMetadata(),
@@ -2127,10 +2213,12 @@ func (s *state) Close() error {
 		s.validatorsDB.Close(),
 		s.txDB.Close(),
 		s.rewardUTXODB.Close(),
+		s.rewardTxIDsDB.Close(),
 		s.utxoDB.Close(),
 		s.subnetBaseDB.Close(),
 		s.subnetToL1ConversionDB.Close(),
 		s.transformedSubnetDB.Close(),
+		s.subnetRewardCurveDB.Close(),
 		s.supplyDB.Close(),
 		s.chainDB.Close(),
 		s.singletonDB.Close(),
@@ -2904,6 +2992,35 @@ func (s *state) writeRewardUTXOs() error {
 				return fmt.Errorf("failed to add reward UTXO: %w", err)
 			}
 		}
+
+		if err := s.writeRewardTxID(txID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRewardTxID indexes the rewarded staking transaction by its nodeID.
+func (s *state) writeRewardTxID(txID ids.ID) error {
+	tx, _, err := s.GetTx(txID)
+	if err != nil {
+		return fmt.Errorf("failed to get rewarded tx %s: %w", txID, err)
+	}
+	return s.putRewardTxID(txID, tx)
+}
+
+func (s *state) putRewardTxID(txID ids.ID, tx *txs.Tx) error {
+	staker, ok := tx.Unsigned.(txs.Staker)
+	if !ok {
+		return nil
+	}
+
+	nodeID := staker.NodeID()
+	key := make([]byte, 0, ids.NodeIDLen+ids.IDLen)
+	key = append(key, nodeID[:]...)
+	key = append(key, txID[:]...)
+	if err := s.rewardTxIDsDB.Put(key, nil); err != nil {
+		return fmt.Errorf("failed to index rewarded tx: %w", err)
 	}
 	return nil
 }
@@ -2990,6 +3107,22 @@ func (s *state) writeTransformedSubnets() error {
 	return nil
 }
 
+func (s *state) writeSubnetRewardCurves() error {
+	for subnetID, tx := range s.subnetRewardCurves {
+		txID := tx.ID()
+
+		delete(s.subnetRewardCurves, subnetID)
+		// Note: Evict is used rather than Put here because tx may end up
+		// referencing additional data (because of shared byte slices) that
+		// would not be properly accounted for in the cache sizing.
+		s.subnetRewardCurveCache.Evict(subnetID)
+		if err := database.PutID(s.subnetRewardCurveDB, subnetID[:], txID); err != nil {
+			return fmt.Errorf("failed to write subnet reward curve: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeSubnetSupplies() error {
 	for subnetID, supply := range s.modifiedSupplies {
 		delete(s.modifiedSupplies, subnetID)
@@ -3221,6 +3354,149 @@ func (s *state) ReindexBlocks(lock sync.Locker, log logging.Logger) error {
 	return s.Commit()
 }
 
+func (s *state) ReindexRewardTxIDs(lock sync.Locker, log logging.Logger) error {
+	has, err := s.singletonDB.Has(RewardTxIDsIndexedKey)
+	if err != nil {
+		return err
+	}
+	if has {
+		log.Info("reward txIDs already indexed")
+		return nil
+	}
+
+	// It is possible that new txs are rewarded after grabbing this iterator.
+	// Newly rewarded txs are guaranteed to be indexed, so we don't need to
+	// check them.
+	txIterator := s.txDB.NewIterator()
+	// Releasing is done using a closure to ensure that updating txIterator will
+	// result in having the most recent iterator released when executing the
+	// deferred function.
+	defer func() {
+		txIterator.Release()
+	}()
+
+	log.Info("starting reward txID indexing")
+
+	var (
+		startTime         = time.Now()
+		lastCommit        = startTime
+		nextUpdate        = startTime.Add(indexLogFrequency)
+		numIndicesChecked = 0
+		numIndicesUpdated = 0
+	)
+
+	for txIterator.Next() {
+		txID, err := ids.ToID(txIterator.Key())
+		if err != nil {
+			return fmt.Errorf("failed to parse txID: %w", err)
+		}
+
+		// Only stakers that were paid a reward have reward UTXOs.
+		rewardUTXODB := linkeddb.NewDefault(prefixdb.New(txID[:], s.rewardUTXODB))
+		isEmpty, err := rewardUTXODB.IsEmpty()
+		if err != nil {
+			return fmt.Errorf("failed to get reward UTXOs of tx %s: %w", txID, err)
+		}
+		if !isEmpty {
+			stx := txBytesAndStatus{}
+			if _, err := txs.GenesisCodec.Unmarshal(txIterator.Value(), &stx); err != nil {
+				return fmt.Errorf("failed to parse tx %s: %w", txID, err)
+			}
+			tx, err := txs.Parse(txs.GenesisCodec, stx.Tx)
+			if err != nil {
+				return fmt.Errorf("failed to parse tx %s: %w", txID, err)
+			}
+			if err := s.putRewardTxID(txID, tx); err != nil {
+				return err
+			}
+
+			numIndicesUpdated++
+		}
+
+		numIndicesChecked++
+
+		now := time.Now()
+		if now.After(nextUpdate) {
+			nextUpdate = now.Add(indexLogFrequency)
+
+			progress := timer.ProgressFromHash(txID[:])
+			eta := timer.EstimateETA(
+				startTime,
+				progress,
+				math.MaxUint64,
+			)
+
+			log.Info("indexing reward txIDs",
+				zap.Int("numIndicesUpdated", numIndicesUpdated),
+				zap.Int("numIndicesChecked", numIndicesChecked),
+				zap.Duration("eta", eta),
+			)
+		}
+
+		if numIndicesChecked%indexIterationLimit == 0 {
+			// We must hold the lock during committing to make sure we don't
+			// attempt to commit to disk while a block is concurrently being
+			// accepted.
+			lock.Lock()
+			err := errors.Join(
+				s.Commit(),
+				txIterator.Error(),
+			)
+			lock.Unlock()
+			if err != nil {
+				return err
+			}
+
+			// We release the iterator here to allow the underlying database to
+			// clean up deleted state.
+			txIterator.Release()
+
+			// We take the minimum here because it's possible that the node is
+			// currently bootstrapping. This would mean that grabbing the lock
+			// could take an extremely long period of time; which we should not
+			// delay processing for.
+			indexDuration := now.Sub(lastCommit)
+			sleepDuration := min(
+				indexIterationSleepMultiplier*indexDuration,
+				indexIterationSleepCap,
+			)
+			time.Sleep(sleepDuration)
+
+			// Make sure not to include the sleep duration into the next index
+			// duration.
+			lastCommit = time.Now()
+
+			txIterator = s.txDB.NewIteratorWithStart(txID[:])
+		}
+	}
+
+	// Ensure we fully iterated over all txs before writing that indexing has
+	// finished.
+	//
+	// Note: This is needed because a transient read error could cause the
+	// iterator to stop early.
+	if err := txIterator.Error(); err != nil {
+		return fmt.Errorf("failed to iterate over historical txs: %w", err)
+	}
+
+	if err := s.singletonDB.Put(RewardTxIDsIndexedKey, nil); err != nil {
+		return fmt.Errorf("failed to mark reward txIDs as indexed: %w", err)
+	}
+
+	// We must hold the lock during committing to make sure we don't attempt to
+	// commit to disk while a block is concurrently being accepted.
+	lock.Lock()
+	defer lock.Unlock()
+
+	log.Info("finished reward txID indexing",
+		zap.Int("numIndicesUpdated", numIndicesUpdated),
+		zap.Int("numIndicesChecked", numIndicesChecked),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+
+	return s.Commit()
+}
+
 func (s *state) GetUptime(vdrID ids.NodeID) (time.Duration, time.Time, error) {
 	return s.validatorState.GetUptime(vdrID, constants.PrimaryNetworkID)
 }
@@ -1390,6 +1390,141 @@ func TestStateAccruedFeesCommitAndLoad(t *testing.T) {
 	require.Equal(expectedAccruedFees, s.GetAccruedFees())
 }
 
+// Verify that committing reward UTXOs indexes the rewarded staking transactions
+// by their nodeID.
+func TestStateRewardTxIDsCommitAndLoad(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	s := newTestState(t, db)
+
+	var (
+		nodeID = ids.GenerateTestNodeID()
+		owner  = &secp256k1fx.OutputOwners{}
+		utxo   = &avax.UTXO{
+			Asset: avax.Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+		unsignedTxs = []txs.UnsignedTx{
+			&txs.AddPermissionlessValidatorTx{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+				},
+				Signer:                &signer.Empty{},
+				ValidatorRewardsOwner: owner,
+				DelegatorRewardsOwner: owner,
+			},
+			&txs.AddPermissionlessDelegatorTx{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+				},
+				DelegationRewardsOwner: owner,
+			},
+			&txs.AddPermissionlessDelegatorTx{
+				Validator: txs.Validator{
+					NodeID: ids.GenerateTestNodeID(),
+				},
+				DelegationRewardsOwner: owner,
+			},
+		}
+		expectedTxIDs []ids.ID
+	)
+	for i, unsignedTx := range unsignedTxs {
+		tx := &txs.Tx{Unsigned: unsignedTx}
+		require.NoError(tx.Initialize(txs.Codec))
+		s.AddTx(tx, status.Committed)
+		s.AddRewardUTXO(tx.ID(), utxo)
+		if i < 2 {
+			expectedTxIDs = append(expectedTxIDs, tx.ID())
+		}
+	}
+	require.NoError(s.Commit())
+
+	s = newTestState(t, db)
+	txIDs, err := s.GetRewardTxIDs(nodeID)
+	require.NoError(err)
+	require.ElementsMatch(expectedTxIDs, txIDs)
+
+	txIDs, err = s.GetRewardTxIDs(ids.GenerateTestNodeID())
+	require.NoError(err)
+	require.Empty(txIDs)
+}
+
+// Verify that the staking transactions rewarded before the reward txIDs were
+// indexed are indexed once.
+func TestReindexRewardTxIDs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	s := newTestState(t, db)
+
+	var (
+		nodeID = ids.GenerateTestNodeID()
+		owner  = &secp256k1fx.OutputOwners{}
+		utxo   = &avax.UTXO{
+			Asset: avax.Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+		rewardedTx = &txs.Tx{
+			Unsigned: &txs.AddPermissionlessDelegatorTx{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+				},
+				DelegationRewardsOwner: owner,
+			},
+		}
+		unrewardedTx = &txs.Tx{
+			Unsigned: &txs.AddPermissionlessDelegatorTx{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+					Wght:   1,
+				},
+				DelegationRewardsOwner: owner,
+			},
+		}
+	)
+	require.NoError(rewardedTx.Initialize(txs.Codec))
+	require.NoError(unrewardedTx.Initialize(txs.Codec))
+	s.AddTx(rewardedTx, status.Committed)
+	s.AddTx(unrewardedTx, status.Committed)
+	s.AddRewardUTXO(rewardedTx.ID(), utxo)
+	require.NoError(s.Commit())
+
+	// Remove the index to mimic txs rewarded before the index was introduced.
+	clearRewardTxIDs := func() {
+		it := s.rewardTxIDsDB.NewIterator()
+		defer it.Release()
+		for it.Next() {
+			require.NoError(s.rewardTxIDsDB.Delete(it.Key()))
+		}
+		require.NoError(it.Error())
+	}
+	clearRewardTxIDs()
+
+	txIDs, err := s.GetRewardTxIDs(nodeID)
+	require.NoError(err)
+	require.Empty(txIDs)
+
+	require.NoError(s.ReindexRewardTxIDs(&sync.Mutex{}, logging.NoLog{}))
+
+	s = newTestState(t, db)
+	txIDs, err = s.GetRewardTxIDs(nodeID)
+	require.NoError(err)
+	require.Equal([]ids.ID{rewardedTx.ID()}, txIDs)
+
+	// The txs are only indexed once.
+	clearRewardTxIDs()
+	require.NoError(s.ReindexRewardTxIDs(&sync.Mutex{}, logging.NoLog{}))
+
+	txIDs, err = s.GetRewardTxIDs(nodeID)
+	require.NoError(err)
+	require.Empty(txIDs)
+}
+
 func TestMarkAndIsInitialized(t *testing.T) {
 	require := require.New(t)
 
@@ -35,7 +35,7 @@ type Config struct {
 	Registerer prometheus.Registerer
 	Validators validators.Manager
 	Upgrades   upgrade.Config
-	Config     config.Config
+	Config     *config.Config
 	Context    *snow.Context
 	Metrics    metrics.Metrics
 	Rewards    reward.Calculator
@@ -66,8 +66,9 @@ func New(t testing.TB, c Config) state.State {
 	if c.Upgrades == (upgrade.Config{}) {
 		c.Upgrades = upgradetest.GetConfig(upgradetest.Latest)
 	}
-	if c.Config == (config.Config{}) {
-		c.Config = config.Default
+	if c.Config == nil {
+		defaultConfig := config.Default
+		c.Config = &defaultConfig
 	}
 	if c.Metrics == nil {
 		c.Metrics = metrics.Noop
@@ -87,7 +88,7 @@ func New(t testing.TB, c Config) state.State {
 		c.Registerer,
 		c.Validators,
 		c.Upgrades,
-		&c.Config,
+		c.Config,
 		c.Context,
 		c.Metrics,
 		c.Rewards,
@@ -10,6 +10,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/codec"
 	"github.com/MetalBlockchain/metalgo/codec/linearcodec"
 	"github.com/MetalBlockchain/metalgo/utils/wrappers"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/stakeable"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
@@ -48,6 +49,7 @@ func init() {
 		errs.Add(
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterGraniteTypes(c),
 		)
 	}
 
@@ -129,3 +131,18 @@ func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 		targetCodec.RegisterType(&DisableL1ValidatorTx{}),
 	)
 }
+
+// RegisterGraniteTypes registers the type information for transactions that
+// were valid during the Granite series of upgrades.
+//
+// The reward curves are registered in the order of [reward.Curves], so that
+// the type ID of a curve is determined by its version.
+func RegisterGraniteTypes(targetCodec linearcodec.Codec) error {
+	errs := []error{
+		targetCodec.RegisterType(&SetSubnetRewardCurveTx{}),
+	}
+	for _, curve := range reward.Curves() {
+		errs = append(errs, targetCodec.RegisterType(curve))
+	}
+	return errors.Join(errs...)
+}
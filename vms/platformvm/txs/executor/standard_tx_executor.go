@@ -21,6 +21,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
 	"github.com/MetalBlockchain/metalgo/vms/components/gas"
 	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/state"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
@@ -48,6 +49,7 @@ var (
 	errMaxStakeDurationTooLarge         = errors.New("max stake duration must be less than or equal to the global max stake duration")
 	errMissingStartTimePreDurango       = errors.New("staker transactions must have a StartTime pre-Durango")
 	errEtnaUpgradeNotActive             = errors.New("attempting to use an Etna-upgrade feature prior to activation")
+	errGraniteUpgradeNotActive          = errors.New("attempting to use a Granite-upgrade feature prior to activation")
 	errTransformSubnetTxPostEtna        = errors.New("TransformSubnetTx is not permitted post-Etna")
 	errMaxNumActiveValidators           = errors.New("already at the max number of active validators")
 	errCouldNotLoadSubnetToL1Conversion = errors.New("could not load subnet conversion")
@@ -60,6 +62,7 @@ var (
 	errWarpMessageContainsStaleNonce    = errors.New("warp message contains stale nonce")
 	errRemovingLastValidator            = errors.New("attempting to remove the last L1 validator from a converted subnet")
 	errStateCorruption                  = errors.New("state corruption")
+	errMintingPeriodTooShort            = errors.New("minting period must be at least the max stake duration of the subnet")
 )
 
 // StandardTx executes the standard transaction [tx].
@@ -1273,6 +1276,71 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 	return e.state.PutL1Validator(l1Validator)
 }
 
+func (e *standardTxExecutor) SetSubnetRewardCurveTx(tx *txs.SetSubnetRewardCurveTx) error {
+	var (
+		currentTimestamp = e.state.GetTimestamp()
+		upgrades         = e.backend.Config.UpgradeConfig
+	)
+	if !upgrades.IsGraniteActivated(currentTimestamp) {
+		return errGraniteUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	// Only permissionless subnets mint staking rewards.
+	transformSubnet, err := GetTransformSubnetTx(e.state, tx.Subnet)
+	if err != nil {
+		return err
+	}
+
+	// The consumption rate curve is only well defined for stake durations up
+	// to its minting period.
+	if curve, ok := tx.Curve.(*reward.ConsumptionRateCurve); ok {
+		if curve.MintingPeriod < uint64(transformSubnet.MaxStakeDuration) {
+			return fmt.Errorf("%w: %d < %d", errMintingPeriodTooShort, curve.MintingPeriod, transformSubnet.MaxStakeDuration)
+		}
+	}
+
+	baseTxCreds, err := verifySubnetAuthorization(e.backend.Fx, e.state, e.tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+	if err := e.backend.FlowChecker.VerifySpend(
+		tx,
+		e.state,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		return err
+	}
+
+	txID := e.tx.ID()
+
+	// Consume the UTXOS
+	avax.Consume(e.state, tx.Ins)
+	// Produce the UTXOS
+	avax.Produce(e.state, txID, tx.Outs)
+	// Stakers added from now on are rewarded with the new curve
+	e.state.SetSubnetRewardCurve(e.tx)
+	return nil
+}
+
 // Creates the staker as defined in [stakerTx] and adds it to [e.State].
 func (e *standardTxExecutor) putStaker(stakerTx txs.Staker) error {
 	var (
@@ -4289,6 +4289,278 @@ func TestStandardExecutorDisableL1ValidatorTx(t *testing.T) {
 	}
 }
 
+func TestStandardExecutorSetSubnetRewardCurveTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+
+	var (
+		ctx           = snowtest.Context(t, constants.PlatformChainID)
+		defaultConfig = &config.Internal{
+			DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+			ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+			UpgradeConfig:      upgradetest.GetConfig(upgradetest.Latest),
+		}
+		baseState = statetest.New(t, statetest.Config{
+			Upgrades: defaultConfig.UpgradeConfig,
+		})
+		wallet = txstest.NewWallet(
+			t,
+			ctx,
+			defaultConfig,
+			baseState,
+			secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+			nil, // subnetIDs
+			nil, // validationIDs
+			nil, // chainIDs
+		)
+		flowChecker = utxo.NewVerifier(
+			ctx,
+			&vm.Clk,
+			fx,
+		)
+		backend = &Backend{
+			Config:       defaultConfig,
+			Bootstrapped: utils.NewAtomic(true),
+			Fx:           fx,
+			FlowChecker:  flowChecker,
+			Ctx:          ctx,
+		}
+	)
+
+	// Create the subnets
+	createSubnet := func() ids.ID {
+		createSubnetTx, err := wallet.IssueCreateSubnetTx(
+			&secp256k1fx.OutputOwners{},
+		)
+		require.NoError(t, err)
+
+		diff, err := state.NewDiffOn(baseState)
+		require.NoError(t, err)
+
+		_, _, _, err = StandardTx(
+			backend,
+			state.PickFeeCalculator(defaultConfig, baseState),
+			createSubnetTx,
+			diff,
+		)
+		require.NoError(t, err)
+		require.NoError(t, diff.Apply(baseState))
+		require.NoError(t, baseState.Commit())
+		return createSubnetTx.ID()
+	}
+	var (
+		subnetID             = createSubnet()
+		permissionedSubnetID = createSubnet()
+	)
+
+	// Make [subnetID] permissionless
+	const (
+		maximumSupply    = 1_000 * units.MegaAvax
+		maxStakeDuration = 365 * 24 * 60 * 60
+	)
+	transformSubnetTx, err := txs.NewSigned(
+		&txs.TransformSubnetTx{
+			Subnet:           subnetID,
+			MaximumSupply:    maximumSupply,
+			MaxStakeDuration: maxStakeDuration,
+			SubnetAuth:       &secp256k1fx.Input{},
+		},
+		txs.Codec,
+		nil,
+	)
+	require.NoError(t, err)
+	baseState.AddTx(transformSubnetTx, status.Committed)
+	baseState.AddSubnetTransformation(transformSubnetTx)
+	require.NoError(t, baseState.Commit())
+
+	fixedAPRCurve := &reward.FixedAPRCurve{
+		Rate: 100_000, // 10%
+	}
+	tests := []struct {
+		name           string
+		subnetID       ids.ID
+		curve          reward.Curve
+		builderOptions []common.Option
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name: "invalid prior to Granite",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errGraniteUpgradeNotActive,
+		},
+		{
+			name: "tx fails syntactic verification",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Ctx = snowtest.Context(t, ids.GenerateTestID())
+				return nil
+			},
+			expectedErr: avax.ErrWrongChainID,
+		},
+		{
+			name: "invalid memo length",
+			builderOptions: []common.Option{
+				common.WithMemo([]byte("memo!")),
+			},
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+		{
+			name:        "invalid if subnet is permissioned",
+			subnetID:    permissionedSubnetID,
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name: "minting period shorter than max stake duration",
+			curve: &reward.ConsumptionRateCurve{
+				MaxConsumptionRate: 120_000,
+				MinConsumptionRate: 100_000,
+				MintingPeriod:      maxStakeDuration - 1,
+			},
+			expectedErr: errMintingPeriodTooShort,
+		},
+		{
+			name: "fail subnet authorization",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.SetSubnetOwner(subnetID, &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						ids.GenerateTestShortID(),
+					},
+				})
+				return nil
+			},
+			expectedErr: errUnauthorizedModification,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					e.backend.Config.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid consumption rate curve",
+			curve: &reward.ConsumptionRateCurve{
+				MaxConsumptionRate: 120_000,
+				MinConsumptionRate: 100_000,
+				MintingPeriod:      maxStakeDuration,
+			},
+		},
+		{
+			name: "valid stepwise curve",
+			curve: &reward.StepwiseCurve{
+				Steps: []reward.Step{
+					{MinDuration: 0, Rate: 50_000},
+					{MinDuration: maxStakeDuration / 2, Rate: 80_000},
+				},
+			},
+		},
+		{
+			name: "valid fixed APR curve",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			subnetID := subnetID
+			if test.subnetID != ids.Empty {
+				subnetID = test.subnetID
+			}
+			curve := test.curve
+			if curve == nil {
+				curve = fixedAPRCurve
+			}
+
+			wallet := txstest.NewWallet(
+				t,
+				ctx,
+				defaultConfig,
+				baseState,
+				secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+				[]ids.ID{subnetID},
+				nil, // validationIDs
+				nil, // chainIDs
+			)
+			setSubnetRewardCurveTx, err := wallet.IssueSetSubnetRewardCurveTx(
+				subnetID,
+				curve,
+				test.builderOptions...,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+
+			executor := &standardTxExecutor{
+				backend: &Backend{
+					Config:       defaultConfig,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  flowChecker,
+					Ctx:          ctx,
+				},
+				feeCalculator: state.PickFeeCalculator(defaultConfig, baseState),
+				tx:            setSubnetRewardCurveTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = setSubnetRewardCurveTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range setSubnetRewardCurveTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			for _, expectedUTXO := range setSubnetRewardCurveTx.UTXOs() {
+				utxoID := expectedUTXO.InputID()
+				utxo, err := diff.GetUTXO(utxoID)
+				require.NoError(err)
+				require.Equal(expectedUTXO, utxo)
+			}
+
+			rewardCurveTx, err := diff.GetSubnetRewardCurve(subnetID)
+			require.NoError(err)
+			require.Equal(setSubnetRewardCurveTx, rewardCurveTx)
+
+			// Stakers added after the tx are rewarded with the new curve.
+			rewards, err := GetRewardsCalculator(executor.backend, diff, subnetID)
+			require.NoError(err)
+
+			const (
+				stakeDuration = maxStakeDuration * time.Second
+				stakeAmount   = units.MegaAvax
+				currentSupply = 500 * units.MegaAvax
+			)
+			require.Equal(
+				curve.Calculator(maximumSupply).Calculate(stakeDuration, stakeAmount, currentSupply),
+				rewards.Calculate(stakeDuration, stakeAmount, currentSupply),
+			)
+		})
+	}
+}
+
 func must[T any](t require.TestingT) func(T, error) T {
 	return func(val T, err error) T {
 		require.NoError(t, err)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/MetalBlockchain/metalgo/database"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/constants"
 	"github.com/MetalBlockchain/metalgo/utils/math"
@@ -22,6 +23,7 @@ var (
 	ErrChildBlockEarlierThanParent     = errors.New("proposed timestamp before current chain time")
 	ErrChildBlockAfterStakerChangeTime = errors.New("proposed timestamp later than next staker change time")
 	ErrChildBlockBeyondSyncBound       = errors.New("proposed timestamp is too far in the future relative to local time")
+	ErrIsNotSetSubnetRewardCurveTx     = errors.New("is not a set subnet reward curve tx")
 )
 
 // VerifyNewChainTime returns nil if the [newChainTime] is a valid chain time.
@@ -352,6 +354,10 @@ func advanceValidatorFeeState(
 	return changed, nil
 }
 
+// GetRewardsCalculator returns the calculator of the staking rewards on
+// [subnetID]. Permissionless subnets use the curve most recently set by a
+// [txs.SetSubnetRewardCurveTx], falling back to the parameters of their
+// [txs.TransformSubnetTx].
 func GetRewardsCalculator(
 	backend *Backend,
 	parentState state.Chain,
@@ -366,6 +372,18 @@ func GetRewardsCalculator(
 		return nil, err
 	}
 
+	setRewardCurveTx, err := parentState.GetSubnetRewardCurve(subnetID)
+	switch {
+	case err == nil:
+		setRewardCurve, ok := setRewardCurveTx.Unsigned.(*txs.SetSubnetRewardCurveTx)
+		if !ok {
+			return nil, ErrIsNotSetSubnetRewardCurveTx
+		}
+		return setRewardCurve.Curve.Calculator(transformSubnet.MaximumSupply), nil
+	case err != database.ErrNotFound:
+		return nil, err
+	}
+
 	return reward.NewCalculator(reward.Config{
 		MaxConsumptionRate: transformSubnet.MaxConsumptionRate,
 		MinConsumptionRate: transformSubnet.MinConsumptionRate,
@@ -114,6 +114,10 @@ func (*warpVerifier) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
 	return nil
 }
 
+func (*warpVerifier) SetSubnetRewardCurveTx(*txs.SetSubnetRewardCurveTx) error {
+	return nil
+}
+
 func (w *warpVerifier) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx) error {
 	return w.verify(tx.Message)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/components/gas"
 	"github.com/MetalBlockchain/metalgo/vms/components/verify"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/fx"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/stakeable"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
@@ -87,6 +88,17 @@ const (
 	intrinsicPoPBandwidth = bls.PublicKeyLen + // public key
 		bls.SignatureLen // signature
 
+	intrinsicConsumptionRateCurveBandwidth = wrappers.LongLen + // max consumption rate
+		wrappers.LongLen + // min consumption rate
+		wrappers.LongLen // minting period
+
+	intrinsicFixedAPRCurveBandwidth = wrappers.LongLen // rate
+
+	intrinsicStepwiseCurveBandwidth = wrappers.IntLen // num steps
+
+	intrinsicStepBandwidth = wrappers.LongLen + // min duration
+		wrappers.LongLen // rate
+
 	intrinsicInputDBRead = 1
 
 	intrinsicInputDBWrite                      = 1
@@ -222,12 +234,22 @@ var (
 		gas.DBRead:  1, // read staker
 		gas.DBWrite: 6, // write remaining balance utxo + weight diff + deactivated weight diff + public key diff + delete staker + write staker
 	}
+	IntrinsicSetSubnetRewardCurveTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.IDLen + // subnetID
+			wrappers.IntLen + // curve typeID
+			wrappers.IntLen + // subnetAuth typeID
+			wrappers.IntLen, // subnetAuthCredential typeID
+		gas.DBRead:  2, // read subnet transformation + read subnet auth
+		gas.DBWrite: 1, // set subnet reward curve
+	}
 
 	errUnsupportedOutput = errors.New("unsupported output type")
 	errUnsupportedInput  = errors.New("unsupported input type")
 	errUnsupportedOwner  = errors.New("unsupported owner type")
 	errUnsupportedAuth   = errors.New("unsupported auth type")
 	errUnsupportedSigner = errors.New("unsupported signer type")
+	errUnsupportedCurve  = errors.New("unsupported reward curve type")
 )
 
 func TxComplexity(txs ...txs.UnsignedTx) (gas.Dimensions, error) {
@@ -417,6 +439,34 @@ func OwnerComplexity(ownerIntf fx.Owner) (gas.Dimensions, error) {
 	}, nil
 }
 
+// RewardCurveComplexity returns the complexity a reward curve adds to a
+// transaction. It does not include the typeID of the curve.
+func RewardCurveComplexity(curveIntf reward.Curve) (gas.Dimensions, error) {
+	var bandwidth uint64
+	switch curve := curveIntf.(type) {
+	case *reward.ConsumptionRateCurve:
+		bandwidth = intrinsicConsumptionRateCurveBandwidth
+	case *reward.FixedAPRCurve:
+		bandwidth = intrinsicFixedAPRCurveBandwidth
+	case *reward.StepwiseCurve:
+		numSteps := uint64(len(curve.Steps))
+		stepsBandwidth, err := math.Mul(numSteps, intrinsicStepBandwidth)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+		bandwidth, err = math.Add(stepsBandwidth, intrinsicStepwiseCurveBandwidth)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	default:
+		return gas.Dimensions{}, errUnsupportedCurve
+	}
+
+	return gas.Dimensions{
+		gas.Bandwidth: bandwidth,
+	}, nil
+}
+
 // AuthComplexity returns the complexity an authorization adds to a transaction.
 // It does not include the typeID of the authorization.
 // It does includes the complexity that the corresponding credential will add.
@@ -795,6 +845,27 @@ func (c *complexityVisitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) e
 	return err
 }
 
+func (c *complexityVisitor) SetSubnetRewardCurveTx(tx *txs.SetSubnetRewardCurveTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	curveComplexity, err := RewardCurveComplexity(tx.Curve)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicSetSubnetRewardCurveTxComplexities.Add(
+		&baseTxComplexity,
+		&curveComplexity,
+		&authComplexity,
+	)
+	return err
+}
+
 func baseTxComplexity(tx *txs.BaseTx) (gas.Dimensions, error) {
 	outputsComplexity, err := OutputComplexity(tx.Outs...)
 	if err != nil {
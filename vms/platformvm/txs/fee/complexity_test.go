@@ -17,6 +17,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/components/gas"
 	"github.com/MetalBlockchain/metalgo/vms/components/verify"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/fx"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/stakeable"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
@@ -490,6 +491,67 @@ func TestOwnerComplexity(t *testing.T) {
 	}
 }
 
+func TestRewardCurveComplexity(t *testing.T) {
+	tests := []struct {
+		name        string
+		curve       reward.Curve
+		expected    gas.Dimensions
+		expectedErr error
+	}{
+		{
+			name:  "consumption rate",
+			curve: &reward.ConsumptionRateCurve{},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 24,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:  "fixed APR",
+			curve: &reward.FixedAPRCurve{},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 8,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "stepwise",
+			curve: &reward.StepwiseCurve{
+				Steps: make([]reward.Step, 3),
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 52,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "invalid curve type",
+			curve:       nil,
+			expected:    gas.Dimensions{},
+			expectedErr: errUnsupportedCurve,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := RewardCurveComplexity(test.curve)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+
+			if err != nil {
+				return
+			}
+
+			curveBytes, err := txs.Codec.Marshal(txs.CodecVersion, test.curve)
+			require.NoError(err)
+
+			numBytesWithoutCodecVersion := uint64(len(curveBytes) - codec.VersionSize)
+			require.Equal(numBytesWithoutCodecVersion, actual[gas.Bandwidth])
+		})
+	}
+}
+
 func TestAuthComplexity(t *testing.T) {
 	tests := []struct {
 		name        string
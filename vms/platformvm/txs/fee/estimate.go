@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/gas"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+var (
+	ErrUnknownTxType = errors.New("unknown tx type")
+
+	// Inputs, outputs, owners and authorizations used by the estimates. They
+	// are controlled by a single address.
+	estimatedInput = &avax.TransferableInput{
+		In: &secp256k1fx.TransferInput{
+			Input: secp256k1fx.Input{
+				SigIndices: []uint32{0},
+			},
+		},
+	}
+	estimatedOutput = &avax.TransferableOutput{
+		Out: &secp256k1fx.TransferOutput{
+			OutputOwners: estimatedOwner,
+		},
+	}
+	estimatedOwner = secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{}},
+	}
+	estimatedAuth = &secp256k1fx.Input{
+		SigIndices: []uint32{0},
+	}
+)
+
+// estimatedTx describes the fields of a transaction type that are included in
+// its estimated complexity, on top of its inputs and outputs.
+type estimatedTx struct {
+	intrinsic gas.Dimensions
+	numOwners int
+	hasAuth   bool
+	hasSigner bool
+}
+
+var estimatedTxs = map[string]estimatedTx{
+	"BaseTx": {
+		intrinsic: IntrinsicBaseTxComplexities,
+	},
+	"AddSubnetValidatorTx": {
+		intrinsic: IntrinsicAddSubnetValidatorTxComplexities,
+		hasAuth:   true,
+	},
+	"CreateChainTx": {
+		intrinsic: IntrinsicCreateChainTxComplexities,
+		hasAuth:   true,
+	},
+	"CreateSubnetTx": {
+		intrinsic: IntrinsicCreateSubnetTxComplexities,
+		numOwners: 1,
+	},
+	"ImportTx": {
+		intrinsic: IntrinsicImportTxComplexities,
+	},
+	"ExportTx": {
+		intrinsic: IntrinsicExportTxComplexities,
+	},
+	"RemoveSubnetValidatorTx": {
+		intrinsic: IntrinsicRemoveSubnetValidatorTxComplexities,
+		hasAuth:   true,
+	},
+	"AddPermissionlessValidatorTx": {
+		intrinsic: IntrinsicAddPermissionlessValidatorTxComplexities,
+		numOwners: 2,
+		hasSigner: true,
+	},
+	"AddPermissionlessDelegatorTx": {
+		intrinsic: IntrinsicAddPermissionlessDelegatorTxComplexities,
+		numOwners: 1,
+	},
+	"TransferSubnetOwnershipTx": {
+		intrinsic: IntrinsicTransferSubnetOwnershipTxComplexities,
+		numOwners: 1,
+		hasAuth:   true,
+	},
+	"ConvertSubnetToL1Tx": {
+		intrinsic: IntrinsicConvertSubnetToL1TxComplexities,
+		hasAuth:   true,
+	},
+	"RegisterL1ValidatorTx": {
+		intrinsic: IntrinsicRegisterL1ValidatorTxComplexities,
+	},
+	"SetL1ValidatorWeightTx": {
+		intrinsic: IntrinsicSetL1ValidatorWeightTxComplexities,
+	},
+	"IncreaseL1ValidatorBalanceTx": {
+		intrinsic: IntrinsicIncreaseL1ValidatorBalanceTxComplexities,
+	},
+	"DisableL1ValidatorTx": {
+		intrinsic: IntrinsicDisableL1ValidatorTxComplexities,
+		hasAuth:   true,
+	},
+	"SetSubnetRewardCurveTx": {
+		intrinsic: IntrinsicSetSubnetRewardCurveTxComplexities,
+		hasAuth:   true,
+	},
+}
+
+// EstimateTxComplexity returns the complexity of a transaction of the provided
+// type, with [numInputs] inputs and [numOutputs] outputs. Every input, output,
+// owner and authorization is assumed to be controlled by a single address.
+//
+// The estimate doesn't include variable length fields, such as memos, chain
+// names, genesis data, L1 validators, warp messages and reward curves.
+func EstimateTxComplexity(txType string, numInputs int, numOutputs int) (gas.Dimensions, error) {
+	tx, ok := estimatedTxs[txType]
+	if !ok {
+		return gas.Dimensions{}, fmt.Errorf("%w: %q", ErrUnknownTxType, txType)
+	}
+
+	ins := make([]*avax.TransferableInput, numInputs)
+	for i := range ins {
+		ins[i] = estimatedInput
+	}
+	inputComplexity, err := InputComplexity(ins...)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	outs := make([]*avax.TransferableOutput, numOutputs)
+	for i := range outs {
+		outs[i] = estimatedOutput
+	}
+	outputComplexity, err := OutputComplexity(outs...)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	complexity, err := tx.intrinsic.Add(&inputComplexity, &outputComplexity)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	for range tx.numOwners {
+		ownerComplexity, err := OwnerComplexity(&estimatedOwner)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+		complexity, err = complexity.Add(&ownerComplexity)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	if tx.hasAuth {
+		authComplexity, err := AuthComplexity(estimatedAuth)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+		complexity, err = complexity.Add(&authComplexity)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	if tx.hasSigner {
+		signerComplexity, err := SignerComplexity(&signer.ProofOfPossession{})
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+		complexity, err = complexity.Add(&signerComplexity)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	return complexity, nil
+}
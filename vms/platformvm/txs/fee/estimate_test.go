@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/gas"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+func TestEstimateTxComplexity(t *testing.T) {
+	var (
+		ins = []*avax.TransferableInput{
+			{
+				In: &secp256k1fx.TransferInput{
+					Amt: 1,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0},
+					},
+				},
+			},
+			{
+				In: &secp256k1fx.TransferInput{
+					Amt: 2,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{1},
+					},
+				},
+			},
+		}
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		outs = []*avax.TransferableOutput{
+			{
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          1,
+					OutputOwners: *owner,
+				},
+			},
+		}
+		baseTx = txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				Ins:  ins,
+				Outs: outs,
+			},
+		}
+	)
+
+	tests := []struct {
+		txType string
+		tx     txs.UnsignedTx
+	}{
+		{
+			txType: "BaseTx",
+			tx:     &baseTx,
+		},
+		{
+			txType: "TransferSubnetOwnershipTx",
+			tx: &txs.TransferSubnetOwnershipTx{
+				BaseTx:     baseTx,
+				SubnetAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				Owner:      owner,
+			},
+		},
+		{
+			txType: "IncreaseL1ValidatorBalanceTx",
+			tx: &txs.IncreaseL1ValidatorBalanceTx{
+				BaseTx: baseTx,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.txType, func(t *testing.T) {
+			require := require.New(t)
+
+			expected, err := TxComplexity(test.tx)
+			require.NoError(err)
+
+			actual, err := EstimateTxComplexity(test.txType, len(ins), len(outs))
+			require.NoError(err)
+			require.Equal(expected, actual)
+		})
+	}
+}
+
+func TestEstimateTxComplexityUnknownTxType(t *testing.T) {
+	_, err := EstimateTxComplexity("AdvanceTimeTx", 1, 1)
+	require.ErrorIs(t, err, ErrUnknownTxType)
+}
+
+func TestEstimateTxComplexityEmpty(t *testing.T) {
+	complexity, err := EstimateTxComplexity("BaseTx", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, IntrinsicBaseTxComplexities, complexity)
+	require.Zero(t, complexity[gas.Compute])
+}
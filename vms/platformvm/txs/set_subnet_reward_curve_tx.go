@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
+)
+
+var (
+	_ UnsignedTx = (*SetSubnetRewardCurveTx)(nil)
+
+	ErrSetPrimaryNetworkRewardCurve = errors.New("cannot set the reward curve of the primary network")
+	ErrNilRewardCurve               = errors.New("nil reward curve")
+)
+
+type SetSubnetRewardCurveTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the permissionless subnet this tx is modifying
+	Subnet ids.ID `serialize:"true" json:"subnetID"`
+	// Curve used to calculate the rewards of stakers added to the subnet after
+	// this tx is accepted
+	Curve reward.Curve `serialize:"true" json:"curve"`
+	// Proves that the issuer has the right to modify the subnet.
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+}
+
+func (tx *SetSubnetRewardCurveTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return ErrSetPrimaryNetworkRewardCurve
+	case tx.Curve == nil:
+		return ErrNilRewardCurve
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := verify.All(tx.Curve, tx.SubnetAuth); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetSubnetRewardCurveTx) Visit(visitor Visitor) error {
+	return visitor.SetSubnetRewardCurveTx(tx)
+}
@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/snow/snowtest"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+func TestSetSubnetRewardCurveTxSerialization(t *testing.T) {
+	for _, curve := range []reward.Curve{
+		&reward.ConsumptionRateCurve{
+			MaxConsumptionRate: 120_000,
+			MinConsumptionRate: 100_000,
+			MintingPeriod:      365 * 24 * 60 * 60,
+		},
+		&reward.FixedAPRCurve{
+			Rate: 80_000,
+		},
+		&reward.StepwiseCurve{
+			Steps: []reward.Step{
+				{MinDuration: 0, Rate: 50_000},
+				{MinDuration: 30 * 24 * 60 * 60, Rate: 80_000},
+			},
+		},
+	} {
+		require := require.New(t)
+
+		var unsignedTx UnsignedTx = &SetSubnetRewardCurveTx{
+			BaseTx: BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: constants.PlatformChainID,
+					Outs:         []*avax.TransferableOutput{},
+					Ins:          []*avax.TransferableInput{},
+					Memo:         []byte{},
+				},
+			},
+			Subnet: ids.GenerateTestID(),
+			Curve:  curve,
+			SubnetAuth: &secp256k1fx.Input{
+				SigIndices: []uint32{},
+			},
+		}
+		txBytes, err := Codec.Marshal(CodecVersion, &unsignedTx)
+		require.NoError(err)
+
+		var parsedTx UnsignedTx
+		_, err = Codec.Unmarshal(txBytes, &parsedTx)
+		require.NoError(err)
+		require.Equal(unsignedTx, parsedTx)
+	}
+}
+
+func TestSetSubnetRewardCurveTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validSubnetID                     = ids.GenerateTestID()
+		validCurve      reward.Curve      = &reward.FixedAPRCurve{Rate: 80_000}
+		validSubnetAuth verify.Verifiable = &secp256k1fx.Input{}
+	)
+	tests := []struct {
+		name        string
+		tx          *SetSubnetRewardCurveTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			// The tx includes invalid data to verify that a cached result is
+			// returned.
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "primary network",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx:     validBaseTx,
+				Subnet:     constants.PrimaryNetworkID,
+				Curve:      validCurve,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: ErrSetPrimaryNetworkRewardCurve,
+		},
+		{
+			name: "nil curve",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx:     validBaseTx,
+				Subnet:     validSubnetID,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: ErrNilRewardCurve,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx:     BaseTx{},
+				Subnet:     validSubnetID,
+				Curve:      validCurve,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid curve",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx:     validBaseTx,
+				Subnet:     validSubnetID,
+				Curve:      &reward.StepwiseCurve{},
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: reward.ErrNoSteps,
+		},
+		{
+			name: "invalid subnet auth",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx: validBaseTx,
+				Subnet: validSubnetID,
+				Curve:  validCurve,
+				SubnetAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "passes verification",
+			tx: &SetSubnetRewardCurveTx{
+				BaseTx:     validBaseTx,
+				Subnet:     validSubnetID,
+				Curve:      validCurve,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.True(test.tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -15,9 +15,11 @@ import (
 
 	"github.com/MetalBlockchain/metalgo/api/metrics"
 	"github.com/MetalBlockchain/metalgo/cache/lru"
+	"github.com/MetalBlockchain/metalgo/chains"
 	"github.com/MetalBlockchain/metalgo/codec"
 	"github.com/MetalBlockchain/metalgo/codec/linearcodec"
 	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/database/prefixdb"
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/snow"
 	"github.com/MetalBlockchain/metalgo/snow/consensus/snowman"
@@ -41,6 +43,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/utxo"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 	"github.com/MetalBlockchain/metalgo/vms/txs/mempool"
+	"github.com/MetalBlockchain/metalgo/vms/txs/scheduler"
 
 	snowmanblock "github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
 	blockbuilder "github.com/MetalBlockchain/metalgo/vms/platformvm/block/builder"
@@ -51,11 +54,20 @@ import (
 	pvalidators "github.com/MetalBlockchain/metalgo/vms/platformvm/validators"
 )
 
+// scheduledTxsFrequency is how often the scheduled txs are checked to see if
+// they can be issued.
+const scheduledTxsFrequency = time.Second
+
 var (
 	_ snowmanblock.ChainVM                      = (*VM)(nil)
 	_ snowmanblock.BuildBlockWithContextChainVM = (*VM)(nil)
 	_ secp256k1fx.VM                            = (*VM)(nil)
 	_ validators.State                          = (*VM)(nil)
+	_ chains.SubnetTracker                      = (*VM)(nil)
+
+	scheduledTxsPrefix = []byte("scheduledTxs")
+
+	errSybilProtectionDisabled = errors.New("all subnets are tracked when sybil protection is disabled")
 )
 
 type VM struct {
@@ -77,14 +89,25 @@ type VM struct {
 
 	state state.State
 
+	// rewards calculates the rewards of the primary network stakers
+	rewards reward.Calculator
+
 	fx            fx.Fx
 	codecRegistry codec.Registry
 
 	// Bootstrapped remembers if this chain has finished bootstrapping or not
 	bootstrapped utils.Atomic[bool]
 
+	// subnetLoggers log the changes of this node's validator status on each
+	// tracked subnet once this chain has finished bootstrapping.
+	subnetLoggers map[ids.ID]validators.SetCallbackListener
+
 	manager blockexecutor.Manager
 
+	// scheduledTxs are signed txs that are issued once the chain time reaches
+	// their not before time. It is nil unless scheduled txs are enabled.
+	scheduledTxs *scheduler.Queue[*txs.Tx]
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -132,7 +155,7 @@ func (vm *VM) Initialize(
 		return err
 	}
 
-	rewards := reward.NewCalculator(vm.RewardConfig)
+	vm.rewards = reward.NewCalculator(vm.RewardConfig)
 
 	vm.state, err = state.New(
 		vm.db,
@@ -143,12 +166,24 @@ func (vm *VM) Initialize(
 		execConfig,
 		vm.ctx,
 		vm.metrics,
-		rewards,
+		vm.rewards,
 	)
 	if err != nil {
 		return err
 	}
 
+	if execConfig.ScheduledTxsEnabled {
+		vm.scheduledTxs, err = scheduler.New(
+			prefixdb.New(scheduledTxsPrefix, vm.db),
+			func(b []byte) (*txs.Tx, error) {
+				return txs.Parse(txs.Codec, b)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to load scheduled txs: %w", err)
+		}
+	}
+
 	validatorManager := pvalidators.NewManager(vm.Internal, vm.state, vm.metrics, &vm.clock)
 	vm.State = validatorManager
 	utxoVerifier := utxo.NewVerifier(vm.ctx, &vm.clock, vm.fx)
@@ -162,7 +197,7 @@ func (vm *VM) Initialize(
 		Fx:           vm.fx,
 		FlowChecker:  utxoVerifier,
 		Uptimes:      vm.uptimeManager,
-		Rewards:      rewards,
+		Rewards:      vm.rewards,
 		Bootstrapped: &vm.bootstrapped,
 	}
 
@@ -234,6 +269,17 @@ func (vm *VM) Initialize(
 	// [periodicallyPruneMempool] grabs the context lock.
 	go vm.periodicallyPruneMempool(execConfig.MempoolPruneFrequency)
 
+	if execConfig.L1ValidatorTopUp.Enabled() {
+		topUp, err := newL1ValidatorTopUp(vm, execConfig.L1ValidatorTopUp)
+		if err != nil {
+			return err
+		}
+
+		// Incrementing [awaitShutdown] would cause a deadlock since the top
+		// ups grab the context lock.
+		go topUp.run(vm.onShutdownCtx)
+	}
+
 	go func() {
 		err := vm.state.ReindexBlocks(&vm.ctx.Lock, vm.ctx.Log)
 		if err != nil {
@@ -243,6 +289,15 @@ func (vm *VM) Initialize(
 		}
 	}()
 
+	go func() {
+		err := vm.state.ReindexRewardTxIDs(&vm.ctx.Lock, vm.ctx.Log)
+		if err != nil {
+			vm.ctx.Log.Warn("indexing reward txIDs failed",
+				zap.Error(err),
+			)
+		}
+	}()
+
 	return nil
 }
 
@@ -264,6 +319,20 @@ func (vm *VM) periodicallyPruneMempool(frequency time.Duration) {
 	}
 }
 
+// nextBlockTime returns the timestamp the next block built on the last accepted
+// block would have.
+func (vm *VM) nextBlockTime() (time.Time, error) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	timestamp, _, err := state.NextBlockTime(
+		vm.Internal.ValidatorFeeConfig,
+		vm.state,
+		&vm.clock,
+	)
+	return timestamp, err
+}
+
 func (vm *VM) pruneMempool() error {
 	vm.ctx.Lock.Lock()
 	defer vm.ctx.Lock.Unlock()
@@ -333,6 +402,58 @@ func (vm *VM) createSubnet(subnetID ids.ID) error {
 	return nil
 }
 
+// TrackSubnet starts creating the chains of [subnetID].
+//
+// Invariant: The context lock must be held.
+func (vm *VM) TrackSubnet(subnetID ids.ID) error {
+	if !vm.SybilProtectionEnabled {
+		return errSybilProtectionDisabled
+	}
+	if vm.TrackedSubnets.Contains(subnetID) {
+		return nil
+	}
+
+	vm.TrackedSubnets.Add(subnetID)
+	if err := vm.createSubnet(subnetID); err != nil {
+		vm.TrackedSubnets.Remove(subnetID)
+		return err
+	}
+	if vm.bootstrapped.Get() {
+		vm.registerSubnetLogger(subnetID)
+	}
+	return nil
+}
+
+// UntrackSubnet stops creating the chains of [subnetID]. Chains that were
+// already created must be stopped by the caller.
+//
+// Invariant: The context lock must be held.
+func (vm *VM) UntrackSubnet(subnetID ids.ID) error {
+	if !vm.SybilProtectionEnabled {
+		return errSybilProtectionDisabled
+	}
+	if !vm.TrackedSubnets.Contains(subnetID) {
+		return nil
+	}
+
+	vm.TrackedSubnets.Remove(subnetID)
+	if vl, ok := vm.subnetLoggers[subnetID]; ok {
+		vm.Validators.UnregisterSetCallbackListener(subnetID, vl)
+		delete(vm.subnetLoggers, subnetID)
+	}
+	return nil
+}
+
+func (vm *VM) registerSubnetLogger(subnetID ids.ID) {
+	if vm.subnetLoggers == nil {
+		vm.subnetLoggers = make(map[ids.ID]validators.SetCallbackListener)
+	}
+
+	vl := validators.NewLogger(vm.ctx.Log, subnetID, vm.ctx.NodeID)
+	vm.Validators.RegisterSetCallbackListener(subnetID, vl)
+	vm.subnetLoggers[subnetID] = vl
+}
+
 // onBootstrapStarted marks this VM as bootstrapping
 func (vm *VM) onBootstrapStarted() error {
 	vm.bootstrapped.Set(false)
@@ -361,8 +482,23 @@ func (vm *VM) onNormalOperationsStarted() error {
 	vm.Validators.RegisterSetCallbackListener(constants.PrimaryNetworkID, vl)
 
 	for subnetID := range vm.TrackedSubnets {
-		vl := validators.NewLogger(vm.ctx.Log, subnetID, vm.ctx.NodeID)
-		vm.Validators.RegisterSetCallbackListener(subnetID, vl)
+		vm.registerSubnetLogger(subnetID)
+	}
+
+	// Scheduled txs are only issued once the chain is bootstrapped, as they
+	// would otherwise be verified against a stale chain time.
+	//
+	// Incrementing [awaitShutdown] would cause a deadlock since the scheduled
+	// txs grab the context lock.
+	if vm.scheduledTxs != nil {
+		go vm.scheduledTxs.Run(
+			vm.onShutdownCtx,
+			vm.ctx.Log,
+			scheduledTxsFrequency,
+			vm.nextBlockTime,
+			vm.issueTxFromRPC,
+			isRetryableScheduledTxErr,
+		)
 	}
 
 	return vm.state.Commit()
@@ -490,6 +626,14 @@ func (vm *VM) GetBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, erro
 	return vm.state.GetBlockIDAtHeight(height)
 }
 
+// isRetryableScheduledTxErr returns true if a scheduled tx that failed to be
+// issued with [err] may be issued successfully later.
+func isRetryableScheduledTxErr(err error) bool {
+	return errors.Is(err, blockexecutor.ErrChainNotSynced) ||
+		errors.Is(err, mempool.ErrMempoolFull) ||
+		errors.Is(err, mempool.ErrConflictsWithOtherTx)
+}
+
 func (vm *VM) issueTxFromRPC(tx *txs.Tx) error {
 	err := vm.Network.IssueTxFromRPC(tx)
 	if err != nil && !errors.Is(err, mempool.ErrDuplicateTx) {
@@ -28,6 +28,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/bootstrap"
 	"github.com/MetalBlockchain/metalgo/snow/networking/benchlist"
 	"github.com/MetalBlockchain/metalgo/snow/networking/handler"
+	"github.com/MetalBlockchain/metalgo/snow/networking/performance"
 	"github.com/MetalBlockchain/metalgo/snow/networking/router"
 	"github.com/MetalBlockchain/metalgo/snow/networking/sender"
 	"github.com/MetalBlockchain/metalgo/snow/networking/sender/sendertest"
@@ -170,7 +171,7 @@ func defaultVM(t *testing.T, f upgradetest.Fork) (*VM, database.Database, *mutab
 		return nil
 	}
 
-	dynamicConfigBytes := []byte(`{"network":{"max-validator-set-staleness":0}}`)
+	dynamicConfigBytes := []byte(`{"network":{"max-validator-set-staleness":0},"scheduled-txs-enabled":true}`)
 	require.NoError(vm.Initialize(
 		context.Background(),
 		ctx,
@@ -1192,6 +1193,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 			TimeoutCoefficient: 1.25,
 		},
 		benchlist.NewNoBenchlist(),
+		performance.NewNoTracker(),
 		prometheus.NewRegistry(),
 		prometheus.NewRegistry(),
 	)
@@ -2181,6 +2183,31 @@ func TestPruneMempool(t *testing.T) {
 	require.True(ok)
 }
 
+func TestTrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM(t, upgradetest.Latest)
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	subnetID := testSubnet1.ID()
+	require.NoError(vm.TrackSubnet(subnetID))
+	require.Contains(vm.TrackedSubnets, subnetID)
+	require.Contains(vm.subnetLoggers, subnetID)
+
+	// Tracking a subnet multiple times is a noop.
+	require.NoError(vm.TrackSubnet(subnetID))
+
+	require.NoError(vm.UntrackSubnet(subnetID))
+	require.NotContains(vm.TrackedSubnets, subnetID)
+	require.NotContains(vm.subnetLoggers, subnetID)
+
+	// Subnets can't be selectively tracked without sybil protection.
+	vm.SybilProtectionEnabled = false
+	err := vm.TrackSubnet(subnetID)
+	require.ErrorIs(err, errSybilProtectionDisabled)
+}
+
 func TestThrottleBlockBuildingUntilNormalOperationsStart(t *testing.T) {
 	require := require.New(t)
 
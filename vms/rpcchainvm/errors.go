@@ -5,6 +5,7 @@ package rpcchainvm
 
 import (
 	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/snow/engine/common"
 	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
 
 	vmpb "github.com/MetalBlockchain/metalgo/proto/pb/vm"
@@ -12,14 +13,16 @@ import (
 
 var (
 	errEnumToError = map[vmpb.Error]error{
-		vmpb.Error_ERROR_CLOSED:                     database.ErrClosed,
-		vmpb.Error_ERROR_NOT_FOUND:                  database.ErrNotFound,
-		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED: block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_CLOSED:                      database.ErrClosed,
+		vmpb.Error_ERROR_NOT_FOUND:                   database.ErrNotFound,
+		vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED:  block.ErrStateSyncableVMNotImplemented,
+		vmpb.Error_ERROR_RECONFIGURE_NOT_IMPLEMENTED: common.ErrReconfigurableVMNotImplemented,
 	}
 	errorToErrEnum = map[error]vmpb.Error{
-		database.ErrClosed:                     vmpb.Error_ERROR_CLOSED,
-		database.ErrNotFound:                   vmpb.Error_ERROR_NOT_FOUND,
-		block.ErrStateSyncableVMNotImplemented: vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		database.ErrClosed:                       vmpb.Error_ERROR_CLOSED,
+		database.ErrNotFound:                     vmpb.Error_ERROR_NOT_FOUND,
+		block.ErrStateSyncableVMNotImplemented:   vmpb.Error_ERROR_STATE_SYNC_NOT_IMPLEMENTED,
+		common.ErrReconfigurableVMNotImplemented: vmpb.Error_ERROR_RECONFIGURE_NOT_IMPLEMENTED,
 	}
 )
 
@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/MetalBlockchain/metalgo/snow/engine/common"
+	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block"
+	"github.com/MetalBlockchain/metalgo/snow/engine/snowman/block/blockmock"
+)
+
+var (
+	_ block.ChainVM           = (*ReconfigurableVMMock)(nil)
+	_ common.ReconfigurableVM = (*ReconfigurableVMMock)(nil)
+
+	validConfigBytes = []byte(`{"valid":true}`)
+)
+
+type ReconfigurableVMMock struct {
+	*blockmock.ChainVM
+}
+
+func (*ReconfigurableVMMock) Reconfigure(_ context.Context, configBytes []byte) error {
+	if string(configBytes) != string(validConfigBytes) {
+		return errBrokenConnectionOrSomething
+	}
+	return nil
+}
+
+func reconfigureTestPlugin(t *testing.T, _ bool) block.ChainVM {
+	// test key is "reconfigureTestKey"
+	ctrl := gomock.NewController(t)
+	return &ReconfigurableVMMock{
+		ChainVM: blockmock.NewChainVM(ctrl),
+	}
+}
+
+func reconfigureNotImplementedTestPlugin(t *testing.T, _ bool) block.ChainVM {
+	// test key is "reconfigureNotImplementedTestKey"
+	ctrl := gomock.NewController(t)
+	return blockmock.NewChainVM(ctrl)
+}
+
+func TestReconfigure(t *testing.T) {
+	require := require.New(t)
+	testKey := reconfigureTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	require.NoError(vm.Reconfigure(context.Background(), validConfigBytes))
+
+	// test a non-special error.
+	// TODO: retrieve exact error
+	err := vm.Reconfigure(context.Background(), []byte(`{"valid":false}`))
+	require.Error(err) //nolint:forbidigo // currently returns grpc errors
+}
+
+func TestReconfigureNotImplemented(t *testing.T) {
+	require := require.New(t)
+	testKey := reconfigureNotImplementedTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	err := vm.Reconfigure(context.Background(), validConfigBytes)
+	require.ErrorIs(err, common.ErrReconfigurableVMNotImplemented)
+}
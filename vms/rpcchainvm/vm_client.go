@@ -14,7 +14,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/MetalBlockchain/metalgo/api/metrics"
@@ -70,6 +72,7 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*VMClient)(nil)
 	_ block.BatchedChainVM               = (*VMClient)(nil)
 	_ block.StateSyncableVM              = (*VMClient)(nil)
+	_ common.ReconfigurableVM            = (*VMClient)(nil)
 	_ prometheus.Gatherer                = (*VMClient)(nil)
 
 	_ snowman.Block           = (*blockClient)(nil)
@@ -765,6 +768,23 @@ func (vm *VMClient) GetStateSummary(ctx context.Context, summaryHeight uint64) (
 	}, err
 }
 
+func (vm *VMClient) Reconfigure(ctx context.Context, configBytes []byte) error {
+	resp, err := vm.client.Reconfigure(
+		ctx,
+		&vmpb.ReconfigureRequest{
+			ConfigBytes: configBytes,
+		},
+	)
+	if status.Code(err) == codes.Unimplemented {
+		// Plugins built before the Reconfigure RPC was added don't serve it.
+		return common.ErrReconfigurableVMNotImplemented
+	}
+	if err != nil {
+		return err
+	}
+	return errEnumToError[resp.Err]
+}
+
 func (vm *VMClient) newBlockFromBuildBlock(resp *vmpb.BuildBlockResponse) (*blockClient, error) {
 	id, err := ids.ToID(resp.Id)
 	if err != nil {
@@ -67,6 +67,8 @@ type VMServer struct {
 	bVM block.BuildBlockWithContextChainVM
 	// If nil, the underlying VM doesn't implement the interface.
 	ssVM block.StateSyncableVM
+	// If nil, the underlying VM doesn't implement the interface.
+	rVM common.ReconfigurableVM
 
 	allowShutdown *utils.Atomic[bool]
 
@@ -85,11 +87,13 @@ type VMServer struct {
 func NewServer(vm block.ChainVM, allowShutdown *utils.Atomic[bool]) *VMServer {
 	bVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	rVM, _ := vm.(common.ReconfigurableVM)
 	vmSrv := &VMServer{
 		metrics:       metrics.NewPrefixGatherer(),
 		vm:            vm,
 		bVM:           bVM,
 		ssVM:          ssVM,
+		rVM:           rVM,
 		allowShutdown: allowShutdown,
 	}
 	return vmSrv
@@ -838,6 +842,22 @@ func (vm *VMServer) StateSummaryAccept(
 	}, errorToRPCError(err)
 }
 
+func (vm *VMServer) Reconfigure(
+	ctx context.Context,
+	req *vmpb.ReconfigureRequest,
+) (*vmpb.ReconfigureResponse, error) {
+	var err error
+	if vm.rVM != nil {
+		err = vm.rVM.Reconfigure(ctx, req.ConfigBytes)
+	} else {
+		err = common.ErrReconfigurableVMNotImplemented
+	}
+
+	return &vmpb.ReconfigureResponse{
+		Err: errorToErrEnum[err],
+	}, errorToRPCError(err)
+}
+
 func convertNetworkUpgrades(pbUpgrades *vmpb.NetworkUpgrades) (upgrade.Config, error) {
 	if pbUpgrades == nil {
 		return upgrade.Config{}, errNilNetworkUpgradesPB
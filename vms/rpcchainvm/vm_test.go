@@ -46,6 +46,8 @@ const (
 	lastAcceptedBlockPostStateSummaryAcceptTestKey = "lastAcceptedBlockPostStateSummaryAcceptTest"
 	contextTestKey                                 = "contextTest"
 	batchedParseBlockCachingTestKey                = "batchedParseBlockCachingTest"
+	reconfigureTestKey                             = "reconfigureTest"
+	reconfigureNotImplementedTestKey               = "reconfigureNotImplementedTest"
 )
 
 var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
@@ -58,6 +60,8 @@ var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
 	lastAcceptedBlockPostStateSummaryAcceptTestKey: lastAcceptedBlockPostStateSummaryAcceptTestPlugin,
 	contextTestKey:                                 contextEnabledTestPlugin,
 	batchedParseBlockCachingTestKey:                batchedParseBlockCachingTestPlugin,
+	reconfigureTestKey:                             reconfigureTestPlugin,
+	reconfigureNotImplementedTestKey:               reconfigureNotImplementedTestPlugin,
 }
 
 // helperProcess helps with creating the subnet binary for testing.
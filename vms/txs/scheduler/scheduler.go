@@ -0,0 +1,322 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/hashing"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/vms/txs/mempool"
+)
+
+const (
+	// MaxTxs is the maximum number of transactions that can be scheduled at
+	// once.
+	MaxTxs = 1024
+
+	// entryHeaderLen is the length of the not before time and the cancel
+	// token hash that prefix the tx bytes of a persisted entry.
+	entryHeaderLen = database.Uint64Size + ids.IDLen
+)
+
+var (
+	ErrDuplicateTx        = errors.New("duplicate tx")
+	ErrQueueFull          = errors.New("scheduled tx queue is full")
+	ErrUnknownTx          = errors.New("unknown tx")
+	ErrInvalidCancelToken = errors.New("invalid cancel token")
+
+	errInvalidEntry = errors.New("invalid scheduled tx entry")
+)
+
+type Tx interface {
+	ID() ids.ID
+	Bytes() []byte
+}
+
+// Entry is a transaction that must not be issued before [NotBefore].
+type Entry[T Tx] struct {
+	Tx        T
+	NotBefore time.Time
+}
+
+type scheduledTx[T Tx] struct {
+	Entry[T]
+
+	// cancelTokenHash is the hash of the token that must be provided to
+	// cancel the transaction. Only the hash is stored so that the token can't
+	// be recovered from the database.
+	cancelTokenHash ids.ID
+}
+
+// Queue is a persistent set of signed transactions that are issued once the
+// chain time reaches their not before time.
+type Queue[T Tx] struct {
+	db database.Database
+
+	lock    sync.Mutex
+	entries map[ids.ID]scheduledTx[T]
+}
+
+// New returns a queue backed by [db], loading any previously scheduled
+// transactions with [parse].
+func New[T Tx](db database.Database, parse func([]byte) (T, error)) (*Queue[T], error) {
+	q := &Queue[T]{
+		db:      db,
+		entries: make(map[ids.ID]scheduledTx[T]),
+	}
+
+	it := db.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		value := it.Value()
+		if len(value) < entryHeaderLen {
+			return nil, fmt.Errorf("%w: %x", errInvalidEntry, it.Key())
+		}
+
+		notBefore, err := database.ParseUInt64(value[:database.Uint64Size])
+		if err != nil {
+			return nil, err
+		}
+		cancelTokenHash, err := ids.ToID(value[database.Uint64Size:entryHeaderLen])
+		if err != nil {
+			return nil, err
+		}
+		tx, err := parse(value[entryHeaderLen:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse scheduled tx: %w", err)
+		}
+		q.entries[tx.ID()] = scheduledTx[T]{
+			Entry: Entry[T]{
+				Tx:        tx,
+				NotBefore: time.Unix(int64(notBefore), 0),
+			},
+			cancelTokenHash: cancelTokenHash,
+		}
+	}
+	return q, it.Error()
+}
+
+// Add schedules [tx] to be issued once the chain time reaches [notBefore].
+//
+// The returned token must be provided to [Cancel] to unschedule the
+// transaction.
+func (q *Queue[T]) Add(tx T, notBefore time.Time) (ids.ID, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	txID := tx.ID()
+	if _, ok := q.entries[txID]; ok {
+		return ids.Empty, fmt.Errorf("%w: %s", ErrDuplicateTx, txID)
+	}
+	if len(q.entries) >= MaxTxs {
+		return ids.Empty, fmt.Errorf("%w: %d txs", ErrQueueFull, MaxTxs)
+	}
+
+	txBytes := tx.Bytes()
+	if len(txBytes) > mempool.MaxTxSize {
+		return ids.Empty, fmt.Errorf("%w: %d bytes", mempool.ErrTxTooLarge, len(txBytes))
+	}
+
+	var cancelToken ids.ID
+	if _, err := rand.Read(cancelToken[:]); err != nil {
+		return ids.Empty, fmt.Errorf("failed to generate cancel token: %w", err)
+	}
+	cancelTokenHash := ids.ID(hashing.ComputeHash256Array(cancelToken[:]))
+
+	notBefore = time.Unix(notBefore.Unix(), 0)
+	value := make([]byte, 0, entryHeaderLen+len(txBytes))
+	value = append(value, database.PackUInt64(uint64(notBefore.Unix()))...)
+	value = append(value, cancelTokenHash[:]...)
+	value = append(value, txBytes...)
+	if err := q.db.Put(txID[:], value); err != nil {
+		return ids.Empty, err
+	}
+
+	q.entries[txID] = scheduledTx[T]{
+		Entry: Entry[T]{
+			Tx:        tx,
+			NotBefore: notBefore,
+		},
+		cancelTokenHash: cancelTokenHash,
+	}
+	return cancelToken, nil
+}
+
+// Cancel unschedules the transaction with [txID] if [cancelToken] is the
+// token that was returned when the transaction was added.
+func (q *Queue[T]) Cancel(txID ids.ID, cancelToken ids.ID) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	entry, ok := q.entries[txID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownTx, txID)
+	}
+	cancelTokenHash := hashing.ComputeHash256Array(cancelToken[:])
+	if subtle.ConstantTimeCompare(cancelTokenHash[:], entry.cancelTokenHash[:]) != 1 {
+		return fmt.Errorf("%w for tx %s", ErrInvalidCancelToken, txID)
+	}
+	return q.remove(txID)
+}
+
+// Remove unschedules the transaction with [txID].
+func (q *Queue[T]) Remove(txID ids.ID) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, ok := q.entries[txID]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownTx, txID)
+	}
+	return q.remove(txID)
+}
+
+// remove assumes that [q.lock] is held and that [txID] is scheduled.
+func (q *Queue[T]) remove(txID ids.ID) error {
+	if err := q.db.Delete(txID[:]); err != nil {
+		return err
+	}
+	delete(q.entries, txID)
+	return nil
+}
+
+// List returns the scheduled transactions ordered by their not before time.
+func (q *Queue[T]) List() []Entry[T] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	entries := make([]Entry[T], 0, len(q.entries))
+	for _, entry := range q.entries {
+		entries = append(entries, entry.Entry)
+	}
+	sortEntries(entries)
+	return entries
+}
+
+// Len returns the number of scheduled transactions.
+func (q *Queue[T]) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return len(q.entries)
+}
+
+// Due returns the transactions whose not before time is at or before [now],
+// ordered by their not before time. The transactions remain scheduled.
+func (q *Queue[T]) Due(now time.Time) []T {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	var due []Entry[T]
+	for _, entry := range q.entries {
+		if !entry.NotBefore.After(now) {
+			due = append(due, entry.Entry)
+		}
+	}
+	sortEntries(due)
+
+	txs := make([]T, len(due))
+	for i, entry := range due {
+		txs[i] = entry.Tx
+	}
+	return txs
+}
+
+// Run issues the scheduled transactions with [issue] once the time returned
+// by [now] reaches their not before time. The queue is checked every
+// [frequency] until [ctx] is cancelled.
+//
+// Transactions are unscheduled once they are issued. If issuance fails with an
+// error for which [retry] returns true, the transaction remains scheduled and
+// is issued again the next time the queue is checked. Otherwise, the
+// transaction is dropped.
+func (q *Queue[T]) Run(
+	ctx context.Context,
+	log logging.Logger,
+	frequency time.Duration,
+	now func() (time.Time, error),
+	issue func(T) error,
+	retry func(error) bool,
+) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if q.Len() == 0 {
+			continue
+		}
+
+		chainTime, err := now()
+		if err != nil {
+			log.Warn("failed to get chain time for scheduled txs",
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for _, tx := range q.Due(chainTime) {
+			if ctx.Err() != nil {
+				return
+			}
+
+			txID := tx.ID()
+			err := issue(tx)
+			switch {
+			case err == nil:
+				log.Info("issued scheduled tx",
+					zap.Stringer("txID", txID),
+				)
+			case ctx.Err() != nil:
+				// Issuance may fail because the chain is shutting down.
+				return
+			case retry(err):
+				log.Debug("retrying scheduled tx",
+					zap.Stringer("txID", txID),
+					zap.Error(err),
+				)
+				continue
+			default:
+				log.Warn("dropping scheduled tx",
+					zap.Stringer("txID", txID),
+					zap.Error(err),
+				)
+			}
+
+			// The tx may have been removed concurrently.
+			if err := q.Remove(txID); err != nil && !errors.Is(err, ErrUnknownTx) {
+				log.Error("failed to remove scheduled tx",
+					zap.Stringer("txID", txID),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func sortEntries[T Tx](entries []Entry[T]) {
+	slices.SortFunc(entries, func(a, b Entry[T]) int {
+		if c := a.NotBefore.Compare(b.NotBefore); c != 0 {
+			return c
+		}
+		return a.Tx.ID().Compare(b.Tx.ID())
+	})
+}
@@ -0,0 +1,282 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/database/memdb"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/hashing"
+	"github.com/MetalBlockchain/metalgo/utils/logging"
+	"github.com/MetalBlockchain/metalgo/vms/txs/mempool"
+)
+
+var (
+	errTest      = errors.New("test error")
+	errRetryable = errors.New("retryable error")
+)
+
+type dummyTx struct {
+	bytes []byte
+}
+
+func (tx *dummyTx) ID() ids.ID {
+	return hashing.ComputeHash256Array(tx.bytes)
+}
+
+func (tx *dummyTx) Bytes() []byte {
+	return tx.bytes
+}
+
+func parseDummyTx(b []byte) (*dummyTx, error) {
+	return &dummyTx{bytes: b}, nil
+}
+
+func TestQueueAddRemove(t *testing.T) {
+	require := require.New(t)
+
+	q, err := New(memdb.New(), parseDummyTx)
+	require.NoError(err)
+
+	var (
+		tx0 = &dummyTx{bytes: []byte{0}}
+		tx1 = &dummyTx{bytes: []byte{1}}
+		now = time.Unix(1_000, 0)
+	)
+	_, err = q.Add(tx0, now.Add(time.Minute))
+	require.NoError(err)
+	_, err = q.Add(tx1, now)
+	require.NoError(err)
+
+	_, err = q.Add(tx0, now)
+	require.ErrorIs(err, ErrDuplicateTx)
+
+	require.Equal(
+		[]Entry[*dummyTx]{
+			{Tx: tx1, NotBefore: now},
+			{Tx: tx0, NotBefore: now.Add(time.Minute)},
+		},
+		q.List(),
+	)
+
+	require.NoError(q.Remove(tx1.ID()))
+	err = q.Remove(tx1.ID())
+	require.ErrorIs(err, ErrUnknownTx)
+	require.Equal(1, q.Len())
+}
+
+func TestQueueCancel(t *testing.T) {
+	require := require.New(t)
+
+	q, err := New(memdb.New(), parseDummyTx)
+	require.NoError(err)
+
+	var (
+		tx0 = &dummyTx{bytes: []byte{0}}
+		tx1 = &dummyTx{bytes: []byte{1}}
+	)
+	cancelToken0, err := q.Add(tx0, time.Time{})
+	require.NoError(err)
+	cancelToken1, err := q.Add(tx1, time.Time{})
+	require.NoError(err)
+	require.NotEqual(cancelToken0, cancelToken1)
+
+	err = q.Cancel(tx0.ID(), cancelToken1)
+	require.ErrorIs(err, ErrInvalidCancelToken)
+	require.Equal(2, q.Len())
+
+	require.NoError(q.Cancel(tx0.ID(), cancelToken0))
+	err = q.Cancel(tx0.ID(), cancelToken0)
+	require.ErrorIs(err, ErrUnknownTx)
+	require.Equal(1, q.Len())
+}
+
+func TestQueueLimits(t *testing.T) {
+	require := require.New(t)
+
+	q, err := New(memdb.New(), parseDummyTx)
+	require.NoError(err)
+
+	_, err = q.Add(&dummyTx{bytes: make([]byte, mempool.MaxTxSize+1)}, time.Time{})
+	require.ErrorIs(err, mempool.ErrTxTooLarge)
+
+	for i := range MaxTxs {
+		_, err = q.Add(&dummyTx{bytes: database.PackUInt64(uint64(i))}, time.Time{})
+		require.NoError(err)
+	}
+	_, err = q.Add(&dummyTx{bytes: []byte{0}}, time.Time{})
+	require.ErrorIs(err, ErrQueueFull)
+}
+
+func TestQueuePersistence(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	q, err := New(db, parseDummyTx)
+	require.NoError(err)
+
+	var (
+		tx0       = &dummyTx{bytes: []byte{0}}
+		tx1       = &dummyTx{bytes: []byte{1}}
+		notBefore = time.Unix(1_000, 0)
+	)
+	cancelToken, err := q.Add(tx0, notBefore)
+	require.NoError(err)
+	_, err = q.Add(tx1, notBefore)
+	require.NoError(err)
+	require.NoError(q.Remove(tx1.ID()))
+
+	q, err = New(db, parseDummyTx)
+	require.NoError(err)
+	require.Equal(
+		[]Entry[*dummyTx]{
+			{Tx: tx0, NotBefore: notBefore},
+		},
+		q.List(),
+	)
+
+	// The cancel token remains valid after the queue is reloaded.
+	require.NoError(q.Cancel(tx0.ID(), cancelToken))
+	_, err = q.Add(tx0, notBefore)
+	require.NoError(err)
+
+	_, err = New(db, func([]byte) (*dummyTx, error) {
+		return nil, errTest
+	})
+	require.ErrorIs(err, errTest)
+}
+
+func TestQueueDue(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	q, err := New(db, parseDummyTx)
+	require.NoError(err)
+
+	var (
+		tx0 = &dummyTx{bytes: []byte{0}}
+		tx1 = &dummyTx{bytes: []byte{1}}
+		tx2 = &dummyTx{bytes: []byte{2}}
+		now = time.Unix(1_000, 0)
+	)
+	_, err = q.Add(tx0, now)
+	require.NoError(err)
+	_, err = q.Add(tx1, now.Add(-time.Second))
+	require.NoError(err)
+	_, err = q.Add(tx2, now.Add(time.Second))
+	require.NoError(err)
+
+	require.Equal([]*dummyTx{tx1, tx0}, q.Due(now))
+	require.Equal(3, q.Len())
+}
+
+func TestQueueRun(t *testing.T) {
+	require := require.New(t)
+
+	q, err := New(memdb.New(), parseDummyTx)
+	require.NoError(err)
+
+	var (
+		tx0 = &dummyTx{bytes: []byte{0}}
+		tx1 = &dummyTx{bytes: []byte{1}}
+		now = time.Unix(1_000, 0)
+	)
+	_, err = q.Add(tx0, now)
+	require.NoError(err)
+	_, err = q.Add(tx1, now.Add(time.Hour))
+	require.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	issued := make(chan *dummyTx, 2)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		q.Run(
+			ctx,
+			logging.NoLog{},
+			time.Millisecond,
+			func() (time.Time, error) {
+				return now, nil
+			},
+			func(tx *dummyTx) error {
+				issued <- tx
+				return nil
+			},
+			func(error) bool {
+				return false
+			},
+		)
+	}()
+
+	require.Equal(tx0, <-issued)
+	cancel()
+	<-done
+
+	require.Empty(issued)
+	require.Equal(1, q.Len())
+}
+
+func TestQueueRunRetry(t *testing.T) {
+	require := require.New(t)
+
+	q, err := New(memdb.New(), parseDummyTx)
+	require.NoError(err)
+
+	var (
+		retryableTx = &dummyTx{bytes: []byte{0}}
+		failingTx   = &dummyTx{bytes: []byte{1}}
+		now         = time.Unix(1_000, 0)
+	)
+	_, err = q.Add(retryableTx, now)
+	require.NoError(err)
+	_, err = q.Add(failingTx, now)
+	require.NoError(err)
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		done        = make(chan struct{})
+		attempts    = make(map[ids.ID]int)
+	)
+	go func() {
+		defer close(done)
+		q.Run(
+			ctx,
+			logging.NoLog{},
+			time.Millisecond,
+			func() (time.Time, error) {
+				return now, nil
+			},
+			func(tx *dummyTx) error {
+				txID := tx.ID()
+				attempts[txID]++
+				switch {
+				case txID == failingTx.ID():
+					return errTest
+				case attempts[txID] < 3:
+					return errRetryable
+				default:
+					cancel()
+					return nil
+				}
+			},
+			func(err error) bool {
+				return errors.Is(err, errRetryable)
+			},
+		)
+	}()
+	<-done
+
+	// The tx that failed with a non-retryable error is dropped after a single
+	// attempt, while the other tx is retried until it is issued.
+	require.Equal(1, attempts[failingTx.ID()])
+	require.Equal(3, attempts[retryableTx.ID()])
+	require.Zero(q.Len())
+}
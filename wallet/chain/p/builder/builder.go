@@ -19,6 +19,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/vms/components/gas"
 	"github.com/MetalBlockchain/metalgo/vms/components/verify"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/fx"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/stakeable"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
@@ -210,6 +211,18 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.DisableL1ValidatorTx, error)
 
+	// NewSetSubnetRewardCurveTx sets the curve used to reward the stakers of a
+	// permissionless subnet.
+	//
+	// - [subnetID] specifies the subnet to be modified
+	// - [curve] specifies how the rewards of stakers added to the subnet after
+	//   this tx are calculated
+	NewSetSubnetRewardCurveTx(
+		subnetID ids.ID,
+		curve reward.Curve,
+		options ...common.Option,
+	) (*txs.SetSubnetRewardCurveTx, error)
+
 	// NewImportTx creates an import transaction that attempts to consume all
 	// the available UTXOs and import the funds to [to].
 	//
@@ -1106,6 +1119,69 @@ func (b *builder) NewDisableL1ValidatorTx(
 	return tx, b.initCtx(tx)
 }
 
+func (b *builder) NewSetSubnetRewardCurveTx(
+	subnetID ids.ID,
+	curve reward.Curve,
+	options ...common.Option,
+) (*txs.SetSubnetRewardCurveTx, error) {
+	var (
+		toBurn  = map[ids.ID]uint64{}
+		toStake = map[ids.ID]uint64{}
+		ops     = common.NewOptions(options)
+	)
+	subnetAuth, err := b.authorize(subnetID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	curveComplexity, err := fee.RewardCurveComplexity(curve)
+	if err != nil {
+		return nil, err
+	}
+	authComplexity, err := fee.AuthComplexity(subnetAuth)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicSetSubnetRewardCurveTxComplexities.Add(
+		&memoComplexity,
+		&curveComplexity,
+		&authComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &txs.SetSubnetRewardCurveTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		Subnet:     subnetID,
+		Curve:      curve,
+		SubnetAuth: subnetAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
 func (b *builder) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
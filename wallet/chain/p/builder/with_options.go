@@ -9,6 +9,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
@@ -217,6 +218,18 @@ func (w *withOptions) NewDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) NewSetSubnetRewardCurveTx(
+	subnetID ids.ID,
+	curve reward.Curve,
+	options ...common.Option,
+) (*txs.SetSubnetRewardCurveTx, error) {
+	return w.builder.NewSetSubnetRewardCurveTx(
+		subnetID,
+		curve,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -0,0 +1,344 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/fx"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+var (
+	_ Backend           = (*PartiallySignedTx)(nil)
+	_ Backend           = (*recordingBackend)(nil)
+	_ keychain.Keychain = (*recordingKeychain)(nil)
+	_ keychain.Signer   = (*recordingSigner)(nil)
+
+	ErrMismatchedTx          = errors.New("partially signed txs do not share the same unsigned tx")
+	ErrMismatchedCredentials = errors.New("partially signed txs have mismatched credentials")
+	ErrConflictingSignatures = errors.New("partially signed txs contain conflicting signatures")
+	ErrMissingSignatures     = errors.New("tx is missing signatures")
+	errMissingUTXO           = errors.New("missing UTXO")
+	errMissingOwner          = errors.New("missing owner")
+)
+
+// PartiallySignedTx is a portable container for a P-chain transaction that is
+// in the process of being signed.
+//
+// It carries everything that a signer needs to add its signatures without
+// having access to a node: the unsigned transaction, the UTXOs it consumes and
+// the owners of any subnets or L1 validators it must authorize. This allows
+// multiple parties, each holding a subset of the required keys, to sign the
+// transaction in turn.
+type PartiallySignedTx struct {
+	// Tx is the transaction along with the signatures that have been collected
+	// so far. Signatures that have not been provided yet are left empty.
+	Tx *txs.Tx `serialize:"true" json:"tx"`
+	// UTXOs are the UTXOs consumed by [Tx].
+	UTXOs []*ChainUTXO `serialize:"true" json:"utxos"`
+	// Owners are the owners that must authorize [Tx].
+	Owners []*Owner `serialize:"true" json:"owners"`
+}
+
+// ChainUTXO is a UTXO along with the chain it is stored on.
+type ChainUTXO struct {
+	ChainID ids.ID     `serialize:"true" json:"chainID"`
+	UTXO    *avax.UTXO `serialize:"true" json:"utxo"`
+}
+
+// Owner is the owner of a subnet or of an L1 validator.
+type Owner struct {
+	// OwnerID is either a subnetID or a validationID.
+	OwnerID ids.ID   `serialize:"true" json:"ownerID"`
+	Owner   fx.Owner `serialize:"true" json:"owner"`
+}
+
+// NewPartiallySignedTx creates a container for [utx] without any signatures.
+//
+// All the UTXOs and owners referenced by [utx] must be available in [backend].
+func NewPartiallySignedTx(
+	ctx context.Context,
+	backend Backend,
+	utx txs.UnsignedTx,
+) (*PartiallySignedTx, error) {
+	b := &recordingBackend{
+		backend: backend,
+	}
+	tx := &txs.Tx{Unsigned: utx}
+	err := tx.Unsigned.Visit(&visitor{
+		kc:      &recordingKeychain{},
+		backend: b,
+		ctx:     ctx,
+		tx:      tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PartiallySignedTx{
+		Tx:     tx,
+		UTXOs:  b.utxos,
+		Owners: b.owners,
+	}, nil
+}
+
+// ParsePartiallySignedTx parses the byte representation produced by
+// [PartiallySignedTx.Bytes].
+func ParsePartiallySignedTx(b []byte) (*PartiallySignedTx, error) {
+	ptx := &PartiallySignedTx{}
+	if _, err := txs.Codec.Unmarshal(b, ptx); err != nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", err)
+	}
+	if ptx.Tx == nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", txs.ErrNilSignedTx)
+	}
+	return ptx, ptx.Tx.Initialize(txs.Codec)
+}
+
+// Bytes returns the binary representation of this container.
+func (p *PartiallySignedTx) Bytes() ([]byte, error) {
+	return txs.Codec.Marshal(txs.CodecVersion, p)
+}
+
+func (p *PartiallySignedTx) GetUTXO(_ context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	for _, utxo := range p.UTXOs {
+		if utxo.ChainID == chainID && utxo.UTXO.InputID() == utxoID {
+			return utxo.UTXO, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (p *PartiallySignedTx) GetOwner(_ context.Context, ownerID ids.ID) (fx.Owner, error) {
+	for _, owner := range p.Owners {
+		if owner.OwnerID == ownerID {
+			return owner.Owner, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+// Sign adds all the signatures that [kc] is able to provide.
+func (p *PartiallySignedTx) Sign(ctx context.Context, kc keychain.Keychain) error {
+	return New(kc, p).Sign(ctx, p.Tx)
+}
+
+// RequiredSigners returns the addresses whose signatures are required by the
+// transaction.
+func (p *PartiallySignedTx) RequiredSigners(ctx context.Context) (set.Set[ids.ShortID], error) {
+	kc, err := p.signers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kc.required, nil
+}
+
+// MissingSigners returns the addresses whose signatures are required by the
+// transaction but have not been provided yet.
+func (p *PartiallySignedTx) MissingSigners(ctx context.Context) (set.Set[ids.ShortID], error) {
+	kc, err := p.signers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kc.missing, nil
+}
+
+// signers performs a dry run of the signing process on a copy of the
+// transaction to determine which signatures are required and which are still
+// missing.
+func (p *PartiallySignedTx) signers(ctx context.Context) (*recordingKeychain, error) {
+	creds, err := copyCredentials(p.Tx.Creds)
+	if err != nil {
+		return nil, err
+	}
+
+	kc := &recordingKeychain{}
+	err = p.Tx.Unsigned.Visit(&visitor{
+		kc:      kc,
+		backend: p,
+		ctx:     ctx,
+		tx: &txs.Tx{
+			Unsigned: p.Tx.Unsigned,
+			Creds:    creds,
+		},
+	})
+	return kc, err
+}
+
+// Combine adds the signatures collected in [other] to this container.
+//
+// Both containers must hold the same unsigned transaction.
+func (p *PartiallySignedTx) Combine(other *PartiallySignedTx) error {
+	if !bytes.Equal(p.Tx.Unsigned.Bytes(), other.Tx.Unsigned.Bytes()) {
+		return ErrMismatchedTx
+	}
+	if len(p.Tx.Creds) != len(other.Tx.Creds) {
+		return fmt.Errorf("%w: expected %d but got %d",
+			ErrMismatchedCredentials,
+			len(p.Tx.Creds),
+			len(other.Tx.Creds),
+		)
+	}
+
+	for credIndex, credIntf := range p.Tx.Creds {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			return ErrUnknownCredentialType
+		}
+		otherCred, ok := other.Tx.Creds[credIndex].(*secp256k1fx.Credential)
+		if !ok {
+			return ErrUnknownCredentialType
+		}
+		if len(cred.Sigs) != len(otherCred.Sigs) {
+			return fmt.Errorf("%w: credential %d expected %d signatures but got %d",
+				ErrMismatchedCredentials,
+				credIndex,
+				len(cred.Sigs),
+				len(otherCred.Sigs),
+			)
+		}
+
+		for sigIndex, otherSig := range otherCred.Sigs {
+			sig := cred.Sigs[sigIndex]
+			switch {
+			case otherSig == emptySig || sig == otherSig:
+			case sig == emptySig:
+				cred.Sigs[sigIndex] = otherSig
+			default:
+				return fmt.Errorf("%w: credential %d signature %d",
+					ErrConflictingSignatures,
+					credIndex,
+					sigIndex,
+				)
+			}
+		}
+	}
+	return p.Tx.Initialize(txs.Codec)
+}
+
+// Finalize returns the signed transaction.
+//
+// An error is returned if any signature is still missing.
+func (p *PartiallySignedTx) Finalize() (*txs.Tx, error) {
+	for credIndex, credIntf := range p.Tx.Creds {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			return nil, ErrUnknownCredentialType
+		}
+		for sigIndex, sig := range cred.Sigs {
+			if sig == emptySig {
+				return nil, fmt.Errorf("%w: credential %d signature %d",
+					ErrMissingSignatures,
+					credIndex,
+					sigIndex,
+				)
+			}
+		}
+	}
+	return p.Tx, p.Tx.Initialize(txs.Codec)
+}
+
+func copyCredentials(creds []verify.Verifiable) ([]verify.Verifiable, error) {
+	copied := make([]verify.Verifiable, len(creds))
+	for i, credIntf := range creds {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			return nil, ErrUnknownCredentialType
+		}
+		copied[i] = &secp256k1fx.Credential{
+			Sigs: append([][secp256k1.SignatureLen]byte(nil), cred.Sigs...),
+		}
+	}
+	return copied, nil
+}
+
+// recordingBackend records every UTXO and owner that is requested from the
+// underlying backend.
+type recordingBackend struct {
+	backend Backend
+
+	utxos  []*ChainUTXO
+	owners []*Owner
+}
+
+func (b *recordingBackend) GetUTXO(ctx context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, err := b.backend.GetUTXO(ctx, chainID, utxoID)
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%w: %s on chain %s", errMissingUTXO, utxoID, chainID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.utxos = append(b.utxos, &ChainUTXO{
+		ChainID: chainID,
+		UTXO:    utxo,
+	})
+	return utxo, nil
+}
+
+func (b *recordingBackend) GetOwner(ctx context.Context, ownerID ids.ID) (fx.Owner, error) {
+	owner, err := b.backend.GetOwner(ctx, ownerID)
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%w: %s", errMissingOwner, ownerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.owners = append(b.owners, &Owner{
+		OwnerID: ownerID,
+		Owner:   owner,
+	})
+	return owner, nil
+}
+
+// recordingKeychain claims to hold every key so that the signing process
+// reports every address it needs a signature from. Signatures are only
+// requested for slots that have not been populated yet, which allows tracking
+// the missing signers.
+type recordingKeychain struct {
+	required set.Set[ids.ShortID]
+	missing  set.Set[ids.ShortID]
+}
+
+func (kc *recordingKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	kc.required.Add(addr)
+	return &recordingSigner{
+		kc:   kc,
+		addr: addr,
+	}, true
+}
+
+func (kc *recordingKeychain) Addresses() set.Set[ids.ShortID] {
+	return kc.required
+}
+
+type recordingSigner struct {
+	kc   *recordingKeychain
+	addr ids.ShortID
+}
+
+func (s *recordingSigner) SignHash([]byte) ([]byte, error) {
+	s.kc.missing.Add(s.addr)
+	return emptySig[:], nil
+}
+
+func (s *recordingSigner) Sign([]byte) ([]byte, error) {
+	s.kc.missing.Add(s.addr)
+	return emptySig[:], nil
+}
+
+func (s *recordingSigner) Address() ids.ShortID {
+	return s.addr
+}
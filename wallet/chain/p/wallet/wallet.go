@@ -9,6 +9,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 	"github.com/MetalBlockchain/metalgo/wallet/chain/p/builder"
@@ -198,6 +199,18 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueSetSubnetRewardCurveTx creates, signs, and issues a transaction that
+	// sets the curve used to reward the stakers of a permissionless subnet.
+	//
+	// - [subnetID] specifies the subnet to be modified
+	// - [curve] specifies how the rewards of stakers added to the subnet after
+	//   this tx are calculated
+	IssueSetSubnetRewardCurveTx(
+		subnetID ids.ID,
+		curve reward.Curve,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueImportTx creates, signs, and issues an import transaction that
 	// attempts to consume all the available UTXOs and import the funds to [to].
 	//
@@ -499,6 +512,18 @@ func (w *wallet) IssueDisableL1ValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueSetSubnetRewardCurveTx(
+	subnetID ids.ID,
+	curve reward.Curve,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewSetSubnetRewardCurveTx(subnetID, curve, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -9,6 +9,7 @@ import (
 	"github.com/MetalBlockchain/metalgo/ids"
 	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
 	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
 	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
 	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
 	"github.com/MetalBlockchain/metalgo/wallet/chain/p/builder"
@@ -206,6 +207,18 @@ func (w *withOptions) IssueDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) IssueSetSubnetRewardCurveTx(
+	subnetID ids.ID,
+	curve reward.Curve,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueSetSubnetRewardCurveTx(
+		subnetID,
+		curve,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
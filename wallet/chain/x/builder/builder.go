@@ -114,6 +114,33 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.OperationTx, error)
 
+	// NewOperationTxMintNFTGroup performs a state change that mints new NFTs
+	// in a specific group of the requested asset.
+	//
+	// - [assetID] specifies the asset to mint the NFTs under.
+	// - [groupID] specifies the group of the asset to mint the NFTs in.
+	// - [payload] specifies the payload to provide each new NFT.
+	// - [owners] specifies the new owners of each NFT.
+	NewOperationTxMintNFTGroup(
+		assetID ids.ID,
+		groupID uint32,
+		payload []byte,
+		owners []*secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.OperationTx, error)
+
+	// NewOperationTxTransferNFT performs a state change that transfers an NFT
+	// to a new owner. The payload of the NFT is preserved.
+	//
+	// - [utxoID] specifies the UTXO holding the NFT, as returned by
+	//   avm.getNFTs.
+	// - [owner] specifies the new owner of the NFT.
+	NewOperationTxTransferNFT(
+		utxoID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.OperationTx, error)
+
 	// NewOperationTxMintProperty performs a state change that mints a new
 	// property for the requested asset.
 	//
@@ -329,7 +356,35 @@ func (b *builder) NewOperationTxMintNFT(
 	options ...common.Option,
 ) (*txs.OperationTx, error) {
 	ops := common.NewOptions(options)
-	operations, err := b.mintNFTs(assetID, payload, owners, ops)
+	operations, err := b.mintNFTs(assetID, anyGroup, payload, owners, ops)
+	if err != nil {
+		return nil, err
+	}
+	return b.NewOperationTx(operations, options...)
+}
+
+func (b *builder) NewOperationTxMintNFTGroup(
+	assetID ids.ID,
+	groupID uint32,
+	payload []byte,
+	owners []*secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.OperationTx, error) {
+	ops := common.NewOptions(options)
+	operations, err := b.mintNFTs(assetID, inGroup(groupID), payload, owners, ops)
+	if err != nil {
+		return nil, err
+	}
+	return b.NewOperationTx(operations, options...)
+}
+
+func (b *builder) NewOperationTxTransferNFT(
+	utxoID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.OperationTx, error) {
+	ops := common.NewOptions(options)
+	operations, err := b.transferNFT(utxoID, owner, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -704,9 +759,20 @@ func (b *builder) mintFTs(
 	return operations, nil
 }
 
+func anyGroup(uint32) bool {
+	return true
+}
+
+func inGroup(groupID uint32) func(uint32) bool {
+	return func(outGroupID uint32) bool {
+		return outGroupID == groupID
+	}
+}
+
 // TODO: make this able to generate multiple NFT groups
 func (b *builder) mintNFTs(
 	assetID ids.ID,
+	matchGroup func(groupID uint32) bool,
 	payload []byte,
 	owners []*secp256k1fx.OutputOwners,
 	options *common.Options,
@@ -728,8 +794,8 @@ func (b *builder) mintNFTs(
 		}
 
 		out, ok := utxo.Out.(*nftfx.MintOutput)
-		if !ok {
-			// wrong output type
+		if !ok || !matchGroup(out.GroupID) {
+			// wrong output type or group
 			continue
 		}
 
@@ -763,6 +829,65 @@ func (b *builder) mintNFTs(
 	)
 }
 
+func (b *builder) transferNFT(
+	utxoID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options *common.Options,
+) (
+	operations []*txs.Operation,
+	err error,
+) {
+	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+
+	for _, utxo := range utxos {
+		if utxoID != utxo.InputID() {
+			continue
+		}
+
+		out, ok := utxo.Out.(*nftfx.TransferOutput)
+		if !ok {
+			// wrong output type
+			break
+		}
+
+		inputSigIndices, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+		if !ok {
+			break
+		}
+
+		// add the operation to the array
+		operations = append(operations, &txs.Operation{
+			Asset: utxo.Asset,
+			UTXOIDs: []*avax.UTXOID{
+				&utxo.UTXOID,
+			},
+			FxID: nftfx.ID,
+			Op: &nftfx.TransferOperation{
+				Input: secp256k1fx.Input{
+					SigIndices: inputSigIndices,
+				},
+				Output: nftfx.TransferOutput{
+					GroupID:      out.GroupID,
+					Payload:      out.Payload,
+					OutputOwners: *owner,
+				},
+			},
+		})
+		return operations, nil
+	}
+	return nil, fmt.Errorf(
+		"%w: provided UTXOs not able to transfer NFT %q",
+		errInsufficientFunds,
+		utxoID,
+	)
+}
+
 func (b *builder) mintProperty(
 	assetID ids.ID,
 	owner *secp256k1fx.OutputOwners,
@@ -115,6 +115,34 @@ func (b *builderWithOptions) NewOperationTxMintNFT(
 	)
 }
 
+func (b *builderWithOptions) NewOperationTxMintNFTGroup(
+	assetID ids.ID,
+	groupID uint32,
+	payload []byte,
+	owners []*secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.OperationTx, error) {
+	return b.builder.NewOperationTxMintNFTGroup(
+		assetID,
+		groupID,
+		payload,
+		owners,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewOperationTxTransferNFT(
+	utxoID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.OperationTx, error) {
+	return b.builder.NewOperationTxTransferNFT(
+		utxoID,
+		owner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewOperationTxMintProperty(
 	assetID ids.ID,
 	owner *secp256k1fx.OutputOwners,
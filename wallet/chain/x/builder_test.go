@@ -227,6 +227,123 @@ func TestMintNFTOperation(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestMintNFTGroupOperation(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = utxotest.NewDeterministicChainUTXOs(
+			t,
+			map[ids.ID][]*avax.UTXO{
+				xChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		payload  = []byte{'h', 'e', 'l', 'l', 'o'}
+		NFTOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+	)
+
+	utx, err := txBuilder.NewOperationTxMintNFTGroup(
+		nftAssetID,
+		1,
+		payload,
+		[]*secp256k1fx.OutputOwners{NFTOwner},
+	)
+	require.NoError(err)
+	require.Len(utx.Ops, 1)
+	op := utx.Ops[0].Op.(*nftfx.MintOperation)
+	require.Equal(uint32(1), op.GroupID)
+	require.Equal(payload, []byte(op.Payload))
+
+	// check UTXOs selection and fee financing
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 1)
+	require.Len(outs, 1)
+
+	expectedConsumed := testContext.BaseTxFee
+	consumed := ins[0].In.Amount() - outs[0].Out.Amount()
+	require.Equal(expectedConsumed, consumed)
+
+	// there is no mint output for group 2
+	_, err = txBuilder.NewOperationTxMintNFTGroup(
+		nftAssetID,
+		2,
+		payload,
+		[]*secp256k1fx.OutputOwners{NFTOwner},
+	)
+	require.Error(err) //nolint:forbidigo // error is not exported
+}
+
+func TestTransferNFTOperation(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = utxotest.NewDeterministicChainUTXOs(
+			t,
+			map[ids.ID][]*avax.UTXO{
+				xChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		nftUTXO  = utxos[5]
+		NFTOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{testKeys[0].Address()},
+		}
+	)
+
+	utx, err := txBuilder.NewOperationTxTransferNFT(
+		nftUTXO.InputID(),
+		NFTOwner,
+	)
+	require.NoError(err)
+	require.Len(utx.Ops, 1)
+	require.Equal(nftAssetID, utx.Ops[0].AssetID())
+	require.Equal([]*avax.UTXOID{&nftUTXO.UTXOID}, utx.Ops[0].UTXOIDs)
+	op := utx.Ops[0].Op.(*nftfx.TransferOperation)
+	require.Equal(uint32(1), op.Output.GroupID)
+	require.Equal([]byte{'n', 'f', 't'}, []byte(op.Output.Payload))
+	require.Equal(NFTOwner.Addrs, op.Output.Addrs)
+
+	// check UTXOs selection and fee financing
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 1)
+	require.Len(outs, 1)
+
+	expectedConsumed := testContext.BaseTxFee
+	consumed := ins[0].In.Amount() - outs[0].Out.Amount()
+	require.Equal(expectedConsumed, consumed)
+
+	// the UTXO doesn't hold an NFT
+	_, err = txBuilder.NewOperationTxTransferNFT(
+		utxos[1].InputID(),
+		NFTOwner,
+	)
+	require.Error(err) //nolint:forbidigo // error is not exported
+}
+
 func TestMintFTOperation(t *testing.T) {
 	require := require.New(t)
 
@@ -532,6 +649,21 @@ func makeTestUTXOs(utxosKey *secp256k1.PrivateKey) []*avax.UTXO {
 				},
 			},
 		},
+		{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.Empty.Prefix(utxosOffset + 7),
+				OutputIndex: uint32(utxosOffset + 7),
+			},
+			Asset: avax.Asset{ID: nftAssetID},
+			Out: &nftfx.TransferOutput{
+				GroupID: 1,
+				Payload: []byte{'n', 'f', 't'},
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxosKey.PublicKey().Address()},
+				},
+			},
+		},
 		{ // a large UTXO last, which should be enough to pay any fee by itself
 			UTXOID: avax.UTXOID{
 				TxID:        ids.Empty.Prefix(utxosOffset + 6),
@@ -0,0 +1,341 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/avm/fxs"
+	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/nftfx"
+	"github.com/MetalBlockchain/metalgo/vms/propertyfx"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/x/builder"
+)
+
+var (
+	_ Backend           = (*PartiallySignedTx)(nil)
+	_ Backend           = (*recordingBackend)(nil)
+	_ keychain.Keychain = (*recordingKeychain)(nil)
+	_ keychain.Signer   = (*recordingSigner)(nil)
+
+	ErrMismatchedTx          = errors.New("partially signed txs do not share the same unsigned tx")
+	ErrMismatchedCredentials = errors.New("partially signed txs have mismatched credentials")
+	ErrConflictingSignatures = errors.New("partially signed txs contain conflicting signatures")
+	ErrMissingSignatures     = errors.New("tx is missing signatures")
+	errMissingUTXO           = errors.New("missing UTXO")
+	errNilTx                 = errors.New("nil tx")
+)
+
+// PartiallySignedTx is a portable container for an X-chain transaction that
+// is in the process of being signed.
+//
+// It carries the unsigned transaction along with the UTXOs it consumes, so that
+// multiple parties, each holding a subset of the required keys, can sign the
+// transaction in turn without having access to a node.
+type PartiallySignedTx struct {
+	// Tx is the transaction along with the signatures that have been collected
+	// so far. Signatures that have not been provided yet are left empty.
+	Tx *txs.Tx `serialize:"true" json:"tx"`
+	// UTXOs are the UTXOs consumed by [Tx].
+	UTXOs []*ChainUTXO `serialize:"true" json:"utxos"`
+}
+
+// ChainUTXO is a UTXO along with the chain it is stored on.
+type ChainUTXO struct {
+	ChainID ids.ID     `serialize:"true" json:"chainID"`
+	UTXO    *avax.UTXO `serialize:"true" json:"utxo"`
+}
+
+// NewPartiallySignedTx creates a container for [utx] without any signatures.
+//
+// All the UTXOs referenced by [utx] must be available in [backend].
+func NewPartiallySignedTx(
+	ctx context.Context,
+	backend Backend,
+	utx txs.UnsignedTx,
+) (*PartiallySignedTx, error) {
+	b := &recordingBackend{
+		backend: backend,
+	}
+	tx := &txs.Tx{Unsigned: utx}
+	err := tx.Unsigned.Visit(&visitor{
+		kc:      &recordingKeychain{},
+		backend: b,
+		ctx:     ctx,
+		tx:      tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PartiallySignedTx{
+		Tx:    tx,
+		UTXOs: b.utxos,
+	}, nil
+}
+
+// ParsePartiallySignedTx parses the byte representation produced by
+// [PartiallySignedTx.Bytes].
+func ParsePartiallySignedTx(b []byte) (*PartiallySignedTx, error) {
+	codec := builder.Parser.Codec()
+	ptx := &PartiallySignedTx{}
+	if _, err := codec.Unmarshal(b, ptx); err != nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", err)
+	}
+	if ptx.Tx == nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", errNilTx)
+	}
+	return ptx, ptx.Tx.Initialize(codec)
+}
+
+// Bytes returns the binary representation of this container.
+func (p *PartiallySignedTx) Bytes() ([]byte, error) {
+	return builder.Parser.Codec().Marshal(txs.CodecVersion, p)
+}
+
+func (p *PartiallySignedTx) GetUTXO(_ context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	for _, utxo := range p.UTXOs {
+		if utxo.ChainID == chainID && utxo.UTXO.InputID() == utxoID {
+			return utxo.UTXO, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+// Sign adds all the signatures that [kc] is able to provide.
+func (p *PartiallySignedTx) Sign(ctx context.Context, kc keychain.Keychain) error {
+	return New(kc, p).Sign(ctx, p.Tx)
+}
+
+// RequiredSigners returns the addresses whose signatures are required by the
+// transaction.
+func (p *PartiallySignedTx) RequiredSigners(ctx context.Context) (set.Set[ids.ShortID], error) {
+	kc, err := p.signers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kc.required, nil
+}
+
+// MissingSigners returns the addresses whose signatures are required by the
+// transaction but have not been provided yet.
+func (p *PartiallySignedTx) MissingSigners(ctx context.Context) (set.Set[ids.ShortID], error) {
+	kc, err := p.signers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kc.missing, nil
+}
+
+// signers performs a dry run of the signing process on a copy of the
+// transaction to determine which signatures are required and which are still
+// missing.
+func (p *PartiallySignedTx) signers(ctx context.Context) (*recordingKeychain, error) {
+	creds, err := copyCredentials(p.Tx.Creds)
+	if err != nil {
+		return nil, err
+	}
+
+	kc := &recordingKeychain{}
+	err = p.Tx.Unsigned.Visit(&visitor{
+		kc:      kc,
+		backend: p,
+		ctx:     ctx,
+		tx: &txs.Tx{
+			Unsigned: p.Tx.Unsigned,
+			Creds:    creds,
+		},
+	})
+	return kc, err
+}
+
+// Combine adds the signatures collected in [other] to this container.
+//
+// Both containers must hold the same unsigned transaction.
+func (p *PartiallySignedTx) Combine(other *PartiallySignedTx) error {
+	if !bytes.Equal(p.Tx.Unsigned.Bytes(), other.Tx.Unsigned.Bytes()) {
+		return ErrMismatchedTx
+	}
+	if len(p.Tx.Creds) != len(other.Tx.Creds) {
+		return fmt.Errorf("%w: expected %d but got %d",
+			ErrMismatchedCredentials,
+			len(p.Tx.Creds),
+			len(other.Tx.Creds),
+		)
+	}
+
+	for credIndex, fxCred := range p.Tx.Creds {
+		cred, err := credential(fxCred)
+		if err != nil {
+			return err
+		}
+		otherCred, err := credential(other.Tx.Creds[credIndex])
+		if err != nil {
+			return err
+		}
+		if len(cred.Sigs) != len(otherCred.Sigs) {
+			return fmt.Errorf("%w: credential %d expected %d signatures but got %d",
+				ErrMismatchedCredentials,
+				credIndex,
+				len(cred.Sigs),
+				len(otherCred.Sigs),
+			)
+		}
+
+		for sigIndex, otherSig := range otherCred.Sigs {
+			sig := cred.Sigs[sigIndex]
+			switch {
+			case otherSig == emptySig || sig == otherSig:
+			case sig == emptySig:
+				cred.Sigs[sigIndex] = otherSig
+			default:
+				return fmt.Errorf("%w: credential %d signature %d",
+					ErrConflictingSignatures,
+					credIndex,
+					sigIndex,
+				)
+			}
+		}
+	}
+	return p.Tx.Initialize(builder.Parser.Codec())
+}
+
+// Finalize returns the signed transaction.
+//
+// An error is returned if any signature is still missing.
+func (p *PartiallySignedTx) Finalize() (*txs.Tx, error) {
+	for credIndex, fxCred := range p.Tx.Creds {
+		cred, err := credential(fxCred)
+		if err != nil {
+			return nil, err
+		}
+		for sigIndex, sig := range cred.Sigs {
+			if sig == emptySig {
+				return nil, fmt.Errorf("%w: credential %d signature %d",
+					ErrMissingSignatures,
+					credIndex,
+					sigIndex,
+				)
+			}
+		}
+	}
+	return p.Tx, p.Tx.Initialize(builder.Parser.Codec())
+}
+
+// credential returns the secp256k1fx credential that holds the signatures of
+// [fxCred].
+func credential(fxCred *fxs.FxCredential) (*secp256k1fx.Credential, error) {
+	if fxCred == nil {
+		return nil, ErrUnknownCredentialType
+	}
+	switch cred := fxCred.Credential.(type) {
+	case *secp256k1fx.Credential:
+		return cred, nil
+	case *nftfx.Credential:
+		return &cred.Credential, nil
+	case *propertyfx.Credential:
+		return &cred.Credential, nil
+	default:
+		return nil, ErrUnknownCredentialType
+	}
+}
+
+func copyCredentials(fxCreds []*fxs.FxCredential) ([]*fxs.FxCredential, error) {
+	copied := make([]*fxs.FxCredential, len(fxCreds))
+	for i, fxCred := range fxCreds {
+		cred, err := credential(fxCred)
+		if err != nil {
+			return nil, err
+		}
+		sigs := secp256k1fx.Credential{
+			Sigs: append([][secp256k1.SignatureLen]byte(nil), cred.Sigs...),
+		}
+
+		copiedCred := &fxs.FxCredential{
+			FxID: fxCred.FxID,
+		}
+		switch fxCred.Credential.(type) {
+		case *secp256k1fx.Credential:
+			copiedCred.Credential = &sigs
+		case *nftfx.Credential:
+			copiedCred.Credential = &nftfx.Credential{Credential: sigs}
+		case *propertyfx.Credential:
+			copiedCred.Credential = &propertyfx.Credential{Credential: sigs}
+		}
+		copied[i] = copiedCred
+	}
+	return copied, nil
+}
+
+// recordingBackend records every UTXO that is requested from the underlying
+// backend.
+type recordingBackend struct {
+	backend Backend
+
+	utxos []*ChainUTXO
+}
+
+func (b *recordingBackend) GetUTXO(ctx context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, err := b.backend.GetUTXO(ctx, chainID, utxoID)
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%w: %s on chain %s", errMissingUTXO, utxoID, chainID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.utxos = append(b.utxos, &ChainUTXO{
+		ChainID: chainID,
+		UTXO:    utxo,
+	})
+	return utxo, nil
+}
+
+// recordingKeychain claims to hold every key so that the signing process
+// reports every address it needs a signature from. Signatures are only
+// requested for slots that have not been populated yet, which allows tracking
+// the missing signers.
+type recordingKeychain struct {
+	required set.Set[ids.ShortID]
+	missing  set.Set[ids.ShortID]
+}
+
+func (kc *recordingKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	kc.required.Add(addr)
+	return &recordingSigner{
+		kc:   kc,
+		addr: addr,
+	}, true
+}
+
+func (kc *recordingKeychain) Addresses() set.Set[ids.ShortID] {
+	return kc.required
+}
+
+type recordingSigner struct {
+	kc   *recordingKeychain
+	addr ids.ShortID
+}
+
+func (s *recordingSigner) SignHash([]byte) ([]byte, error) {
+	s.kc.missing.Add(s.addr)
+	return emptySig[:], nil
+}
+
+func (s *recordingSigner) Sign([]byte) ([]byte, error) {
+	s.kc.missing.Add(s.addr)
+	return emptySig[:], nil
+}
+
+func (s *recordingSigner) Address() ids.ShortID {
+	return s.addr
+}
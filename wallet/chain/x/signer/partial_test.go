@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MetalBlockchain/metalgo/database"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+)
+
+type testBackend struct {
+	utxos map[ids.ID]*avax.UTXO
+}
+
+func (b *testBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func TestPartiallySignedTx(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx       = context.Background()
+		keys      = secp256k1.TestKeys()
+		feeKey    = keys[0]
+		ownerKey0 = keys[1]
+		ownerKey1 = keys[2]
+		chainID   = ids.GenerateTestID()
+		assetID   = ids.GenerateTestID()
+		feeUTXO   = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{feeKey.Address()},
+				},
+			},
+		}
+		multisigUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 2,
+					Addrs: []ids.ShortID{
+						ownerKey0.Address(),
+						ownerKey1.Address(),
+					},
+				},
+			},
+		}
+		backend = &testBackend{
+			utxos: map[ids.ID]*avax.UTXO{
+				feeUTXO.InputID():      feeUTXO,
+				multisigUTXO.InputID(): multisigUTXO,
+			},
+		}
+		utx = &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: chainID,
+				Ins: []*avax.TransferableInput{
+					{
+						UTXOID: feeUTXO.UTXOID,
+						Asset:  feeUTXO.Asset,
+						In: &secp256k1fx.TransferInput{
+							Amt: 1,
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+						},
+					},
+					{
+						UTXOID: multisigUTXO.UTXOID,
+						Asset:  multisigUTXO.Asset,
+						In: &secp256k1fx.TransferInput{
+							Amt: 1,
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0, 1},
+							},
+						},
+					},
+				},
+			},
+		}
+	)
+
+	ptx, err := NewPartiallySignedTx(ctx, backend, utx)
+	require.NoError(err)
+	require.Len(ptx.UTXOs, 2)
+
+	required, err := ptx.RequiredSigners(ctx)
+	require.NoError(err)
+	require.Equal(
+		set.Of(feeKey.Address(), ownerKey0.Address(), ownerKey1.Address()),
+		required,
+	)
+
+	ptxBytes, err := ptx.Bytes()
+	require.NoError(err)
+
+	// Each party signs their own copy of the transaction.
+	ptx0, err := ParsePartiallySignedTx(ptxBytes)
+	require.NoError(err)
+	require.NoError(ptx0.Sign(ctx, secp256k1fx.NewKeychain(feeKey, ownerKey0)))
+
+	missing, err := ptx0.MissingSigners(ctx)
+	require.NoError(err)
+	require.Equal(set.Of(ownerKey1.Address()), missing)
+
+	_, err = ptx0.Finalize()
+	require.ErrorIs(err, ErrMissingSignatures)
+
+	ptx1, err := ParsePartiallySignedTx(ptxBytes)
+	require.NoError(err)
+	require.NoError(ptx1.Sign(ctx, secp256k1fx.NewKeychain(ownerKey1)))
+
+	require.NoError(ptx0.Combine(ptx1))
+
+	missing, err = ptx0.MissingSigners(ctx)
+	require.NoError(err)
+	require.Empty(missing)
+
+	tx, err := ptx0.Finalize()
+	require.NoError(err)
+
+	// The combined transaction must match the transaction signed by a single
+	// party holding all the keys.
+	expectedTx, err := SignUnsigned(
+		ctx,
+		New(secp256k1fx.NewKeychain(feeKey, ownerKey0, ownerKey1), backend),
+		utx,
+	)
+	require.NoError(err)
+	require.Equal(expectedTx.Bytes(), tx.Bytes())
+}
+
+func TestPartiallySignedTxCombineMismatchedTx(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx     = context.Background()
+		backend = &testBackend{}
+		chainID = ids.GenerateTestID()
+	)
+	ptx0, err := NewPartiallySignedTx(ctx, backend, &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: chainID,
+		},
+	})
+	require.NoError(err)
+
+	ptx1, err := NewPartiallySignedTx(ctx, backend, &txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: chainID,
+			Memo:         []byte{1},
+		},
+	})
+	require.NoError(err)
+
+	err = ptx0.Combine(ptx1)
+	require.ErrorIs(err, ErrMismatchedTx)
+}
@@ -84,6 +84,33 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueOperationTxMintNFTGroup creates, signs, and issues a state change
+	// that mints new NFTs in a specific group of the requested asset.
+	//
+	// - [assetID] specifies the asset to mint the NFTs under.
+	// - [groupID] specifies the group of the asset to mint the NFTs in.
+	// - [payload] specifies the payload to provide each new NFT.
+	// - [owners] specifies the new owners of each NFT.
+	IssueOperationTxMintNFTGroup(
+		assetID ids.ID,
+		groupID uint32,
+		payload []byte,
+		owners []*secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueOperationTxTransferNFT creates, signs, and issues a state change
+	// that transfers an NFT to a new owner.
+	//
+	// - [utxoID] specifies the UTXO holding the NFT, as returned by
+	//   avm.getNFTs.
+	// - [owner] specifies the new owner of the NFT.
+	IssueOperationTxTransferNFT(
+		utxoID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueOperationTxMintProperty creates, signs, and issues a state change
 	// that mints a new property for the requested asset.
 	//
@@ -228,6 +255,32 @@ func (w *wallet) IssueOperationTxMintNFT(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueOperationTxMintNFTGroup(
+	assetID ids.ID,
+	groupID uint32,
+	payload []byte,
+	owners []*secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewOperationTxMintNFTGroup(assetID, groupID, payload, owners, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueOperationTxTransferNFT(
+	utxoID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewOperationTxTransferNFT(utxoID, owner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueOperationTxMintProperty(
 	assetID ids.ID,
 	owner *secp256k1fx.OutputOwners,
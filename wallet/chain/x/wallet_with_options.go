@@ -102,6 +102,34 @@ func (w *walletWithOptions) IssueOperationTxMintNFT(
 	)
 }
 
+func (w *walletWithOptions) IssueOperationTxMintNFTGroup(
+	assetID ids.ID,
+	groupID uint32,
+	payload []byte,
+	owners []*secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueOperationTxMintNFTGroup(
+		assetID,
+		groupID,
+		payload,
+		owners,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *walletWithOptions) IssueOperationTxTransferNFT(
+	utxoID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueOperationTxTransferNFT(
+		utxoID,
+		owner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueOperationTxMintProperty(
 	assetID ids.ID,
 	owner *secp256k1fx.OutputOwners,
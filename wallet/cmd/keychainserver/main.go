@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// keychainserver is a reference implementation of a remote signing service
+// that can be used as a keychain through rpckeychain.Client. The keys are
+// stored encrypted on disk and are only decrypted in memory while serving.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain/rpckeychain"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+
+	pb "github.com/MetalBlockchain/metalgo/proto/pb/keychain"
+)
+
+var errEmptyPassword = errors.New("password file is empty")
+
+func main() {
+	var passwordFile string
+	rootCmd := &cobra.Command{
+		Use:          "keychainserver",
+		Short:        "Serves secp256k1 keys held in an encrypted file over gRPC",
+		SilenceUsage: true,
+	}
+	rootCmd.PersistentFlags().StringVar(&passwordFile, "password-file", "", "File containing the password the keys are encrypted with")
+
+	rootCmd.AddCommand(
+		encryptCommand(&passwordFile),
+		serveCommand(&passwordFile),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "command failed %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func encryptCommand(passwordFile *string) *cobra.Command {
+	var in, out string
+	c := &cobra.Command{
+		Use:   "encrypt",
+		Short: "Encrypts the \"PrivateKey-\" prefixed keys, one per line, of a plaintext file",
+		Args:  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			password, err := readPassword(*passwordFile)
+			if err != nil {
+				return err
+			}
+			keys, err := rpckeychain.ReadKeyFile(in)
+			if err != nil {
+				return err
+			}
+			b, err := rpckeychain.EncryptKeys(password, keys)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(out, b, 0o600)
+		},
+	}
+	flags := c.Flags()
+	flags.StringVar(&in, "in", "", "Plaintext file to read the keys from")
+	flags.StringVar(&out, "out", "", "File to write the encrypted keys to")
+	return c
+}
+
+func serveCommand(passwordFile *string) *cobra.Command {
+	var keyFile, listenAddr string
+	c := &cobra.Command{
+		Use:   "serve",
+		Short: "Serves the keys of an encrypted key file",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			password, err := readPassword(*passwordFile)
+			if err != nil {
+				return err
+			}
+			b, err := os.ReadFile(keyFile)
+			if err != nil {
+				return err
+			}
+			keys, err := rpckeychain.DecryptKeys(password, b)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+
+			kc := secp256k1fx.NewKeychain(keys...)
+			server := grpc.NewServer()
+			pb.RegisterKeychainServer(server, rpckeychain.NewServer(kc))
+
+			go func() {
+				<-c.Context().Done()
+				server.GracefulStop()
+			}()
+
+			for _, addr := range kc.Addresses().List() {
+				fmt.Fprintf(os.Stderr, "serving address %s\n", addr)
+			}
+			fmt.Fprintf(os.Stderr, "listening on %s\n", listener.Addr())
+			return server.Serve(listener)
+		},
+	}
+	flags := c.Flags()
+	flags.StringVar(&keyFile, "key-file", "", "Encrypted key file produced by the encrypt command")
+	flags.StringVar(&listenAddr, "listen", "127.0.0.1:9660", "Address to listen for gRPC requests on")
+	return c
+}
+
+func readPassword(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	password := strings.TrimRight(string(b), "\r\n")
+	if password == "" {
+		return "", errEmptyPassword
+	}
+	return password, nil
+}
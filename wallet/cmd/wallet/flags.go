@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/formatting/address"
+	"github.com/MetalBlockchain/metalgo/vms/components/avax"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary"
+)
+
+const (
+	uriKey            = "uri"
+	keyFileKey        = "key-file"
+	ledgerKey         = "ledger"
+	ledgerNumAddrsKey = "ledger-num-addresses"
+	remoteKeychainKey = "remote-keychain"
+	addressKey        = "address"
+	subnetIDKey       = "owner-subnet-id"
+	validationIDKey   = "owner-validation-id"
+	partialKey        = "partial"
+	memoKey           = "memo"
+)
+
+var (
+	errNoOwnerAddresses = errors.New("no owner addresses provided")
+	errInvalidThreshold = errors.New("threshold exceeds the number of owner addresses")
+)
+
+// config contains the flags shared by all the commands.
+type config struct {
+	uri            string
+	keyFiles       []string
+	ledger         bool
+	ledgerNumAddrs int
+	remoteKeychain string
+	addresses      []string
+	subnetIDs      []string
+	validationIDs  []string
+	partial        string
+	memo           string
+}
+
+func addGlobalFlags(flags *pflag.FlagSet, cfg *config) {
+	flags.StringVar(&cfg.uri, uriKey, primary.LocalAPIURI, "API URI of the node to use")
+	flags.StringSliceVar(&cfg.keyFiles, keyFileKey, nil, "Files containing private keys, one per line, to sign with")
+	flags.BoolVar(&cfg.ledger, ledgerKey, false, "Sign with a connected ledger device")
+	flags.IntVar(&cfg.ledgerNumAddrs, ledgerNumAddrsKey, 1, "Number of addresses to derive from the ledger device")
+	flags.StringVar(&cfg.remoteKeychain, remoteKeychainKey, "", "URL of a remote keychain service to sign with")
+	flags.StringSliceVar(&cfg.addresses, addressKey, nil, "Additional addresses to spend from without holding their keys")
+	flags.StringSliceVar(&cfg.subnetIDs, subnetIDKey, nil, "Subnets whose owners should be fetched to authorize transactions")
+	flags.StringSliceVar(&cfg.validationIDs, validationIDKey, nil, "L1 validators whose owners should be fetched to authorize transactions")
+	flags.StringVar(&cfg.partial, partialKey, "", "If provided, write a partially signed transaction to this file rather than issuing it")
+	flags.StringVar(&cfg.memo, memoKey, "", "Memo to include in the transaction")
+}
+
+// ownerFlags describes an [secp256k1fx.OutputOwners].
+type ownerFlags struct {
+	prefix    string
+	addrs     []string
+	threshold uint32
+	locktime  uint64
+}
+
+func (o *ownerFlags) addFlags(flags *pflag.FlagSet, prefix string, description string) {
+	o.prefix = prefix
+	flags.StringSliceVar(&o.addrs, prefix+"-addresses", nil, "Addresses of the "+description)
+	flags.Uint32Var(&o.threshold, prefix+"-threshold", 1, "Number of signatures required by the "+description)
+	flags.Uint64Var(&o.locktime, prefix+"-locktime", 0, "Unix timestamp before which the "+description+" can not spend")
+}
+
+func (o *ownerFlags) owner() (*secp256k1fx.OutputOwners, error) {
+	if len(o.addrs) == 0 {
+		return nil, fmt.Errorf("%w: --%s-addresses", errNoOwnerAddresses, o.prefix)
+	}
+	addrs, err := parseAddresses(o.addrs)
+	if err != nil {
+		return nil, err
+	}
+	if int(o.threshold) > len(addrs) {
+		return nil, fmt.Errorf("%w: --%s-threshold", errInvalidThreshold, o.prefix)
+	}
+	owner := &secp256k1fx.OutputOwners{
+		Locktime:  o.locktime,
+		Threshold: o.threshold,
+		Addrs:     addrs,
+	}
+	owner.Sort()
+	return owner, nil
+}
+
+// outputFlags describes a single [avax.TransferableOutput].
+type outputFlags struct {
+	owner   ownerFlags
+	amount  uint64
+	assetID string
+}
+
+func (o *outputFlags) addFlags(flags *pflag.FlagSet) {
+	o.owner.addFlags(flags, "to", "recipient")
+	flags.Uint64Var(&o.amount, "amount", 0, "Amount to send")
+	flags.StringVar(&o.assetID, "asset-id", "", "Asset to send, defaults to the native asset")
+}
+
+func (o *outputFlags) outputs(defaultAssetID ids.ID) ([]*avax.TransferableOutput, error) {
+	owner, err := o.owner.owner()
+	if err != nil {
+		return nil, err
+	}
+	assetID := defaultAssetID
+	if o.assetID != "" {
+		assetID, err = ids.FromString(o.assetID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          o.amount,
+			OutputOwners: *owner,
+		},
+	}}, nil
+}
+
+// parseAddress accepts both bech32 formatted addresses, such as
+// "P-metal1...", and raw short IDs.
+func parseAddress(addrStr string) (ids.ShortID, error) {
+	addr, err := address.ParseToID(addrStr)
+	if err == nil {
+		return addr, nil
+	}
+	addr, shortErr := ids.ShortFromString(addrStr)
+	if shortErr != nil {
+		return ids.ShortEmpty, fmt.Errorf("couldn't parse address %q: %w", addrStr, err)
+	}
+	return addr, nil
+}
+
+func parseAddresses(addrStrs []string) ([]ids.ShortID, error) {
+	addrs := make([]ids.ShortID, len(addrStrs))
+	for i, addrStr := range addrStrs {
+		addr, err := parseAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+func parseIDs(idStrs []string) ([]ids.ID, error) {
+	parsed := make([]ids.ID, len(idStrs))
+	for i, idStr := range idStrs {
+		id, err := ids.FromString(idStr)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = id
+	}
+	return parsed, nil
+}
+
+// validatorFlags describes a [txs.Validator].
+type validatorFlags struct {
+	nodeID    string
+	startTime uint64
+	endTime   uint64
+	weight    uint64
+}
+
+func (v *validatorFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&v.nodeID, "node-id", "", "NodeID of the validator")
+	flags.Uint64Var(&v.startTime, "start-time", 0, "Unix timestamp the validation period starts at")
+	flags.Uint64Var(&v.endTime, "end-time", 0, "Unix timestamp the validation period ends at")
+	flags.Uint64Var(&v.weight, "weight", 0, "Weight of the validator")
+}
+
+func (v *validatorFlags) validator() (*txs.Validator, error) {
+	nodeID, err := ids.NodeIDFromString(v.nodeID)
+	if err != nil {
+		return nil, err
+	}
+	return &txs.Validator{
+		NodeID: nodeID,
+		Start:  v.startTime,
+		End:    v.endTime,
+		Wght:   v.weight,
+	}, nil
+}
+
+func (v *validatorFlags) subnetValidator(subnetIDStr string) (*txs.SubnetValidator, error) {
+	validator, err := v.validator()
+	if err != nil {
+		return nil, err
+	}
+	subnetID, err := ids.FromString(subnetIDStr)
+	if err != nil {
+		return nil, err
+	}
+	return &txs.SubnetValidator{
+		Validator: *validator,
+		Subnet:    subnetID,
+	}, nil
+}
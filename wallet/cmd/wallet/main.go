@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cobra.EnablePrefixMatching = true
+}
+
+func main() {
+	cfg := &config{}
+	rootCmd := &cobra.Command{
+		Use:          "wallet",
+		Short:        "Issues and signs P-Chain and X-Chain transactions",
+		SilenceUsage: true,
+	}
+	addGlobalFlags(rootCmd.PersistentFlags(), cfg)
+
+	rootCmd.AddCommand(
+		pCommand(cfg),
+		xCommand(cfg),
+		partialCommand(cfg),
+	)
+
+	ctx := context.Background()
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "command failed %v\n", err)
+		os.Exit(1)
+	}
+}
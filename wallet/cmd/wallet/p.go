@@ -0,0 +1,708 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/bls"
+	"github.com/MetalBlockchain/metalgo/utils/formatting"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/reward"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/signer"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+
+	stdjson "encoding/json"
+)
+
+var (
+	errInvalidProofOfPossession = errors.New("invalid proof of possession")
+	errUnknownRewardCurve       = errors.New("unknown reward curve")
+	errInvalidRewardStep        = errors.New("invalid reward step")
+)
+
+func pCommand(cfg *config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "p",
+		Short: "Issues P-Chain transactions",
+	}
+	c.AddCommand(
+		pBalanceCommand(cfg),
+		pUTXOsCommand(cfg),
+		pBaseTxCommand(cfg),
+		pAddValidatorTxCommand(cfg),
+		pAddSubnetValidatorTxCommand(cfg),
+		pRemoveSubnetValidatorTxCommand(cfg),
+		pAddDelegatorTxCommand(cfg),
+		pCreateChainTxCommand(cfg),
+		pCreateSubnetTxCommand(cfg),
+		pTransferSubnetOwnershipTxCommand(cfg),
+		pConvertSubnetToL1TxCommand(cfg),
+		pRegisterL1ValidatorTxCommand(cfg),
+		pSetL1ValidatorWeightTxCommand(cfg),
+		pIncreaseL1ValidatorBalanceTxCommand(cfg),
+		pDisableL1ValidatorTxCommand(cfg),
+		pSetSubnetRewardCurveTxCommand(cfg),
+		pImportTxCommand(cfg),
+		pExportTxCommand(cfg),
+		pTransformSubnetTxCommand(cfg),
+		pAddPermissionlessValidatorTxCommand(cfg),
+		pAddPermissionlessDelegatorTxCommand(cfg),
+	)
+	return c
+}
+
+// pTxCommand returns a command that issues the transaction returned by
+// [build].
+func pTxCommand(
+	cfg *config,
+	use string,
+	short string,
+	build func(ctx context.Context, w *wallet) (txs.UnsignedTx, error),
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			utx, err := build(ctx, w)
+			if err != nil {
+				return err
+			}
+			return w.issueP(ctx, utx)
+		},
+	}
+}
+
+func pBalanceCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance",
+		Short: "Prints the spendable balance of each asset",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			balances, err := w.pBuilder.GetBalance(w.options(ctx)...)
+			if err != nil {
+				return err
+			}
+			return printJSON(balances)
+		},
+	}
+}
+
+func pUTXOsCommand(cfg *config) *cobra.Command {
+	var sourceChain string
+	c := &cobra.Command{
+		Use:   "utxos",
+		Short: "Prints the UTXOs of the wallet",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			sourceChainID, err := w.chainID(sourceChain)
+			if err != nil {
+				return err
+			}
+			utxos, err := w.state.UTXOs.UTXOs(ctx, sourceChainID, constants.PlatformChainID)
+			if err != nil {
+				return err
+			}
+			return printJSON(utxos)
+		},
+	}
+	c.Flags().StringVar(&sourceChain, "source-chain", "P", "Chain the UTXOs were exported from")
+	return c
+}
+
+func pBaseTxCommand(cfg *config) *cobra.Command {
+	var out outputFlags
+	c := pTxCommand(cfg, "base", "Sends funds", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		outputs, err := out.outputs(w.pBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewBaseTx(outputs, w.options(ctx)...)
+	})
+	out.addFlags(c.Flags())
+	return c
+}
+
+func pAddValidatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		vdr          validatorFlags
+		rewardsOwner ownerFlags
+		shares       uint32
+	)
+	c := pTxCommand(cfg, "add-validator", "Adds a primary network validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validator, err := vdr.validator()
+		if err != nil {
+			return nil, err
+		}
+		owner, err := rewardsOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewAddValidatorTx(validator, owner, shares, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	vdr.addFlags(flags)
+	rewardsOwner.addFlags(flags, "rewards", "rewards owner")
+	flags.Uint32Var(&shares, "delegation-fee", 20_000, "Fraction, out of 1,000,000, of the delegation rewards taken by the validator")
+	return c
+}
+
+func pAddSubnetValidatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		vdr      validatorFlags
+		subnetID string
+	)
+	c := pTxCommand(cfg, "add-subnet-validator", "Adds a permissioned subnet validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validator, err := vdr.subnetValidator(subnetID)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewAddSubnetValidatorTx(validator, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	vdr.addFlags(flags)
+	flags.StringVar(&subnetID, "subnet-id", "", "Subnet to validate")
+	return c
+}
+
+func pRemoveSubnetValidatorTxCommand(cfg *config) *cobra.Command {
+	var nodeIDStr, subnetIDStr string
+	c := pTxCommand(cfg, "remove-subnet-validator", "Removes a permissioned subnet validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		nodeID, err := ids.NodeIDFromString(nodeIDStr)
+		if err != nil {
+			return nil, err
+		}
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewRemoveSubnetValidatorTx(nodeID, subnetID, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&nodeIDStr, "node-id", "", "Validator to remove")
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to remove the validator from")
+	return c
+}
+
+func pAddDelegatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		vdr          validatorFlags
+		rewardsOwner ownerFlags
+	)
+	c := pTxCommand(cfg, "add-delegator", "Adds a primary network delegator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validator, err := vdr.validator()
+		if err != nil {
+			return nil, err
+		}
+		owner, err := rewardsOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewAddDelegatorTx(validator, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	vdr.addFlags(flags)
+	rewardsOwner.addFlags(flags, "rewards", "rewards owner")
+	return c
+}
+
+func pCreateChainTxCommand(cfg *config) *cobra.Command {
+	var (
+		subnetIDStr string
+		genesisFile string
+		vmIDStr     string
+		fxIDStrs    []string
+		chainName   string
+	)
+	c := pTxCommand(cfg, "create-chain", "Creates a chain on a subnet", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		genesis, err := os.ReadFile(genesisFile)
+		if err != nil {
+			return nil, err
+		}
+		vmID, err := ids.FromString(vmIDStr)
+		if err != nil {
+			return nil, err
+		}
+		fxIDs, err := parseIDs(fxIDStrs)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewCreateChainTx(subnetID, genesis, vmID, fxIDs, chainName, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to create the chain on")
+	flags.StringVar(&genesisFile, "genesis-file", "", "File containing the genesis of the chain")
+	flags.StringVar(&vmIDStr, "vm-id", "", "VM the chain runs")
+	flags.StringSliceVar(&fxIDStrs, "fx-ids", nil, "Feature extensions the VM runs with")
+	flags.StringVar(&chainName, "chain-name", "", "Human readable name of the chain")
+	return c
+}
+
+func pCreateSubnetTxCommand(cfg *config) *cobra.Command {
+	var subnetOwner ownerFlags
+	c := pTxCommand(cfg, "create-subnet", "Creates a subnet", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		owner, err := subnetOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewCreateSubnetTx(owner, w.options(ctx)...)
+	})
+	subnetOwner.addFlags(c.Flags(), "owner", "subnet owner")
+	return c
+}
+
+func pTransferSubnetOwnershipTxCommand(cfg *config) *cobra.Command {
+	var (
+		subnetIDStr string
+		subnetOwner ownerFlags
+	)
+	c := pTxCommand(cfg, "transfer-subnet-ownership", "Changes the owner of a subnet", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := subnetOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewTransferSubnetOwnershipTx(subnetID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to transfer")
+	subnetOwner.addFlags(flags, "owner", "new subnet owner")
+	return c
+}
+
+func pConvertSubnetToL1TxCommand(cfg *config) *cobra.Command {
+	var (
+		subnetIDStr    string
+		chainIDStr     string
+		addressStr     string
+		validatorsFile string
+	)
+	c := pTxCommand(cfg, "convert-subnet-to-l1", "Converts a subnet to an L1", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		chainID, err := ids.FromString(chainIDStr)
+		if err != nil {
+			return nil, err
+		}
+		address, err := formatting.Decode(formatting.HexNC, addressStr)
+		if err != nil {
+			return nil, err
+		}
+		validatorsJSON, err := os.ReadFile(validatorsFile)
+		if err != nil {
+			return nil, err
+		}
+		var validators []*txs.ConvertSubnetToL1Validator
+		if err := stdjson.Unmarshal(validatorsJSON, &validators); err != nil {
+			return nil, fmt.Errorf("failed to parse validators: %w", err)
+		}
+		return w.pBuilder.NewConvertSubnetToL1Tx(subnetID, chainID, address, validators, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to convert")
+	flags.StringVar(&chainIDStr, "chain-id", "", "Chain the validator manager is deployed on")
+	flags.StringVar(&addressStr, "manager-address", "", "Hex encoded address of the validator manager")
+	flags.StringVar(&validatorsFile, "validators-file", "", "JSON file containing the initial validators of the L1")
+	return c
+}
+
+func pRegisterL1ValidatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		balance    uint64
+		popStr     string
+		messageStr string
+	)
+	c := pTxCommand(cfg, "register-l1-validator", "Adds a validator to an L1", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		popBytes, err := formatting.Decode(formatting.HexNC, popStr)
+		if err != nil {
+			return nil, err
+		}
+		if len(popBytes) != bls.SignatureLen {
+			return nil, fmt.Errorf("%w: expected %d bytes but got %d", errInvalidProofOfPossession, bls.SignatureLen, len(popBytes))
+		}
+		message, err := formatting.Decode(formatting.HexNC, messageStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewRegisterL1ValidatorTx(balance, [bls.SignatureLen]byte(popBytes), message, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.Uint64Var(&balance, "balance", 0, "Balance allocated to the continuous fee of the validator")
+	flags.StringVar(&popStr, "proof-of-possession", "", "Hex encoded BLS proof of possession of the validator")
+	flags.StringVar(&messageStr, "message", "", "Hex encoded signed warp message authorizing the validator")
+	return c
+}
+
+func pSetL1ValidatorWeightTxCommand(cfg *config) *cobra.Command {
+	var messageStr string
+	c := pTxCommand(cfg, "set-l1-validator-weight", "Sets the weight of an L1 validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		message, err := formatting.Decode(formatting.HexNC, messageStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewSetL1ValidatorWeightTx(message, w.options(ctx)...)
+	})
+	c.Flags().StringVar(&messageStr, "message", "", "Hex encoded signed warp message authorizing the weight change")
+	return c
+}
+
+func pIncreaseL1ValidatorBalanceTxCommand(cfg *config) *cobra.Command {
+	var (
+		validationIDStr string
+		balance         uint64
+	)
+	c := pTxCommand(cfg, "increase-l1-validator-balance", "Increases the balance of an L1 validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validationID, err := ids.FromString(validationIDStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewIncreaseL1ValidatorBalanceTx(validationID, balance, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&validationIDStr, "validation-id", "", "Validator to top up")
+	flags.Uint64Var(&balance, "balance", 0, "Amount to increase the balance by")
+	return c
+}
+
+func pDisableL1ValidatorTxCommand(cfg *config) *cobra.Command {
+	var validationIDStr string
+	c := pTxCommand(cfg, "disable-l1-validator", "Disables an L1 validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validationID, err := ids.FromString(validationIDStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewDisableL1ValidatorTx(validationID, w.options(ctx)...)
+	})
+	c.Flags().StringVar(&validationIDStr, "validation-id", "", "Validator to disable")
+	return c
+}
+
+func pSetSubnetRewardCurveTxCommand(cfg *config) *cobra.Command {
+	var (
+		subnetIDStr        string
+		curveName          string
+		rate               uint64
+		stepStrs           []string
+		minConsumptionRate uint64
+		maxConsumptionRate uint64
+		mintingPeriod      time.Duration
+	)
+	c := pTxCommand(cfg, "set-subnet-reward-curve", "Sets the reward curve of a permissionless subnet", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+
+		var curve reward.Curve
+		switch curveName {
+		case "consumption-rate":
+			curve = &reward.ConsumptionRateCurve{
+				MaxConsumptionRate: maxConsumptionRate,
+				MinConsumptionRate: minConsumptionRate,
+				MintingPeriod:      uint64(mintingPeriod / time.Second),
+			}
+		case "fixed-apr":
+			curve = &reward.FixedAPRCurve{
+				Rate: rate,
+			}
+		case "stepwise":
+			steps, err := parseRewardSteps(stepStrs)
+			if err != nil {
+				return nil, err
+			}
+			curve = &reward.StepwiseCurve{
+				Steps: steps,
+			}
+		default:
+			return nil, fmt.Errorf("%w: %q", errUnknownRewardCurve, curveName)
+		}
+		return w.pBuilder.NewSetSubnetRewardCurveTx(subnetID, curve, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to modify")
+	flags.StringVar(&curveName, "curve", "fixed-apr", "Reward curve, one of consumption-rate, fixed-apr or stepwise")
+	flags.Uint64Var(&rate, "rate", 0, "Annual reward rate, out of 1,000,000, of the fixed-apr curve")
+	flags.StringSliceVar(&stepStrs, "steps", nil, "Steps of the stepwise curve formatted as <min stake duration>=<annual rate out of 1,000,000>")
+	flags.Uint64Var(&minConsumptionRate, "min-consumption-rate", 0, "Reward rate of a staker staking for no time, of the consumption-rate curve")
+	flags.Uint64Var(&maxConsumptionRate, "max-consumption-rate", 0, "Reward rate of a staker staking for the minting period, of the consumption-rate curve")
+	flags.DurationVar(&mintingPeriod, "minting-period", 365*24*time.Hour, "Minting period of the consumption-rate curve")
+	return c
+}
+
+// parseRewardSteps parses steps formatted as <min stake duration>=<rate>, such
+// as 720h=80000.
+func parseRewardSteps(stepStrs []string) ([]reward.Step, error) {
+	steps := make([]reward.Step, len(stepStrs))
+	for i, stepStr := range stepStrs {
+		durationStr, rateStr, ok := strings.Cut(stepStr, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errInvalidRewardStep, stepStr)
+		}
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", errInvalidRewardStep, stepStr, err)
+		}
+		rate, err := strconv.ParseUint(rateStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", errInvalidRewardStep, stepStr, err)
+		}
+		steps[i] = reward.Step{
+			MinDuration: uint64(duration / time.Second),
+			Rate:        rate,
+		}
+	}
+	return steps, nil
+}
+
+func pImportTxCommand(cfg *config) *cobra.Command {
+	var (
+		sourceChain string
+		to          ownerFlags
+	)
+	c := pTxCommand(cfg, "import", "Imports funds from another chain", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		sourceChainID, err := w.chainID(sourceChain)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewImportTx(sourceChainID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&sourceChain, "source-chain", "X", "Chain to import the funds from")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func pExportTxCommand(cfg *config) *cobra.Command {
+	var (
+		destinationChain string
+		out              outputFlags
+	)
+	c := pTxCommand(cfg, "export", "Exports funds to another chain", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		destinationChainID, err := w.chainID(destinationChain)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := out.outputs(w.pBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewExportTx(destinationChainID, outputs, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&destinationChain, "destination-chain", "X", "Chain to export the funds to")
+	out.addFlags(flags)
+	return c
+}
+
+func pTransformSubnetTxCommand(cfg *config) *cobra.Command {
+	var (
+		subnetIDStr              string
+		assetIDStr               string
+		initialSupply            uint64
+		maxSupply                uint64
+		minConsumptionRate       uint64
+		maxConsumptionRate       uint64
+		minValidatorStake        uint64
+		maxValidatorStake        uint64
+		minStakeDuration         time.Duration
+		maxStakeDuration         time.Duration
+		minDelegationFee         uint32
+		minDelegatorStake        uint64
+		maxValidatorWeightFactor uint8
+		uptimeRequirement        uint32
+	)
+	c := pTxCommand(cfg, "transform-subnet", "Converts a permissioned subnet into a permissionless subnet", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		subnetID, err := ids.FromString(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewTransformSubnetTx(
+			subnetID,
+			assetID,
+			initialSupply,
+			maxSupply,
+			minConsumptionRate,
+			maxConsumptionRate,
+			minValidatorStake,
+			maxValidatorStake,
+			minStakeDuration,
+			maxStakeDuration,
+			minDelegationFee,
+			minDelegatorStake,
+			maxValidatorWeightFactor,
+			uptimeRequirement,
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	flags.StringVar(&subnetIDStr, "subnet-id", "", "Subnet to transform")
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset used to reward stakers")
+	flags.Uint64Var(&initialSupply, "initial-supply", 0, "Amount of the asset in circulation")
+	flags.Uint64Var(&maxSupply, "max-supply", 0, "Maximum amount of the asset that will ever exist")
+	flags.Uint64Var(&minConsumptionRate, "min-consumption-rate", 0, "Reward rate of a staker staking for the minimum duration")
+	flags.Uint64Var(&maxConsumptionRate, "max-consumption-rate", 0, "Reward rate of a staker staking for the maximum duration")
+	flags.Uint64Var(&minValidatorStake, "min-validator-stake", 0, "Minimum stake of a validator")
+	flags.Uint64Var(&maxValidatorStake, "max-validator-stake", 0, "Maximum stake of a validator, including delegations")
+	flags.DurationVar(&minStakeDuration, "min-stake-duration", 0, "Minimum staking duration")
+	flags.DurationVar(&maxStakeDuration, "max-stake-duration", 0, "Maximum staking duration")
+	flags.Uint32Var(&minDelegationFee, "min-delegation-fee", 0, "Minimum delegation fee, out of 1,000,000")
+	flags.Uint64Var(&minDelegatorStake, "min-delegator-stake", 0, "Minimum stake of a delegator")
+	flags.Uint8Var(&maxValidatorWeightFactor, "max-validator-weight-factor", 1, "Factor bounding the delegations a validator can receive")
+	flags.Uint32Var(&uptimeRequirement, "uptime-requirement", 0, "Uptime, out of 1,000,000, required to be rewarded")
+	return c
+}
+
+func pAddPermissionlessValidatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		vdr                    validatorFlags
+		subnetIDStr            string
+		assetIDStr             string
+		blsPublicKeyStr        string
+		blsPopStr              string
+		validationRewardsOwner ownerFlags
+		delegationRewardsOwner ownerFlags
+		shares                 uint32
+	)
+	c := pTxCommand(cfg, "add-permissionless-validator", "Adds a permissionless validator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validator, err := vdr.subnetValidator(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := w.assetID(assetIDStr, w.pBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+
+		var vdrSigner signer.Signer = &signer.Empty{}
+		if validator.Subnet == constants.PrimaryNetworkID {
+			vdrSigner, err = parseProofOfPossession(blsPublicKeyStr, blsPopStr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		validationOwner, err := validationRewardsOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		delegationOwner, err := delegationRewardsOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewAddPermissionlessValidatorTx(
+			validator,
+			vdrSigner,
+			assetID,
+			validationOwner,
+			delegationOwner,
+			shares,
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	vdr.addFlags(flags)
+	flags.StringVar(&subnetIDStr, "subnet-id", constants.PrimaryNetworkID.String(), "Subnet to validate")
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to stake, defaults to the native asset")
+	flags.StringVar(&blsPublicKeyStr, "bls-public-key", "", "Hex encoded BLS public key of the validator")
+	flags.StringVar(&blsPopStr, "bls-proof-of-possession", "", "Hex encoded BLS proof of possession of the validator")
+	validationRewardsOwner.addFlags(flags, "validation-rewards", "validation rewards owner")
+	delegationRewardsOwner.addFlags(flags, "delegation-rewards", "delegation rewards owner")
+	flags.Uint32Var(&shares, "delegation-fee", 20_000, "Fraction, out of 1,000,000, of the delegation rewards taken by the validator")
+	return c
+}
+
+func pAddPermissionlessDelegatorTxCommand(cfg *config) *cobra.Command {
+	var (
+		vdr          validatorFlags
+		subnetIDStr  string
+		assetIDStr   string
+		rewardsOwner ownerFlags
+	)
+	c := pTxCommand(cfg, "add-permissionless-delegator", "Adds a permissionless delegator", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		validator, err := vdr.subnetValidator(subnetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := w.assetID(assetIDStr, w.pBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := rewardsOwner.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.pBuilder.NewAddPermissionlessDelegatorTx(validator, assetID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	vdr.addFlags(flags)
+	flags.StringVar(&subnetIDStr, "subnet-id", constants.PrimaryNetworkID.String(), "Subnet to delegate on")
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to stake, defaults to the native asset")
+	rewardsOwner.addFlags(flags, "rewards", "rewards owner")
+	return c
+}
+
+func parseProofOfPossession(publicKeyStr, popStr string) (*signer.ProofOfPossession, error) {
+	publicKey, err := formatting.Decode(formatting.HexNC, publicKeyStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKey) != bls.PublicKeyLen {
+		return nil, fmt.Errorf("%w: expected %d byte public key but got %d", errInvalidProofOfPossession, bls.PublicKeyLen, len(publicKey))
+	}
+	pop, err := formatting.Decode(formatting.HexNC, popStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(pop) != bls.SignatureLen {
+		return nil, fmt.Errorf("%w: expected %d byte signature but got %d", errInvalidProofOfPossession, bls.SignatureLen, len(pop))
+	}
+
+	proofOfPossession := &signer.ProofOfPossession{
+		PublicKey:         [bls.PublicKeyLen]byte(publicKey),
+		ProofOfPossession: [bls.SignatureLen]byte(pop),
+	}
+	return proofOfPossession, proofOfPossession.Verify()
+}
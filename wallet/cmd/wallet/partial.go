@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MetalBlockchain/metalgo/api/info"
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/hashing"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/avm"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/p"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/x"
+	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary/common"
+
+	psigner "github.com/MetalBlockchain/metalgo/wallet/chain/p/signer"
+	pwallet "github.com/MetalBlockchain/metalgo/wallet/chain/p/wallet"
+	xsigner "github.com/MetalBlockchain/metalgo/wallet/chain/x/signer"
+)
+
+const (
+	chainKey = "chain"
+	outKey   = "out"
+)
+
+var errUnknownChain = errors.New("unknown chain")
+
+// partialTx is the summary printed after a partially signed tx is written.
+type partialTx struct {
+	File            string        `json:"file"`
+	UnsignedTxID    ids.ID        `json:"unsignedTxID"`
+	RequiredSigners []ids.ShortID `json:"requiredSigners"`
+	MissingSigners  []ids.ShortID `json:"missingSigners"`
+}
+
+func partialCommand(cfg *config) *cobra.Command {
+	var chain string
+	c := &cobra.Command{
+		Use:   "partial",
+		Short: "Signs, combines and issues partially signed transactions",
+	}
+	c.PersistentFlags().StringVar(&chain, chainKey, "P", "Chain of the partially signed transactions, either P or X")
+
+	signCmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Adds the signatures of the provided keys to a partially signed transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := c.Context()
+			kc, err := newKeychain(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			path := args[0]
+			switch chain {
+			case "P":
+				ptx, err := readPartialP(path)
+				if err != nil {
+					return err
+				}
+				if err := ptx.Sign(ctx, kc); err != nil {
+					return err
+				}
+				return writePartialP(ctx, path, ptx)
+			case "X":
+				ptx, err := readPartialX(path)
+				if err != nil {
+					return err
+				}
+				if err := ptx.Sign(ctx, kc); err != nil {
+					return err
+				}
+				return writePartialX(ctx, path, ptx)
+			default:
+				return fmt.Errorf("%w: %q", errUnknownChain, chain)
+			}
+		},
+	}
+
+	var out string
+	combineCmd := &cobra.Command{
+		Use:   "combine <file>...",
+		Short: "Combines the signatures of multiple copies of a partially signed transaction",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := c.Context()
+			switch chain {
+			case "P":
+				ptx, err := readPartialP(args[0])
+				if err != nil {
+					return err
+				}
+				for _, path := range args[1:] {
+					other, err := readPartialP(path)
+					if err != nil {
+						return err
+					}
+					if err := ptx.Combine(other); err != nil {
+						return fmt.Errorf("failed to combine %q: %w", path, err)
+					}
+				}
+				return writePartialP(ctx, out, ptx)
+			case "X":
+				ptx, err := readPartialX(args[0])
+				if err != nil {
+					return err
+				}
+				for _, path := range args[1:] {
+					other, err := readPartialX(path)
+					if err != nil {
+						return err
+					}
+					if err := ptx.Combine(other); err != nil {
+						return fmt.Errorf("failed to combine %q: %w", path, err)
+					}
+				}
+				return writePartialX(ctx, out, ptx)
+			default:
+				return fmt.Errorf("%w: %q", errUnknownChain, chain)
+			}
+		},
+	}
+	combineCmd.Flags().StringVar(&out, outKey, "", "File to write the combined transaction to")
+	_ = combineCmd.MarkFlagRequired(outKey)
+
+	inspectCmd := &cobra.Command{
+		Use:   "inspect <file>",
+		Short: "Prints the required and missing signers of a partially signed transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := c.Context()
+			path := args[0]
+			switch chain {
+			case "P":
+				ptx, err := readPartialP(path)
+				if err != nil {
+					return err
+				}
+				return printPartialP(ctx, path, ptx)
+			case "X":
+				ptx, err := readPartialX(path)
+				if err != nil {
+					return err
+				}
+				return printPartialX(ctx, path, ptx)
+			default:
+				return fmt.Errorf("%w: %q", errUnknownChain, chain)
+			}
+		},
+	}
+
+	issueCmd := &cobra.Command{
+		Use:   "issue <file>",
+		Short: "Issues a fully signed transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			ctx := c.Context()
+			options := []common.Option{
+				common.WithContext(ctx),
+			}
+			path := args[0]
+			switch chain {
+			case "P":
+				ptx, err := readPartialP(path)
+				if err != nil {
+					return err
+				}
+				tx, err := ptx.Finalize()
+				if err != nil {
+					return err
+				}
+
+				utxos := common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs())
+				client := p.NewClient(
+					platformvm.NewClient(cfg.uri),
+					pwallet.NewBackend(utxos, nil),
+				)
+				if err := client.IssueTx(tx, options...); err != nil {
+					return err
+				}
+				return printJSON(&issuedTx{
+					TxID: tx.ID(),
+					Tx:   tx,
+				})
+			case "X":
+				ptx, err := readPartialX(path)
+				if err != nil {
+					return err
+				}
+				tx, err := ptx.Finalize()
+				if err != nil {
+					return err
+				}
+
+				infoClient := info.NewClient(cfg.uri)
+				xClient := avm.NewClient(cfg.uri, "X")
+				xCTX, err := x.NewContextFromClients(ctx, infoClient, xClient)
+				if err != nil {
+					return err
+				}
+				utxos := common.NewChainUTXOs(xCTX.BlockchainID, common.NewUTXOs())
+				backend := x.NewBackend(xCTX, utxos)
+				if err := x.NewWallet(nil, nil, xClient, backend).IssueTx(tx, options...); err != nil {
+					return err
+				}
+				return printJSON(&issuedTx{
+					TxID: tx.ID(),
+					Tx:   tx,
+				})
+			default:
+				return fmt.Errorf("%w: %q", errUnknownChain, chain)
+			}
+		},
+	}
+
+	c.AddCommand(
+		signCmd,
+		combineCmd,
+		inspectCmd,
+		issueCmd,
+	)
+	return c
+}
+
+func readPartialP(path string) (*psigner.PartiallySignedTx, error) {
+	b, err := readHexFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return psigner.ParsePartiallySignedTx(b)
+}
+
+func writePartialP(ctx context.Context, path string, ptx *psigner.PartiallySignedTx) error {
+	b, err := ptx.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := writeHexFile(path, b); err != nil {
+		return err
+	}
+	return printPartialP(ctx, path, ptx)
+}
+
+func printPartialP(ctx context.Context, path string, ptx *psigner.PartiallySignedTx) error {
+	required, err := ptx.RequiredSigners(ctx)
+	if err != nil {
+		return err
+	}
+	missing, err := ptx.MissingSigners(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(&partialTx{
+		File:            path,
+		UnsignedTxID:    hashing.ComputeHash256Array(ptx.Tx.Unsigned.Bytes()),
+		RequiredSigners: sortedAddresses(required),
+		MissingSigners:  sortedAddresses(missing),
+	})
+}
+
+func readPartialX(path string) (*xsigner.PartiallySignedTx, error) {
+	b, err := readHexFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return xsigner.ParsePartiallySignedTx(b)
+}
+
+func writePartialX(ctx context.Context, path string, ptx *xsigner.PartiallySignedTx) error {
+	b, err := ptx.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := writeHexFile(path, b); err != nil {
+		return err
+	}
+	return printPartialX(ctx, path, ptx)
+}
+
+func printPartialX(ctx context.Context, path string, ptx *xsigner.PartiallySignedTx) error {
+	required, err := ptx.RequiredSigners(ctx)
+	if err != nil {
+		return err
+	}
+	missing, err := ptx.MissingSigners(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(&partialTx{
+		File:            path,
+		UnsignedTxID:    hashing.ComputeHash256Array(ptx.Tx.Unsigned.Bytes()),
+		RequiredSigners: sortedAddresses(required),
+		MissingSigners:  sortedAddresses(missing),
+	})
+}
+
+func sortedAddresses(addrs set.Set[ids.ShortID]) []ids.ShortID {
+	sorted := addrs.List()
+	utils.Sort(sorted)
+	return sorted
+}
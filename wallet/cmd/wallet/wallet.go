@@ -0,0 +1,265 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/utils/constants"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/keychain/rpckeychain"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/ledger"
+	"github.com/MetalBlockchain/metalgo/utils/crypto/secp256k1"
+	"github.com/MetalBlockchain/metalgo/utils/formatting"
+	"github.com/MetalBlockchain/metalgo/utils/set"
+	"github.com/MetalBlockchain/metalgo/vms/platformvm"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/p"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/x"
+	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary"
+	"github.com/MetalBlockchain/metalgo/wallet/subnet/primary/common"
+
+	avmtxs "github.com/MetalBlockchain/metalgo/vms/avm/txs"
+	platformvmtxs "github.com/MetalBlockchain/metalgo/vms/platformvm/txs"
+	pbuilder "github.com/MetalBlockchain/metalgo/wallet/chain/p/builder"
+	psigner "github.com/MetalBlockchain/metalgo/wallet/chain/p/signer"
+	pwallet "github.com/MetalBlockchain/metalgo/wallet/chain/p/wallet"
+	xbuilder "github.com/MetalBlockchain/metalgo/wallet/chain/x/builder"
+	xsigner "github.com/MetalBlockchain/metalgo/wallet/chain/x/signer"
+)
+
+// wallet holds the P-Chain and X-Chain wallets along with the state they were
+// built from.
+type wallet struct {
+	cfg   *config
+	kc    keychain.Keychain
+	addrs set.Set[ids.ShortID]
+	state *primary.AVAXState
+
+	pBackend pwallet.Backend
+	pBuilder pbuilder.Builder
+	pWallet  pwallet.Wallet
+
+	xBackend x.Backend
+	xBuilder xbuilder.Builder
+	xWallet  x.Wallet
+}
+
+func newWallet(ctx context.Context, cfg *config) (*wallet, error) {
+	kc, err := newKeychain(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := set.Of(kc.Addresses().List()...)
+	extraAddrs, err := parseAddresses(cfg.addresses)
+	if err != nil {
+		return nil, err
+	}
+	addrs.Add(extraAddrs...)
+
+	subnetIDs, err := parseIDs(cfg.subnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	validationIDs, err := parseIDs(cfg.validationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := primary.FetchState(ctx, cfg.uri, addrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch state: %w", err)
+	}
+
+	owners, err := platformvm.GetOwners(state.PClient, ctx, subnetIDs, validationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch owners: %w", err)
+	}
+
+	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, state.UTXOs)
+	pBackend := pwallet.NewBackend(pUTXOs, owners)
+	pBuilder := pbuilder.New(addrs, state.PCTX, pBackend)
+	pSigner := psigner.New(kc, pBackend)
+
+	xUTXOs := common.NewChainUTXOs(state.XCTX.BlockchainID, state.UTXOs)
+	xBackend := x.NewBackend(state.XCTX, xUTXOs)
+	xBuilder := xbuilder.New(addrs, state.XCTX, xBackend)
+	xSigner := xsigner.New(kc, xBackend)
+
+	return &wallet{
+		cfg:      cfg,
+		kc:       kc,
+		addrs:    addrs,
+		state:    state,
+		pBackend: pBackend,
+		pBuilder: pBuilder,
+		pWallet:  pwallet.New(p.NewClient(state.PClient, pBackend), pBuilder, pSigner),
+		xBackend: xBackend,
+		xBuilder: xBuilder,
+		xWallet:  x.NewWallet(xBuilder, xSigner, state.XClient, xBackend),
+	}, nil
+}
+
+// newKeychain returns the keychain described by [cfg]. If no keys were
+// provided, the returned keychain is empty.
+func newKeychain(ctx context.Context, cfg *config) (keychain.Keychain, error) {
+	numSources := 0
+	if cfg.ledger {
+		numSources++
+	}
+	if len(cfg.keyFiles) != 0 {
+		numSources++
+	}
+	if cfg.remoteKeychain != "" {
+		numSources++
+	}
+	if numSources > 1 {
+		return nil, fmt.Errorf("--%s, --%s and --%s are mutually exclusive", keyFileKey, ledgerKey, remoteKeychainKey)
+	}
+
+	if cfg.remoteKeychain != "" {
+		kc, err := rpckeychain.NewClient(ctx, cfg.remoteKeychain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to remote keychain: %w", err)
+		}
+		return kc, nil
+	}
+	if cfg.ledger {
+		device, err := ledger.New()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to ledger: %w", err)
+		}
+		return keychain.NewLedgerKeychain(device, cfg.ledgerNumAddrs)
+	}
+
+	var keys []*secp256k1.PrivateKey
+	for _, keyFile := range cfg.keyFiles {
+		fileKeys, err := rpckeychain.ReadKeyFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, fileKeys...)
+	}
+	return secp256k1fx.NewKeychain(keys...), nil
+}
+
+func (w *wallet) options(ctx context.Context) []common.Option {
+	options := []common.Option{
+		common.WithContext(ctx),
+	}
+	if w.cfg.memo != "" {
+		options = append(options, common.WithMemo([]byte(w.cfg.memo)))
+	}
+	return options
+}
+
+// issueP signs and issues [utx]. If a partially signed transaction was
+// requested, [utx] is instead signed with the available keys and written to
+// disk.
+func (w *wallet) issueP(ctx context.Context, utx platformvmtxs.UnsignedTx) error {
+	if w.cfg.partial != "" {
+		ptx, err := psigner.NewPartiallySignedTx(ctx, w.pBackend, utx)
+		if err != nil {
+			return err
+		}
+		if err := ptx.Sign(ctx, w.kc); err != nil {
+			return err
+		}
+		return writePartialP(ctx, w.cfg.partial, ptx)
+	}
+
+	tx, err := w.pWallet.IssueUnsignedTx(utx, w.options(ctx)...)
+	if err != nil {
+		return err
+	}
+	return printJSON(&issuedTx{
+		TxID: tx.ID(),
+		Tx:   tx,
+	})
+}
+
+// issueX signs and issues [utx]. If a partially signed transaction was
+// requested, [utx] is instead signed with the available keys and written to
+// disk.
+func (w *wallet) issueX(ctx context.Context, utx avmtxs.UnsignedTx) error {
+	if w.cfg.partial != "" {
+		ptx, err := xsigner.NewPartiallySignedTx(ctx, w.xBackend, utx)
+		if err != nil {
+			return err
+		}
+		if err := ptx.Sign(ctx, w.kc); err != nil {
+			return err
+		}
+		return writePartialX(ctx, w.cfg.partial, ptx)
+	}
+
+	tx, err := w.xWallet.IssueUnsignedTx(utx, w.options(ctx)...)
+	if err != nil {
+		return err
+	}
+	return printJSON(&issuedTx{
+		TxID: tx.ID(),
+		Tx:   tx,
+	})
+}
+
+// chainID returns the ID of the chain referenced by [chain], which may either
+// be an alias of a primary network chain or a chainID.
+func (w *wallet) chainID(chain string) (ids.ID, error) {
+	switch chain {
+	case "P":
+		return constants.PlatformChainID, nil
+	case "X":
+		return w.state.XCTX.BlockchainID, nil
+	case "C":
+		return w.state.CCTX.BlockchainID, nil
+	default:
+		return ids.FromString(chain)
+	}
+}
+
+// assetID parses [assetIDStr], falling back to [defaultAssetID] if it is
+// empty.
+func (*wallet) assetID(assetIDStr string, defaultAssetID ids.ID) (ids.ID, error) {
+	if assetIDStr == "" {
+		return defaultAssetID, nil
+	}
+	return ids.FromString(assetIDStr)
+}
+
+type issuedTx struct {
+	TxID ids.ID `json:"txID"`
+	Tx   any    `json:"tx"`
+}
+
+func printJSON(v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(b))
+	return err
+}
+
+func writeHexFile(path string, b []byte) error {
+	encoded, err := formatting.Encode(formatting.Hex, b)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(encoded+"\n"), 0o600)
+}
+
+func readHexFile(path string) ([]byte, error) {
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return formatting.Decode(formatting.Hex, strings.TrimSpace(string(encoded)))
+}
@@ -0,0 +1,368 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MetalBlockchain/metalgo/ids"
+	"github.com/MetalBlockchain/metalgo/vms/avm/txs"
+	"github.com/MetalBlockchain/metalgo/vms/components/verify"
+	"github.com/MetalBlockchain/metalgo/vms/secp256k1fx"
+	"github.com/MetalBlockchain/metalgo/wallet/chain/x/builder"
+)
+
+func xCommand(cfg *config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "x",
+		Short: "Issues X-Chain transactions",
+	}
+	c.AddCommand(
+		xBalanceCommand(cfg),
+		xUTXOsCommand(cfg),
+		xBaseTxCommand(cfg),
+		xCreateAssetTxCommand(cfg),
+		xMintFTTxCommand(cfg),
+		xMintNFTTxCommand(cfg),
+		xMintNFTGroupTxCommand(cfg),
+		xTransferNFTTxCommand(cfg),
+		xMintPropertyTxCommand(cfg),
+		xBurnPropertyTxCommand(cfg),
+		xImportTxCommand(cfg),
+		xExportTxCommand(cfg),
+	)
+	return c
+}
+
+// xTxCommand returns a command that issues the transaction returned by
+// [build].
+func xTxCommand(
+	cfg *config,
+	use string,
+	short string,
+	build func(ctx context.Context, w *wallet) (txs.UnsignedTx, error),
+) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			utx, err := build(ctx, w)
+			if err != nil {
+				return err
+			}
+			return w.issueX(ctx, utx)
+		},
+	}
+}
+
+func xBalanceCommand(cfg *config) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance",
+		Short: "Prints the spendable balance of each fungible asset",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			balances, err := w.xBuilder.GetFTBalance(w.options(ctx)...)
+			if err != nil {
+				return err
+			}
+			return printJSON(balances)
+		},
+	}
+}
+
+func xUTXOsCommand(cfg *config) *cobra.Command {
+	var sourceChain string
+	c := &cobra.Command{
+		Use:   "utxos",
+		Short: "Prints the UTXOs of the wallet",
+		Args:  cobra.NoArgs,
+		RunE: func(c *cobra.Command, _ []string) error {
+			ctx := c.Context()
+			w, err := newWallet(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			sourceChainID, err := w.chainID(sourceChain)
+			if err != nil {
+				return err
+			}
+			utxos, err := w.state.UTXOs.UTXOs(ctx, sourceChainID, w.state.XCTX.BlockchainID)
+			if err != nil {
+				return err
+			}
+			return printJSON(utxos)
+		},
+	}
+	c.Flags().StringVar(&sourceChain, "source-chain", "X", "Chain the UTXOs were exported from")
+	return c
+}
+
+func xBaseTxCommand(cfg *config) *cobra.Command {
+	var out outputFlags
+	c := xTxCommand(cfg, "base", "Sends funds", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		outputs, err := out.outputs(w.xBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewBaseTx(outputs, w.options(ctx)...)
+	})
+	out.addFlags(c.Flags())
+	return c
+}
+
+func xCreateAssetTxCommand(cfg *config) *cobra.Command {
+	var (
+		name         string
+		symbol       string
+		denomination uint8
+		supply       uint64
+		holder       ownerFlags
+		minter       ownerFlags
+	)
+	c := xTxCommand(cfg, "create-asset", "Creates a fungible asset", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		holderOwner, err := holder.owner()
+		if err != nil {
+			return nil, err
+		}
+		initialState := []verify.State{
+			&secp256k1fx.TransferOutput{
+				Amt:          supply,
+				OutputOwners: *holderOwner,
+			},
+		}
+		if len(minter.addrs) != 0 {
+			minterOwner, err := minter.owner()
+			if err != nil {
+				return nil, err
+			}
+			initialState = append(initialState, &secp256k1fx.MintOutput{
+				OutputOwners: *minterOwner,
+			})
+		}
+		return w.xBuilder.NewCreateAssetTx(
+			name,
+			symbol,
+			denomination,
+			map[uint32][]verify.State{
+				builder.SECP256K1FxIndex: initialState,
+			},
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	flags.StringVar(&name, "name", "", "Human readable name of the asset")
+	flags.StringVar(&symbol, "symbol", "", "Human readable abbreviation of the asset")
+	flags.Uint8Var(&denomination, "denomination", 0, "Number of decimal places of the asset")
+	flags.Uint64Var(&supply, "supply", 0, "Initial supply of the asset")
+	holder.addFlags(flags, "holder", "initial holder")
+	minter.addFlags(flags, "minter", "optional minter")
+	return c
+}
+
+func xMintFTTxCommand(cfg *config) *cobra.Command {
+	var (
+		assetIDStr string
+		amount     uint64
+		to         ownerFlags
+	)
+	c := xTxCommand(cfg, "mint-ft", "Mints units of a fungible asset", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxMintFT(
+			map[ids.ID]*secp256k1fx.TransferOutput{
+				assetID: {
+					Amt:          amount,
+					OutputOwners: *owner,
+				},
+			},
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to mint")
+	flags.Uint64Var(&amount, "amount", 0, "Amount to mint")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xMintNFTTxCommand(cfg *config) *cobra.Command {
+	var (
+		assetIDStr string
+		payload    string
+		to         ownerFlags
+	)
+	c := xTxCommand(cfg, "mint-nft", "Mints an NFT", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxMintNFT(
+			assetID,
+			[]byte(payload),
+			[]*secp256k1fx.OutputOwners{owner},
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to mint the NFT under")
+	flags.StringVar(&payload, "payload", "", "Payload of the NFT")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xMintNFTGroupTxCommand(cfg *config) *cobra.Command {
+	var (
+		assetIDStr string
+		groupID    uint32
+		payload    string
+		to         ownerFlags
+	)
+	c := xTxCommand(cfg, "mint-nft-group", "Mints an NFT in a specific group", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxMintNFTGroup(
+			assetID,
+			groupID,
+			[]byte(payload),
+			[]*secp256k1fx.OutputOwners{owner},
+			w.options(ctx)...,
+		)
+	})
+	flags := c.Flags()
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to mint the NFT under")
+	flags.Uint32Var(&groupID, "group-id", 0, "Group of the asset to mint the NFT in")
+	flags.StringVar(&payload, "payload", "", "Payload of the NFT")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xTransferNFTTxCommand(cfg *config) *cobra.Command {
+	var (
+		utxoIDStr string
+		to        ownerFlags
+	)
+	c := xTxCommand(cfg, "transfer-nft", "Transfers an NFT", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		utxoID, err := ids.FromString(utxoIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxTransferNFT(utxoID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&utxoIDStr, "utxo-id", "", "UTXO holding the NFT, as returned by avm.getNFTs")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xMintPropertyTxCommand(cfg *config) *cobra.Command {
+	var (
+		assetIDStr string
+		to         ownerFlags
+	)
+	c := xTxCommand(cfg, "mint-property", "Mints a property", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxMintProperty(assetID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&assetIDStr, "asset-id", "", "Asset to mint the property under")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xBurnPropertyTxCommand(cfg *config) *cobra.Command {
+	var assetIDStr string
+	c := xTxCommand(cfg, "burn-property", "Burns all the properties of an asset", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		assetID, err := ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewOperationTxBurnProperty(assetID, w.options(ctx)...)
+	})
+	c.Flags().StringVar(&assetIDStr, "asset-id", "", "Asset to burn the properties of")
+	return c
+}
+
+func xImportTxCommand(cfg *config) *cobra.Command {
+	var (
+		sourceChain string
+		to          ownerFlags
+	)
+	c := xTxCommand(cfg, "import", "Imports funds from another chain", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		sourceChainID, err := w.chainID(sourceChain)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := to.owner()
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewImportTx(sourceChainID, owner, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&sourceChain, "source-chain", "P", "Chain to import the funds from")
+	to.addFlags(flags, "to", "recipient")
+	return c
+}
+
+func xExportTxCommand(cfg *config) *cobra.Command {
+	var (
+		destinationChain string
+		out              outputFlags
+	)
+	c := xTxCommand(cfg, "export", "Exports funds to another chain", func(ctx context.Context, w *wallet) (txs.UnsignedTx, error) {
+		destinationChainID, err := w.chainID(destinationChain)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := out.outputs(w.xBuilder.Context().AVAXAssetID)
+		if err != nil {
+			return nil, err
+		}
+		return w.xBuilder.NewExportTx(destinationChainID, outputs, w.options(ctx)...)
+	})
+	flags := c.Flags()
+	flags.StringVar(&destinationChain, "destination-chain", "P", "Chain to export the funds to")
+	out.addFlags(flags)
+	return c
+}